@@ -12,10 +12,16 @@ import (
 // @title           Bash Scripts
 // @version         1.0.0
 // @description     This is an API for running bash scripts
+// @description     Errors are returned as schema.HTTPError by default, or as RFC 7807 schema.Problem when the request has Accept: application/problem+json
 
 // @host      0.0.0.0:8000
 // @BasePath  /api/v1
 
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Api key in the format: Bearer psk_<prefix>_<secret>, or the SSO token when jwt is enabled
+
 func main() {
 	s := server.GetServer()
 
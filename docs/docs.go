@@ -15,33 +15,40 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/bash": {
+        "/api-key": {
             "post": {
-                "description": "Create bash script",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create api key, available only for admin. The key value is returned only once",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bash"
+                    "Api Key"
                 ],
                 "summary": "Create",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Bash script file",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "Create api key model",
+                        "name": "apiKey",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateApiKey"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Bash"
+                            "$ref": "#/definitions/schema.ApiKeyWithSecret"
                         }
                     },
                     "500": {
@@ -49,49 +56,65 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
             }
         },
-        "/bash/execute/list": {
-            "post": {
-                "description": "Execute list of bash scripts",
-                "consumes": [
-                    "application/json"
+        "/api-key/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Get list of api keys, available only for admin",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bash"
+                    "Api Key"
                 ],
-                "summary": "Execute List",
+                "summary": "Get list",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Execute type: if true, then in a multithreading, otherwise in a single thread",
-                        "name": "isSync",
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "description": "List of execute bash script models",
-                        "name": "execute",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ExecBash"
-                            }
-                        }
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination",
+                        "name": "offset",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schema.Message"
+                            "$ref": "#/definitions/schema.ApiKeyPaginationPage"
                         }
                     },
                     "500": {
@@ -99,43 +122,56 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
             }
         },
-        "/bash/list": {
-            "get": {
-                "description": "Get list of bash scripts",
+        "/api-key/{id}/expire": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set expiration time of api key by id, the key expires immediately if the time is omitted. Available only for admin",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bash"
+                    "Api Key"
                 ],
-                "summary": "Get list",
+                "summary": "Expire by id",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Limit param of pagination",
-                        "name": "limit",
-                        "in": "query",
+                        "type": "string",
+                        "description": "ID of api key",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset param of pagination",
-                        "name": "offset",
-                        "in": "query",
-                        "required": true
+                        "description": "Expire api key model",
+                        "name": "apiKey",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExpireApiKey"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schema.BashPaginationPage"
+                            "$ref": "#/definitions/model.ApiKey"
                         }
                     },
                     "500": {
@@ -143,50 +179,45 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
             }
         },
-        "/bash/log/{bashId}/list": {
-            "get": {
-                "description": "Get list of bash logs by bash id",
+        "/api-key/{id}/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke api key by id, available only for admin",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bash Log"
+                    "Api Key"
                 ],
-                "summary": "Get list by bash id",
+                "summary": "Revoke by id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID of bash script",
-                        "name": "bashId",
+                        "description": "ID of api key",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Limit param of pagination",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset param of pagination",
-                        "name": "offset",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schema.BashLogPaginationPage"
+                            "$ref": "#/definitions/model.ApiKey"
                         }
                     },
                     "500": {
@@ -194,34 +225,78 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
             }
         },
-        "/bash/{id}": {
+        "/audit/export": {
             "get": {
-                "description": "Get bash script by id",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export audit events from oldest to newest as newline delimited json, one event per line. Available only for admin",
                 "produces": [
-                    "application/json"
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "Bash"
+                    "Audit"
                 ],
-                "summary": "Get by id",
+                "summary": "Export",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID of bash script",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Action to filter events by, e.g. bash.execute",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or subject of the actor to filter events by",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failure"
+                        ],
+                        "type": "string",
+                        "description": "Outcome to filter events by",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the target entity to filter events by",
+                        "name": "targetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Inclusive lower bound of the event time in RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exclusive upper bound of the event time in RFC 3339",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Bash"
+                            "$ref": "#/definitions/model.AuditEvent"
                         }
                     },
                     "500": {
@@ -229,32 +304,105 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove bash script by id",
+            }
+        },
+        "/audit/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of audit events of create, delete and execute actions from newest to oldest, available only for admin",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bash"
+                    "Audit"
                 ],
-                "summary": "Remove by id",
+                "summary": "Get list",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID of bash script",
-                        "name": "id",
-                        "in": "path",
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination",
+                        "name": "offset",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action to filter events by, e.g. bash.execute",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or subject of the actor to filter events by",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failure"
+                        ],
+                        "type": "string",
+                        "description": "Outcome to filter events by",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the target entity to filter events by",
+                        "name": "targetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Inclusive lower bound of the event time in RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exclusive upper bound of the event time in RFC 3339",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Bash"
+                            "$ref": "#/definitions/schema.AuditEventPaginationPage"
                         }
                     },
                     "500": {
@@ -262,26 +410,40 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
             }
         },
-        "/bash/{id}/file": {
-            "get": {
-                "description": "Get bash script file by id",
+        "/bash": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create bash script",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
-                    "application/x-www-form-urlencoded"
+                    "application/json"
                 ],
                 "tags": [
                     "Bash"
                 ],
-                "summary": "Get file by id",
+                "summary": "Create",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID of bash script",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "Bash script file",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -289,7 +451,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/model.Bash"
                         }
                     },
                     "500": {
@@ -297,69 +459,2136 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/schema.HTTPError"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.ExecBash": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "59628b82-356c-4745-bc81-187015cde387"
-                },
-                "timeoutSeconds": {
-                    "type": "integer"
-                }
-            }
         },
-        "model.Bash": {
-            "type": "object",
-            "properties": {
-                "body": {
-                    "type": "string"
+        "/bash/execute/inline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Execute inline bash script without saving it, the run and its logs are removed after the retention period.\nRequires the bash:execute-inline permission of the admin role, since the access lists of the scripts do not cover inline scripts.\nAccepts a JSON body or a multipart form with the script file and optional timeoutSeconds, repeated args and repeated env fields in KEY=VALUE form.",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash"
+                ],
+                "summary": "Execute Inline",
+                "parameters": [
+                    {
+                        "description": "Execute inline bash script model",
+                        "name": "execute",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExecBashInline"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BashRun"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/execute/list": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Execute list of bash scripts.\nA repeated request with the same Idempotency-Key returns the runs of the original request instead of starting new ones.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash"
+                ],
+                "summary": "Execute List",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Execute type: if true, then in a multithreading, otherwise in a single thread",
+                        "name": "isSync",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key of the request to safely retry it",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "List of execute bash script models",
+                        "name": "execute",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ExecBash"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.ExecBashList"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of bash scripts.\nWith the cursor param the keyset page ordered by creation time is returned: items, limit and the next and prev tokens instead of offset and total.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash"
+                ],
+                "summary": "Get list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination, required without cursor",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next or prev token of keyset pagination, empty for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-createdAt,title",
+                        "description": "Comma-separated fields to sort by: title, createdAt, with - for descending order, not allowed with cursor since keyset pages are ordered by createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the title not equal to",
+                        "name": "title[ne]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the title containing the substring, case-insensitive",
+                        "name": "title[like]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time from, RFC 3339",
+                        "name": "createdAt[gte]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time before, RFC 3339",
+                        "name": "createdAt[lt]",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.BashPaginationPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/log/prune": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Prune bash logs outside the retention right away by batches, the dry run only counts the logs which would be pruned. Available only for admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Log Retention"
+                ],
+                "summary": "Prune logs",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Count the prunable logs without removing them",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.BashLogPrune"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/log/{bashId}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export all bash logs of the bash script matching the filters of the list as a file: txt lines with the time and the stream, newline delimited json or csv with a header.",
+                "produces": [
+                    "text/plain",
+                    "application/x-ndjson",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Bash Log"
+                ],
+                "summary": "Export by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "bashId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "txt",
+                            "ndjson",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "txt",
+                        "description": "Format of the file",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of bash run to filter logs by",
+                        "name": "runId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-createdAt",
+                        "description": "Comma-separated fields to sort by: isError, createdAt, with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by the stream of the log: true for stderr, false for stdout",
+                        "name": "isError",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time from, inclusive, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time to, exclusive, RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the substring of the log body ignoring case",
+                        "name": "text",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the POSIX regular expression of the log body, (?i) prefix ignores case",
+                        "name": "regex",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/log/{bashId}/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of bash logs by bash id.\nWith the cursor param the keyset page ordered by creation time is returned: items, limit and the next and prev tokens instead of offset and total.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Log"
+                ],
+                "summary": "Get list by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "bashId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination, required without cursor",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next or prev token of keyset pagination, empty for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of bash run to filter logs by",
+                        "name": "runId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-createdAt",
+                        "description": "Comma-separated fields to sort by: isError, createdAt, with - for descending order, not allowed with cursor since keyset pages are ordered by createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by the stream of the log: true for stderr, false for stdout",
+                        "name": "isError",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time from, RFC 3339",
+                        "name": "createdAt[gte]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time before, RFC 3339",
+                        "name": "createdAt[lt]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time from, inclusive, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Filter by the creation time to, exclusive, RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the substring of the log body ignoring case",
+                        "name": "text",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the POSIX regular expression of the log body, (?i) prefix ignores case",
+                        "name": "regex",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.BashLogPaginationPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/run/{runId}/log": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of bash logs of a single bash script run.\nWith the cursor param the keyset page ordered by creation time is returned: items, limit and the next and prev tokens instead of offset and total.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Log"
+                ],
+                "summary": "Get list by run id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash run",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination, required without cursor",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next or prev token of keyset pagination, empty for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.BashLogPaginationPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get bash script by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash"
+                ],
+                "summary": "Get by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Bash"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove bash script by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash"
+                ],
+                "summary": "Remove by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Bash"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/acl": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Allow the role or the subject (api key id) to execute bash script. A script with entries can be executed only by the listed roles and the more privileged ones, the listed subjects and admin. Available only for admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Acl"
+                ],
+                "summary": "Create acl entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create bash acl entry model, either role or subject",
+                        "name": "bashAcl",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateBashAcl"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BashAcl"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/acl/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get access control list of bash script, available only for admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Acl"
+                ],
+                "summary": "Get acl list by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.BashAcl"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/acl/{aclId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove access control list entry of bash script by id, available only for admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Acl"
+                ],
+                "summary": "Remove acl entry by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of bash acl entry",
+                        "name": "aclId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BashAcl"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/file": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get bash script file by id",
+                "produces": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "Bash"
+                ],
+                "summary": "Get file by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/log/retention": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get log retention of bash script, the null limits are taken from the global retention. Available only for admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Log Retention"
+                ],
+                "summary": "Get log retention by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BashLogRetention"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set log retention of bash script: logs older than maxAgeSeconds, beyond the maxRows newest logs or outside the keepRuns newest runs are pruned.\nThe null limits are taken from the global retention and the zero ones keep the logs without limit. Available only for admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Log Retention"
+                ],
+                "summary": "Set log retention",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set bash log retention model",
+                        "name": "bashLogRetention",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetBashLogRetention"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BashLogRetention"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove log retention of bash script, so the global retention is applied to its logs. Available only for admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Log Retention"
+                ],
+                "summary": "Remove log retention by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BashLogRetention"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/run/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of bash script runs by bash id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Run"
+                ],
+                "summary": "Get list by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination",
+                        "name": "offset",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.BashRunPaginationPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/bash/{id}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get run stats of bash script over the windows: counts by outcome, success rate among the finished runs,\np50, p95 and max duration in seconds, last success and last failure",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Run Stats"
+                ],
+                "summary": "Get stats by bash id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of bash script",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated configured windows like 24h,7d, all configured windows by default",
+                        "name": "windows",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.BashStats"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/overview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get run stats of all scripts and of every script run within the window, the scripts are ordered by the unsuccessful runs.\nThe overview is read from the rollup when the rollups are enabled, rolledUpAt is null when it's aggregated on request",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bash Run Stats"
+                ],
+                "summary": "Get stats overview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configured window like 7d, the first configured window by default",
+                        "name": "window",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.StatsOverview"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create webhook, global if bashId is omitted, otherwise for the specified bash script",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Create",
+                "parameters": [
+                    {
+                        "description": "Create webhook model",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of webhooks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination",
+                        "name": "offset",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.WebhookPaginationPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove webhook by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Remove by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Webhook"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{id}/delivery/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of webhook delivery attempts by webhook id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get delivery list by webhook id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit param of pagination",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset param of pagination",
+                        "name": "offset",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Total mode of pagination: exact (default), estimate or none",
+                        "name": "totalMode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schema.WebhookDeliveryPaginationPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HTTPError"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.CreateApiKey": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2025-04-14T15:50:21.907561+00:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "deploy pipeline"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "operator"
+                }
+            }
+        },
+        "dto.CreateBashAcl": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "operator"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"
+                }
+            }
+        },
+        "dto.CreateWebhook": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "run.failure",
+                        "run.timeout"
+                    ]
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/scripts"
+                }
+            }
+        },
+        "dto.ExecBash": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "timeoutSeconds": {
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.ExecBashInline": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "world"
+                    ]
+                },
+                "body": {
+                    "type": "string",
+                    "example": "echo $GREETING $1"
+                },
+                "env": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "timeoutSeconds": {
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.ExpireApiKey": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2025-04-14T15:50:21.907561+00:00"
+                }
+            }
+        },
+        "dto.SetBashLogRetention": {
+            "type": "object",
+            "properties": {
+                "keepRuns": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "maxAgeSeconds": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 604800
+                },
+                "maxRows": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100000
+                }
+            }
+        },
+        "model.ApiKey": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2025-04-14T15:50:21.907561+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "example": "2024-04-15T15:50:21.907561+00:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "deploy pipeline"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "0a1b2c3d"
+                },
+                "revokedAt": {
+                    "type": "string",
+                    "example": "2024-05-14T15:50:21.907561+00:00"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "operator"
+                }
+            }
+        },
+        "model.AuditEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "bash.execute"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "deploy pipeline"
+                },
+                "actorSubject": {
+                    "type": "string",
+                    "example": "3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"
+                },
+                "clientIp": {
+                    "type": "string",
+                    "example": "10.0.0.12"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9a7c1e2b-4d5f-4a6b-8c9d-0e1f2a3b4c5d"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "POST"
+                },
+                "outcome": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/api/v1/bash/execute/list"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "4c1f7a52-8a0e-4c55-b7a6-3a2f1d9e6b10"
+                },
+                "statusCode": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "targetIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "59628b82-356c-4745-bc81-187015cde387"
+                    ]
+                }
+            }
+        },
+        "model.Bash": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.BashAcl": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5b1f2e7a-3c4d-4e8f-9a0b-1c2d3e4f5a6b"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "operator"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"
+                }
+            }
+        },
+        "model.BashLog": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "f4f4d096-ef4a-4649-8346-a952e2ca27d3"
+                },
+                "isError": {
+                    "type": "boolean"
+                },
+                "runId": {
+                    "type": "string",
+                    "example": "7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"
+                }
+            }
+        },
+        "model.BashLogRetention": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "keepRuns": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "maxAgeSeconds": {
+                    "type": "integer",
+                    "example": 604800
+                },
+                "maxRows": {
+                    "type": "integer",
+                    "example": 100000
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                }
+            }
+        },
+        "model.BashRun": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:21.907561+00:00"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2024-04-15T15:50:21.907561+00:00"
+                },
+                "finishedAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:22.907561+00:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"
+                },
+                "redactionCount": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "4c1f7a52-8a0e-4c55-b7a6-3a2f1d9e6b10"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                }
+            }
+        },
+        "model.BashRunScriptStats": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "durationMax": {
+                    "type": "number",
+                    "example": 12.31
+                },
+                "durationP50": {
+                    "type": "number",
+                    "example": 1.52
+                },
+                "durationP95": {
+                    "type": "number",
+                    "example": 4.8
+                },
+                "failure": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "interrupted": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "lastFailureAt": {
+                    "type": "string",
+                    "example": "2024-04-13T08:10:02.101561+00:00"
+                },
+                "lastSuccessAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:22.907561+00:00"
+                },
+                "running": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "success": {
+                    "type": "integer",
+                    "example": 110
+                },
+                "successRate": {
+                    "type": "number",
+                    "example": 0.9244
+                },
+                "timeout": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "title": {
+                    "type": "string",
+                    "example": "backup"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "model.BashRunWindowStats": {
+            "type": "object",
+            "properties": {
+                "durationMax": {
+                    "type": "number",
+                    "example": 12.31
+                },
+                "durationP50": {
+                    "type": "number",
+                    "example": 1.52
+                },
+                "durationP95": {
+                    "type": "number",
+                    "example": 4.8
+                },
+                "failure": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "interrupted": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "lastFailureAt": {
+                    "type": "string",
+                    "example": "2024-04-13T08:10:02.101561+00:00"
+                },
+                "lastSuccessAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:22.907561+00:00"
+                },
+                "running": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "since": {
+                    "type": "string",
+                    "example": "2024-04-07T15:50:21.907561+00:00"
+                },
+                "success": {
+                    "type": "integer",
+                    "example": 110
+                },
+                "successRate": {
+                    "type": "number",
+                    "example": 0.9244
+                },
+                "timeout": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "window": {
+                    "type": "string",
+                    "example": "7d"
+                }
+            }
+        },
+        "model.Webhook": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
                 },
                 "createdAt": {
                     "type": "string",
                     "example": "2024-04-14T15:50:21.907561+00:00"
                 },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "run.failure",
+                        "run.timeout"
+                    ]
+                },
                 "id": {
                     "type": "string",
-                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                    "example": "0c7d3c4c-0b4d-4c4b-9a4b-2f1a0f7a9d61"
                 },
-                "title": {
-                    "type": "string"
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/scripts"
                 }
             }
         },
-        "model.BashLog": {
+        "model.WebhookDelivery": {
             "type": "object",
             "properties": {
-                "bashId": {
+                "attempt": {
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string",
-                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                    "example": "2024-04-14T15:50:21.907561+00:00"
                 },
-                "body": {
+                "error": {
                     "type": "string"
                 },
+                "event": {
+                    "type": "string",
+                    "example": "run.failure"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "b5e0a0d4-6f0e-4a43-8d3c-2f0c2e6f9b1e"
+                },
+                "isSuccess": {
+                    "type": "boolean"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "statusCode": {
+                    "type": "integer"
+                },
+                "webhookId": {
+                    "type": "string",
+                    "example": "0c7d3c4c-0b4d-4c4b-9a4b-2f1a0f7a9d61"
+                }
+            }
+        },
+        "schema.ApiKeyPaginationPage": {
+            "type": "object",
+            "properties": {
+                "hasMore": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ApiKey"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
+                }
+            }
+        },
+        "schema.ApiKeyWithSecret": {
+            "type": "object",
+            "properties": {
                 "createdAt": {
                     "type": "string",
                     "example": "2024-04-14T15:50:21.907561+00:00"
                 },
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2025-04-14T15:50:21.907561+00:00"
+                },
                 "id": {
                     "type": "string",
-                    "example": "f4f4d096-ef4a-4649-8346-a952e2ca27d3"
+                    "example": "3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"
                 },
-                "isError": {
+                "key": {
+                    "type": "string",
+                    "example": "psk_0a1b2c3d_5f1e..."
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "example": "2024-04-15T15:50:21.907561+00:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "deploy pipeline"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "0a1b2c3d"
+                },
+                "revokedAt": {
+                    "type": "string",
+                    "example": "2024-05-14T15:50:21.907561+00:00"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "operator"
+                }
+            }
+        },
+        "schema.AuditEventPaginationPage": {
+            "type": "object",
+            "properties": {
+                "hasMore": {
                     "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AuditEvent"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
                 }
             }
         },
         "schema.BashLogPaginationPage": {
             "type": "object",
             "properties": {
+                "hasMore": {
+                    "type": "boolean"
+                },
                 "items": {
                     "type": "array",
                     "items": {
@@ -374,12 +2603,36 @@ const docTemplate = `{
                 },
                 "total": {
                     "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
+                }
+            }
+        },
+        "schema.BashLogPrune": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 1520
+                },
+                "dryRun": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
         "schema.BashPaginationPage": {
             "type": "object",
             "properties": {
+                "hasMore": {
+                    "type": "boolean"
+                },
                 "items": {
                     "type": "array",
                     "items": {
@@ -394,6 +2647,78 @@ const docTemplate = `{
                 },
                 "total": {
                     "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
+                }
+            }
+        },
+        "schema.BashRunPaginationPage": {
+            "type": "object",
+            "properties": {
+                "hasMore": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.BashRun"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
+                }
+            }
+        },
+        "schema.BashStats": {
+            "type": "object",
+            "properties": {
+                "bashId": {
+                    "type": "string",
+                    "example": "59628b82-356c-4745-bc81-187015cde387"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.BashRunWindowStats"
+                    }
+                }
+            }
+        },
+        "schema.ExecBashList": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "OK"
+                },
+                "runIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"
+                    ]
                 }
             }
         },
@@ -403,6 +2728,13 @@ const docTemplate = `{
                 "detail": {
                     "type": "string"
                 },
+                "errors": {
+                    "description": "Errors lists the invalid fields of the request, it is set only for validation errors.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/validation.FieldError"
+                    }
+                },
                 "httpCode": {
                     "type": "integer"
                 },
@@ -411,14 +2743,198 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.Message": {
+        "schema.Problem": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "detail": {
+                    "type": "string",
+                    "example": "The specified bash script does not exists"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/validation.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/bash/59628b82-356c-4745-bc81-187015cde387"
+                },
+                "serviceCode": {
+                    "type": "integer",
+                    "example": 207
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:pg-sh-scripts:problem:207"
+                }
+            }
+        },
+        "schema.StatsOverview": {
+            "type": "object",
+            "properties": {
+                "durationMax": {
+                    "type": "number",
+                    "example": 12.31
+                },
+                "durationP50": {
+                    "type": "number",
+                    "example": 1.52
+                },
+                "durationP95": {
+                    "type": "number",
+                    "example": 4.8
+                },
+                "failure": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "interrupted": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "lastFailureAt": {
+                    "type": "string",
+                    "example": "2024-04-13T08:10:02.101561+00:00"
+                },
+                "lastSuccessAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:50:22.907561+00:00"
+                },
+                "rolledUpAt": {
+                    "type": "string",
+                    "example": "2024-04-14T15:45:00.000000+00:00"
+                },
+                "running": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "scripts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.BashRunScriptStats"
+                    }
+                },
+                "since": {
+                    "type": "string",
+                    "example": "2024-04-07T15:50:21.907561+00:00"
+                },
+                "success": {
+                    "type": "integer",
+                    "example": 110
+                },
+                "successRate": {
+                    "type": "number",
+                    "example": 0.9244
+                },
+                "timeout": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "window": {
+                    "type": "string",
+                    "example": "7d"
+                }
+            }
+        },
+        "schema.WebhookDeliveryPaginationPage": {
+            "type": "object",
+            "properties": {
+                "hasMore": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookDelivery"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
+                }
+            }
+        },
+        "schema.WebhookPaginationPage": {
+            "type": "object",
+            "properties": {
+                "hasMore": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Webhook"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalMode": {
+                    "type": "string",
+                    "enum": [
+                        "exact",
+                        "estimate",
+                        "none"
+                    ]
+                }
+            }
+        },
+        "validation.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "$[1].timeoutSeconds"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "max=86400"
+                },
+                "value": {
+                    "type": "object"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Api key in the format: Bearer psk_\u003cprefix\u003e_\u003csecret\u003e, or the SSO token when jwt is enabled",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
@@ -429,7 +2945,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/api/v1",
 	Schemes:          []string{},
 	Title:            "Bash Scripts",
-	Description:      "This is an API for running bash scripts",
+	Description:      "This is an API for running bash scripts\nErrors are returned as schema.HTTPError by default, or as RFC 7807 schema.Problem when the request has Accept: application/problem+json",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
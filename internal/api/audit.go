@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	auditTargetsContextKey  = "auditTargets"
+	auditRecordedContextKey = "auditRecorded"
+)
+
+type IAuditRecorder interface {
+	RecordAuditEvent(ctx context.Context, dto dto.CreateAuditEvent) error
+}
+
+// AddAuditTargets adds ids of the entities touched by the request to its audit event,
+// path params are added by Audit itself.
+func AddAuditTargets(c *gin.Context, ids ...string) {
+	targetIds := c.GetStringSlice(auditTargetsContextKey)
+	c.Set(auditTargetsContextKey, append(targetIds, ids...))
+}
+
+// Audit records the action once the rest of the chain has written the response.
+// It has to run before RequirePermission so that denied attempts are recorded too.
+// The client ip honours the trusted proxies of the server.
+func Audit(recorder IAuditRecorder, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		recordAuditEvent(c, recorder, action)
+	}
+}
+
+// AuditDenied records the requests rejected with 401 or 403 that are not recorded by Audit,
+// it runs before the auth middleware, so the attempts without valid credentials are recorded too.
+func AuditDenied(recorder IAuditRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.GetBool(auditRecordedContextKey) {
+			return
+		}
+		if status := c.Writer.Status(); status == http.StatusUnauthorized || status == http.StatusForbidden {
+			recordAuditEvent(c, recorder, model.AuditActionAccessDenied)
+		}
+	}
+}
+
+func recordAuditEvent(c *gin.Context, recorder IAuditRecorder, action string) {
+	c.Set(auditRecordedContextKey, true)
+
+	createAuditEventDTO := dto.CreateAuditEvent{
+		Action:     action,
+		Actor:      "unknown",
+		ClientIp:   c.ClientIP(),
+		Method:     c.Request.Method,
+		Path:       c.Request.URL.Path,
+		Outcome:    model.AuditOutcomeSuccess,
+		StatusCode: c.Writer.Status(),
+	}
+
+	if principal := GetPrincipal(c); principal != nil {
+		createAuditEventDTO.Actor = principal.Name
+		if principal.Subject != "" {
+			createAuditEventDTO.ActorSubject = &principal.Subject
+		}
+	}
+	if requestId := GetRequestId(c); requestId != "" {
+		createAuditEventDTO.RequestId = &requestId
+	}
+	for _, param := range c.Params {
+		createAuditEventDTO.TargetIds = append(createAuditEventDTO.TargetIds, param.Value)
+	}
+	createAuditEventDTO.TargetIds = append(
+		createAuditEventDTO.TargetIds,
+		c.GetStringSlice(auditTargetsContextKey)...,
+	)
+	if createAuditEventDTO.StatusCode >= http.StatusBadRequest {
+		createAuditEventDTO.Outcome = model.AuditOutcomeFailure
+	}
+
+	// The action may outlive a disconnected client, like a sync execution, so its record has to as well.
+	ctx := context.WithoutCancel(c.Request.Context())
+	if err := recorder.RecordAuditEvent(ctx, createAuditEventDTO); err != nil {
+		log.GetLogger().ErrorContext(ctx, fmt.Sprintf("Recording audit event: %s Error: %s", action, err))
+	}
+}
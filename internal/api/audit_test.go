@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	mock_usecase "pg-sh-scripts/internal/usecase/mock"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudit(t *testing.T) {
+	type (
+		inStruct struct {
+			principal *model.Principal
+			handler   gin.HandlerFunc
+			requestId string
+		}
+
+		expectedStruct struct {
+			dto dto.CreateAuditEvent
+		}
+	)
+
+	requestId := "4c1f7a52-8a0e-4c55-b7a6-3a2f1d9e6b10"
+	subject := "3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				principal: &model.Principal{Subject: subject, Name: "deploy", Roles: []string{model.RoleAuthor}},
+				handler: func(c *gin.Context) {
+					AddAuditTargets(c, "7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90")
+					c.Status(http.StatusOK)
+				},
+				requestId: requestId,
+			},
+			expected: expectedStruct{
+				dto: dto.CreateAuditEvent{
+					Action:       model.AuditActionBashDelete,
+					Actor:        "deploy",
+					ActorSubject: &subject,
+					ClientIp:     "203.0.113.7",
+					RequestId:    &requestId,
+					Method:       http.MethodDelete,
+					Path:         "/bash/59628b82-356c-4745-bc81-187015cde387",
+					TargetIds: []string{
+						"59628b82-356c-4745-bc81-187015cde387",
+						"7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90",
+					},
+					Outcome:    model.AuditOutcomeSuccess,
+					StatusCode: http.StatusOK,
+				},
+			},
+		},
+		{
+			name: "Permission denied",
+			in: inStruct{
+				principal: &model.Principal{Name: "anonymous", Roles: []string{model.RoleViewer}},
+				handler:   RequirePermission(model.PermissionBashWrite),
+			},
+			expected: expectedStruct{
+				dto: dto.CreateAuditEvent{
+					Action:     model.AuditActionBashDelete,
+					Actor:      "anonymous",
+					ClientIp:   "203.0.113.7",
+					Method:     http.MethodDelete,
+					Path:       "/bash/59628b82-356c-4745-bc81-187015cde387",
+					TargetIds:  []string{"59628b82-356c-4745-bc81-187015cde387"},
+					Outcome:    model.AuditOutcomeFailure,
+					StatusCode: http.StatusForbidden,
+				},
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuditUseCase := mock_usecase.NewMockIAuditUseCase(ctrl)
+			mockAuditUseCase.EXPECT().RecordAuditEvent(gomock.Any(), testCase.expected.dto).Return(nil)
+
+			r := gin.New()
+			r.ForwardedByClientIP = true
+			if err := r.SetTrustedProxies([]string{"192.0.2.1"}); err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			r.DELETE(
+				"/bash/:id",
+				func(c *gin.Context) {
+					SetPrincipal(c, testCase.in.principal)
+					if testCase.in.requestId != "" {
+						SetRequestId(c, testCase.in.requestId)
+					}
+				},
+				Audit(mockAuditUseCase, model.AuditActionBashDelete),
+				testCase.in.handler,
+			)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodDelete, "/bash/59628b82-356c-4745-bc81-187015cde387", nil)
+			request.RemoteAddr = "192.0.2.1:41234"
+			request.Header.Set("X-Forwarded-For", "203.0.113.7")
+
+			r.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.expected.dto.StatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestAuditDenied(t *testing.T) {
+	type (
+		inStruct struct {
+			handlers []func(recorder IAuditRecorder) gin.HandlerFunc
+		}
+
+		expectedStruct struct {
+			code   int
+			action string
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Unauthorized",
+			in: inStruct{
+				handlers: []func(recorder IAuditRecorder) gin.HandlerFunc{
+					func(recorder IAuditRecorder) gin.HandlerFunc {
+						return RequirePermission(model.PermissionBashWrite)
+					},
+				},
+			},
+			expected: expectedStruct{
+				code:   http.StatusUnauthorized,
+				action: model.AuditActionAccessDenied,
+			},
+		},
+		{
+			name: "Permission denied recorded by action",
+			in: inStruct{
+				handlers: []func(recorder IAuditRecorder) gin.HandlerFunc{
+					func(recorder IAuditRecorder) gin.HandlerFunc {
+						return func(c *gin.Context) {
+							SetPrincipal(c, &model.Principal{Name: "viewer", Roles: []string{model.RoleViewer}})
+						}
+					},
+					func(recorder IAuditRecorder) gin.HandlerFunc {
+						return Audit(recorder, model.AuditActionBashDelete)
+					},
+					func(recorder IAuditRecorder) gin.HandlerFunc {
+						return RequirePermission(model.PermissionBashWrite)
+					},
+				},
+			},
+			expected: expectedStruct{
+				code:   http.StatusForbidden,
+				action: model.AuditActionBashDelete,
+			},
+		},
+		{
+			name: "Success is not recorded",
+			in: inStruct{
+				handlers: []func(recorder IAuditRecorder) gin.HandlerFunc{
+					func(recorder IAuditRecorder) gin.HandlerFunc {
+						return func(c *gin.Context) {
+							c.Status(http.StatusOK)
+						}
+					},
+				},
+			},
+			expected: expectedStruct{
+				code: http.StatusOK,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuditUseCase := mock_usecase.NewMockIAuditUseCase(ctrl)
+			if testCase.expected.action != "" {
+				mockAuditUseCase.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, dto dto.CreateAuditEvent) error {
+						assert.Equal(t, testCase.expected.action, dto.Action)
+						assert.Equal(t, testCase.expected.code, dto.StatusCode)
+						assert.Equal(t, model.AuditOutcomeFailure, dto.Outcome)
+						return nil
+					},
+				)
+			}
+
+			handlers := []gin.HandlerFunc{AuditDenied(mockAuditUseCase)}
+			for _, handler := range testCase.in.handlers {
+				handlers = append(handlers, handler(mockAuditUseCase))
+			}
+
+			r := gin.New()
+			r.DELETE("/bash/:id", handlers...)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodDelete, "/bash/59628b82-356c-4745-bc81-187015cde387", nil)
+
+			r.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+		})
+	}
+}
+
+func TestAudit_CanceledRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuditUseCase := mock_usecase.NewMockIAuditUseCase(ctrl)
+	mockAuditUseCase.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, dto dto.CreateAuditEvent) error {
+			assert.NoError(t, ctx.Err())
+			return nil
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := gin.New()
+	r.POST(
+		"/bash/execute/list",
+		Audit(mockAuditUseCase, model.AuditActionBashExecute),
+		func(c *gin.Context) {
+			// The client disconnects while the script is still running.
+			cancel()
+			c.Status(http.StatusOK)
+		},
+	)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/bash/execute/list", nil).WithContext(ctx)
+
+	r.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// exportFlushRows and exportFlushInterval bound how long the encoded rows wait
+	// before they are sent to the client, so the export is neither flushed per row nor held until the end.
+	exportFlushRows     = 500
+	exportFlushInterval = time.Second
+)
+
+type (
+	// ExportEncoder writes the rows of the export one by one,
+	// Flush is called periodically and once more at the end of the export.
+	ExportEncoder[T any] interface {
+		Encode(item T) error
+		Flush() error
+	}
+
+	NdjsonEncoder[T any] struct {
+		encoder *json.Encoder
+	}
+)
+
+func NewNdjsonEncoder[T any](w io.Writer) *NdjsonEncoder[T] {
+	return &NdjsonEncoder[T]{encoder: json.NewEncoder(w)}
+}
+
+func (e *NdjsonEncoder[T]) Encode(item T) error {
+	return e.encoder.Encode(item)
+}
+
+func (e *NdjsonEncoder[T]) Flush() error {
+	return nil
+}
+
+// RenderExport streams the rows passed by export to the response through the encoder,
+// the headers of the attachment are set by the caller. An error before anything is written
+// is rendered as the http error, after that the response is aborted and the client sees a truncated body.
+func RenderExport[T any](
+	c *gin.Context,
+	helper IHelper,
+	encoder ExportEncoder[T],
+	export func(fn func(item T) error) error,
+) {
+	var rowCount int
+	flushedAt := time.Now()
+
+	flush := func() error {
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		if c.Writer.Written() {
+			c.Writer.Flush()
+		}
+		rowCount = 0
+		flushedAt = time.Now()
+		return nil
+	}
+
+	err := export(func(item T) error {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+		rowCount++
+		if rowCount >= exportFlushRows || time.Since(flushedAt) >= exportFlushInterval {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		httpError := helper.ParseError(c.Request.Context(), err)
+		if c.Writer.Written() {
+			// The export has already started, so the client sees a truncated body.
+			c.Abort()
+			return
+		}
+		c.Header("Content-Type", "application/json; charset=utf-8")
+		c.Header("Content-Disposition", "")
+		RenderError(c, httpError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"pg-sh-scripts/internal/config"
@@ -13,7 +14,7 @@ import (
 
 type (
 	IHelper interface {
-		ParseError(err error) *schema.HTTPError
+		ParseError(ctx context.Context, err error) *schema.HTTPError
 	}
 
 	Helper struct {
@@ -22,13 +23,13 @@ type (
 	}
 )
 
-func (e *Helper) ParseError(err error) *schema.HTTPError {
+func (e *Helper) ParseError(ctx context.Context, err error) *schema.HTTPError {
 	var httpErr *schema.HTTPError
 
 	if errors.As(err, &httpErr) {
-		e.logger.Error(fmt.Sprintf("Service error: %v", err))
+		e.logger.ErrorContext(ctx, fmt.Sprintf("Service error: %v", err))
 	} else {
-		e.logger.Error(fmt.Sprintf("Unknown error: %v", err))
+		e.logger.ErrorContext(ctx, fmt.Sprintf("Unknown error: %v", err))
 		errors.As(e.httpErrors.Internal, &httpErr)
 	}
 
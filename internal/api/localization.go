@@ -0,0 +1,47 @@
+package api
+
+import (
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/pkg/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	AcceptLanguageHeader  = "Accept-Language"
+	ContentLanguageHeader = "Content-Language"
+
+	catalogKey  = "i18nCatalog"
+	languageKey = "language"
+)
+
+// SetLanguage chooses the language of the request by the Accept-Language header,
+// the error details of the request are translated with the catalog.
+func SetLanguage(c *gin.Context, catalog *i18n.Catalog) {
+	c.Set(catalogKey, catalog)
+	c.Set(languageKey, catalog.GetLanguage(c.GetHeader(AcceptLanguageHeader)))
+}
+
+// GetLanguage returns the language of the request or an empty string.
+func GetLanguage(c *gin.Context) string {
+	return c.GetString(languageKey)
+}
+
+// localizeError returns the copy of the http error with the translated detail,
+// the error is returned as is when the request has no language or the translation is missing.
+func localizeError(c *gin.Context, httpErr *schema.HTTPError) *schema.HTTPError {
+	value, ok := c.Get(catalogKey)
+	if !ok {
+		return httpErr
+	}
+
+	detail, lang, ok := value.(*i18n.Catalog).GetMessage(GetLanguage(c), httpErr.ServiceCode)
+	if !ok {
+		return httpErr
+	}
+	c.Header(ContentLanguageHeader, lang)
+
+	localizedErr := *httpErr
+	localizedErr.Detail = detail
+	return &localizedErr
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/pkg/i18n"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderError_Localization(t *testing.T) {
+	type (
+		inStruct struct {
+			catalog        *i18n.Catalog
+			accept         string
+			acceptLanguage string
+			httpErr        error
+		}
+
+		expectedStruct struct {
+			contentLanguage string
+			body            string
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+	catalog, err := i18n.NewCatalog(config.LanguageEn, config.GetErrorMessages())
+	assert.NoError(t, err)
+	partialCatalog, err := i18n.NewCatalog(config.LanguageEn, map[string]i18n.Messages{
+		config.LanguageEn: config.GetErrorMessages()[config.LanguageEn],
+		config.LanguageRu: {0: "Внутренняя ошибка"},
+	})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Without catalog",
+			in: inStruct{
+				acceptLanguage: "ru",
+				httpErr:        httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentLanguage: "",
+				body:            `{"httpCode":404,"serviceCode":207,"detail":"The specified bash script does not exists"}`,
+			},
+		},
+		{
+			name: "Default language",
+			in: inStruct{
+				catalog:        catalog,
+				acceptLanguage: "",
+				httpErr:        httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentLanguage: "en",
+				body:            `{"httpCode":404,"serviceCode":207,"detail":"The specified bash script does not exists"}`,
+			},
+		},
+		{
+			name: "Russian",
+			in: inStruct{
+				catalog:        catalog,
+				acceptLanguage: "ru-RU,ru;q=0.9,en;q=0.8",
+				httpErr:        httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentLanguage: "ru",
+				body:            `{"httpCode":404,"serviceCode":207,"detail":"Указанный Bash скрипт не существует"}`,
+			},
+		},
+		{
+			name: "Russian problem",
+			in: inStruct{
+				catalog:        catalog,
+				accept:         ProblemJSONMIME,
+				acceptLanguage: "ru",
+				httpErr:        httpErrors.Internal,
+			},
+			expected: expectedStruct{
+				contentLanguage: "ru",
+				body:            `{"type":"urn:pg-sh-scripts:problem:0","title":"Internal Server Error","status":500,"detail":"Внутренняя ошибка","instance":"/bash/59628b82-356c-4745-bc81-187015cde387","serviceCode":0}`,
+			},
+		},
+		{
+			name: "Missing translation",
+			in: inStruct{
+				catalog:        partialCatalog,
+				acceptLanguage: "ru",
+				httpErr:        httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentLanguage: "en",
+				body:            `{"httpCode":404,"serviceCode":207,"detail":"The specified bash script does not exists"}`,
+			},
+		},
+		{
+			name: "Unknown service code",
+			in: inStruct{
+				catalog:        catalog,
+				acceptLanguage: "ru",
+				httpErr:        &schema.HTTPError{HTTPCode: http.StatusTeapot, ServiceCode: -1, Detail: "Unknown"},
+			},
+			expected: expectedStruct{
+				contentLanguage: "",
+				body:            `{"httpCode":418,"serviceCode":-1,"detail":"Unknown"}`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			if testCase.in.catalog != nil {
+				router.Use(func(c *gin.Context) {
+					SetLanguage(c, testCase.in.catalog)
+				})
+			}
+			router.GET("/bash/:id", func(c *gin.Context) {
+				RenderError(c, testCase.in.httpErr.(*schema.HTTPError))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/bash/59628b82-356c-4745-bc81-187015cde387", nil)
+			if testCase.in.accept != "" {
+				req.Header.Set("Accept", testCase.in.accept)
+			}
+			req.Header.Set(AcceptLanguageHeader, testCase.in.acceptLanguage)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.expected.contentLanguage, w.Header().Get(ContentLanguageHeader))
+			assert.Equal(t, testCase.expected.body, w.Body.String())
+		})
+	}
+}
+
+func TestGetErrorMessages(t *testing.T) {
+	messages := config.GetErrorMessages()
+
+	for code := range messages[config.LanguageEn] {
+		assert.Contains(t, messages[config.LanguageRu], code, "missing ru translation of service code %d", code)
+	}
+	assert.Len(t, messages[config.LanguageRu], len(messages[config.LanguageEn]))
+}
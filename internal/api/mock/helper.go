@@ -5,6 +5,7 @@
 package mock_api
 
 import (
+	context "context"
 	schema "pg-sh-scripts/internal/schema"
 	reflect "reflect"
 
@@ -35,15 +36,15 @@ func (m *MockIHelper) EXPECT() *MockIHelperMockRecorder {
 }
 
 // ParseError mocks base method.
-func (m *MockIHelper) ParseError(err error) *schema.HTTPError {
+func (m *MockIHelper) ParseError(ctx context.Context, err error) *schema.HTTPError {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ParseError", err)
+	ret := m.ctrl.Call(m, "ParseError", ctx, err)
 	ret0, _ := ret[0].(*schema.HTTPError)
 	return ret0
 }
 
 // ParseError indicates an expected call of ParseError.
-func (mr *MockIHelperMockRecorder) ParseError(err interface{}) *gomock.Call {
+func (mr *MockIHelperMockRecorder) ParseError(ctx, err interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseError", reflect.TypeOf((*MockIHelper)(nil).ParseError), err)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseError", reflect.TypeOf((*MockIHelper)(nil).ParseError), ctx, err)
 }
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+
+	"github.com/gin-gonic/gin"
+)
+
+const principalContextKey = "principal"
+
+func SetPrincipal(c *gin.Context, principal *model.Principal) {
+	c.Set(principalContextKey, principal)
+}
+
+// GetPrincipal returns the authenticated caller of the request or nil.
+func GetPrincipal(c *gin.Context) *model.Principal {
+	value, ok := c.Get(principalContextKey)
+	if !ok {
+		return nil
+	}
+	principal, _ := value.(*model.Principal)
+	return principal
+}
+
+// AbortWithError stops the handler chain and writes the http error.
+func AbortWithError(c *gin.Context, err error) {
+	var httpErr *schema.HTTPError
+	if !errors.As(err, &httpErr) {
+		errors.As(config.GetHTTPErrors().Internal, &httpErr)
+	}
+	if httpErr.HTTPCode == http.StatusUnauthorized {
+		c.Header("WWW-Authenticate", "Bearer")
+	}
+	RenderError(c, httpErr)
+	c.Abort()
+}
+
+// RequirePermission allows the request only if a role of the principal grants the permission.
+func RequirePermission(permission model.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal := GetPrincipal(c)
+		if principal == nil {
+			AbortWithError(c, config.GetHTTPErrors().ApiKeyUnauthorized)
+			return
+		}
+		if !principal.Can(permission) {
+			AbortWithError(c, config.GetHTTPErrors().AccessPermissionDenied)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pg-sh-scripts/internal/model"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequirePermission_ExecuteInline(t *testing.T) {
+	testCases := []struct {
+		name     string
+		role     string
+		expected int
+	}{
+		{
+			name:     "Operator is denied",
+			role:     model.RoleOperator,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "Author is denied",
+			role:     model.RoleAuthor,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "Admin is allowed",
+			role:     model.RoleAdmin,
+			expected: http.StatusOK,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := gin.New()
+			r.POST(
+				"/bash/execute/inline",
+				func(c *gin.Context) {
+					SetPrincipal(c, &model.Principal{Name: "deploy", Roles: []string{testCase.role}})
+				},
+				RequirePermission(model.PermissionBashExecute),
+				RequirePermission(model.PermissionBashExecuteInline),
+				func(c *gin.Context) {
+					c.Status(http.StatusOK)
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPost, "/bash/execute/inline", nil)
+
+			r.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.expected, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"pg-sh-scripts/internal/schema"
+
+	"github.com/gin-gonic/gin"
+)
+
+const ProblemJSONMIME = "application/problem+json"
+
+// RenderError writes the http error with the detail in the language of the request in the legacy shape,
+// or as the RFC 7807 problem document when the client prefers application/problem+json in the Accept header.
+func RenderError(c *gin.Context, httpErr *schema.HTTPError) {
+	httpErr = localizeError(c, httpErr)
+
+	if c.NegotiateFormat(gin.MIMEJSON, ProblemJSONMIME) == ProblemJSONMIME {
+		c.Header("Content-Type", ProblemJSONMIME)
+		c.JSON(httpErr.HTTPCode, schema.GetProblem(httpErr, c.Request.URL.Path))
+		return
+	}
+	c.JSON(httpErr.HTTPCode, httpErr)
+}
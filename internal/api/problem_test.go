@@ -0,0 +1,115 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/pkg/validation"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderError(t *testing.T) {
+	type (
+		inStruct struct {
+			accept  string
+			httpErr error
+		}
+
+		expectedStruct struct {
+			contentType string
+			body        string
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+	validateErr := schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+		{Field: "$[0].timeoutSeconds", Reason: "min=0", Value: -1},
+	})
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Legacy by default",
+			in: inStruct{
+				accept:  "",
+				httpErr: httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentType: "application/json; charset=utf-8",
+				body:        `{"httpCode":404,"serviceCode":207,"detail":"The specified bash script does not exists"}`,
+			},
+		},
+		{
+			name: "Legacy for application/json",
+			in: inStruct{
+				accept:  "application/json",
+				httpErr: httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentType: "application/json; charset=utf-8",
+				body:        `{"httpCode":404,"serviceCode":207,"detail":"The specified bash script does not exists"}`,
+			},
+		},
+		{
+			name: "Problem",
+			in: inStruct{
+				accept:  "application/problem+json",
+				httpErr: httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentType: ProblemJSONMIME,
+				body:        `{"type":"urn:pg-sh-scripts:problem:207","title":"Not Found","status":404,"detail":"The specified bash script does not exists","instance":"/bash/59628b82-356c-4745-bc81-187015cde387","serviceCode":207}`,
+			},
+		},
+		{
+			name: "Problem listed first",
+			in: inStruct{
+				accept:  "application/problem+json, application/json",
+				httpErr: httpErrors.BashDoesNotExists,
+			},
+			expected: expectedStruct{
+				contentType: ProblemJSONMIME,
+				body:        `{"type":"urn:pg-sh-scripts:problem:207","title":"Not Found","status":404,"detail":"The specified bash script does not exists","instance":"/bash/59628b82-356c-4745-bc81-187015cde387","serviceCode":207}`,
+			},
+		},
+		{
+			name: "Problem with invalid fields",
+			in: inStruct{
+				accept:  "application/problem+json",
+				httpErr: validateErr,
+			},
+			expected: expectedStruct{
+				contentType: ProblemJSONMIME,
+				body:        `{"type":"urn:pg-sh-scripts:problem:1","title":"Unprocessable Entity","status":422,"detail":"The request contains invalid fields","instance":"/bash/59628b82-356c-4745-bc81-187015cde387","serviceCode":1,"errors":[{"field":"$[0].timeoutSeconds","reason":"min=0","value":-1}]}`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/bash/:id", func(c *gin.Context) {
+				RenderError(c, testCase.in.httpErr.(*schema.HTTPError))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/bash/59628b82-356c-4745-bc81-187015cde387", nil)
+			if testCase.in.accept != "" {
+				req.Header.Set("Accept", testCase.in.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.in.httpErr.(*schema.HTTPError).HTTPCode, w.Code)
+			assert.Equal(t, testCase.expected.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, testCase.expected.body, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"pg-sh-scripts/pkg/logging"
+
+	"github.com/gin-gonic/gin"
+)
+
+const RequestIdHeader = "X-Request-ID"
+
+// SetRequestId stores the request id in the gin context and in the context of the request,
+// so it is logged with every record of the request and reaches the executed scripts.
+func SetRequestId(c *gin.Context, requestId string) {
+	c.Set(logging.RequestIdKey, requestId)
+	c.Request = c.Request.WithContext(logging.WithRequestId(c.Request.Context(), requestId))
+}
+
+// GetRequestId returns the id of the request or an empty string.
+func GetRequestId(c *gin.Context) string {
+	return c.GetString(logging.RequestIdKey)
+}
@@ -0,0 +1,236 @@
+package v1
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/usecase"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	groupApiKeyPath      = "/api-key"
+	getApiKeyListPath    = "/list"
+	createApiKeyPath     = ""
+	revokeApiKeyByIdPath = "/:id/revoke"
+	expireApiKeyByIdPath = "/:id/expire"
+)
+
+type (
+	IApiKeyHandler interface {
+		GetApiKeyList(c *gin.Context)
+		CreateApiKey(c *gin.Context)
+		RevokeApiKeyById(c *gin.Context)
+		ExpireApiKeyById(c *gin.Context)
+	}
+
+	ApiKeyHandler struct {
+		useCase      usecase.IApiKeyUseCase
+		auditUseCase usecase.IAuditUseCase
+		helper       api.IHelper
+		httpErrors   *config.HTTPErrors
+	}
+)
+
+func (h *ApiKeyHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupApiKeyPath)
+	{
+		group.GET(getApiKeyListPath, api.RequirePermission(model.PermissionApiKeyManage), h.GetApiKeyList)
+		group.POST(
+			createApiKeyPath,
+			api.Audit(h.auditUseCase, model.AuditActionApiKeyCreate),
+			api.RequirePermission(model.PermissionApiKeyManage),
+			h.CreateApiKey,
+		)
+		group.POST(
+			revokeApiKeyByIdPath,
+			api.Audit(h.auditUseCase, model.AuditActionApiKeyRevoke),
+			api.RequirePermission(model.PermissionApiKeyManage),
+			h.RevokeApiKeyById,
+		)
+		group.POST(
+			expireApiKeyByIdPath,
+			api.Audit(h.auditUseCase, model.AuditActionApiKeyExpire),
+			api.RequirePermission(model.PermissionApiKeyManage),
+			h.ExpireApiKeyById,
+		)
+	}
+}
+
+// GetApiKeyList
+// @Summary Get list
+// @Tags Api Key
+// @Description Get list of api keys, available only for admin
+// @Produce json
+// @Success 200 {object} schema.ApiKeyPaginationPage
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param limit query int true "Limit param of pagination" default(20)
+// @Param offset query int true "Offset param of pagination" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Security BearerAuth
+// @Router /api-key/list [get]
+func (h *ApiKeyHandler) GetApiKeyList(c *gin.Context) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if limit < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if offset < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	paginationParams := pagination.LimitOffsetParams{
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
+	}
+
+	apiKeyList, err := h.useCase.GetApiKeyPaginationPage(c.Request.Context(), paginationParams)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiKeyList)
+}
+
+// CreateApiKey
+// @Summary Create
+// @Tags Api Key
+// @Description Create api key, available only for admin. The key value is returned only once
+// @Accept json
+// @Produce json
+// @Success 200 {object} schema.ApiKeyWithSecret
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param apiKey body dto.CreateApiKey true "Create api key model"
+// @Security BearerAuth
+// @Router /api-key [post]
+func (h *ApiKeyHandler) CreateApiKey(c *gin.Context) {
+	var createApiKeyDTO dto.CreateApiKey
+
+	if err := c.ShouldBindJSON(&createApiKeyDTO); err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.ApiKeyCreateDTO)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	apiKey, key, err := h.useCase.CreateApiKey(c.Request.Context(), createApiKeyDTO)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+	api.AddAuditTargets(c, apiKey.Id.String())
+
+	c.JSON(http.StatusOK, schema.ApiKeyWithSecret{ApiKey: apiKey, Key: key})
+}
+
+// RevokeApiKeyById
+// @Summary Revoke by id
+// @Tags Api Key
+// @Description Revoke api key by id, available only for admin
+// @Produce json
+// @Success 200 {object} model.ApiKey
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of api key"
+// @Security BearerAuth
+// @Router /api-key/{id}/revoke [post]
+func (h *ApiKeyHandler) RevokeApiKeyById(c *gin.Context) {
+	apiKeyId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.ApiKeyId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	apiKey, err := h.useCase.RevokeApiKeyById(c.Request.Context(), apiKeyId)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiKey)
+}
+
+// ExpireApiKeyById
+// @Summary Expire by id
+// @Tags Api Key
+// @Description Set expiration time of api key by id, the key expires immediately if the time is omitted. Available only for admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.ApiKey
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of api key"
+// @Param apiKey body dto.ExpireApiKey false "Expire api key model"
+// @Security BearerAuth
+// @Router /api-key/{id}/expire [post]
+func (h *ApiKeyHandler) ExpireApiKeyById(c *gin.Context) {
+	apiKeyId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.ApiKeyId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	var expireApiKeyDTO dto.ExpireApiKey
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&expireApiKeyDTO); err != nil {
+			httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.ApiKeyExpireDTO)
+			api.RenderError(c, httpError)
+			return
+		}
+	}
+
+	apiKey, err := h.useCase.ExpireApiKeyById(c.Request.Context(), apiKeyId, expireApiKeyDTO)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiKey)
+}
+
+func GetApiKeyHandler() api.IHandler {
+	return &ApiKeyHandler{
+		useCase:      usecase.GetApiKeyUseCase(),
+		auditUseCase: usecase.GetAuditUseCase(),
+		helper:       api.GetHelper(),
+		httpErrors:   config.GetHTTPErrors(),
+	}
+}
@@ -0,0 +1,270 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	mock_api "pg-sh-scripts/internal/api/mock"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	mock_usecase "pg-sh-scripts/internal/usecase/mock"
+	"strings"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const apiKeyTestDataDir = "apikey_testdata"
+
+func TestApiKeyHandler_CreateApiKey(t *testing.T) {
+	type (
+		inStruct struct {
+			body    string
+			dto     dto.CreateApiKey
+			httpErr error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIApiKeyUseCase, *mock_api.MockIHelper, dto.CreateApiKey, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				body: `{"name":"deploy","role":"operator"}`,
+				dto: dto.CreateApiKey{
+					Name: "deploy",
+					Role: "operator",
+				},
+				httpErr: nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIApiKeyUseCase, mh *mock_api.MockIHelper, dto dto.CreateApiKey, err error) {
+				mu.EXPECT().CreateApiKey(gomock.Any(), dto).Return(
+					&model.ApiKey{Name: "deploy", Prefix: "0a1b2c3d", Salt: "salt", Hash: "hash", Role: "operator"},
+					"psk_0a1b2c3d_secret",
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_api_key_with_secret",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Validation create body error",
+			in: inStruct{
+				body:    `{"name":`,
+				httpErr: httpErrors.ApiKeyCreateDTO,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIApiKeyUseCase, mh *mock_api.MockIHelper, dto dto.CreateApiKey, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "api_key_create_dto_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Validation name error",
+			in: inStruct{
+				body:    `{"name":""}`,
+				dto:     dto.CreateApiKey{},
+				httpErr: httpErrors.ApiKeyName,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIApiKeyUseCase, mh *mock_api.MockIHelper, dto dto.CreateApiKey, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().CreateApiKey(gomock.Any(), dto).Return(nil, "", err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "api_key_name_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockApiKeyUseCase := mock_usecase.NewMockIApiKeyUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(mockApiKeyUseCase, mockApiHelper, testCase.in.dto, testCase.in.httpErr)
+
+			apiKeyHandler := ApiKeyHandler{
+				useCase:    mockApiKeyUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupApiKeyPath + createApiKeyPath
+
+			r := gin.New()
+			r.POST(handlerPath, apiKeyHandler.CreateApiKey)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				http.MethodPost,
+				handlerPath,
+				bytes.NewBufferString(testCase.in.body),
+			)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(apiKeyTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestApiKeyHandler_RevokeApiKeyById(t *testing.T) {
+	type (
+		inStruct struct {
+			apiKeyId string
+			httpErr  error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIApiKeyUseCase, *mock_api.MockIHelper, uuid.UUID, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				apiKeyId: uuid.NewV4().String(),
+				httpErr:  nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIApiKeyUseCase, mh *mock_api.MockIHelper, apiKeyId uuid.UUID, err error) {
+				mu.EXPECT().RevokeApiKeyById(gomock.Any(), apiKeyId).Return(&model.ApiKey{}, nil)
+			},
+			expected: expectedStruct{
+				golden: "default_api_key",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Api key id must be uuid error",
+			in: inStruct{
+				apiKeyId: "uuid",
+				httpErr:  httpErrors.ApiKeyId,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIApiKeyUseCase, mh *mock_api.MockIHelper, apiKeyId uuid.UUID, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "api_key_id_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Api key does not exists error",
+			in: inStruct{
+				apiKeyId: uuid.NewV4().String(),
+				httpErr:  httpErrors.ApiKeyDoesNotExists,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIApiKeyUseCase, mh *mock_api.MockIHelper, apiKeyId uuid.UUID, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().RevokeApiKeyById(gomock.Any(), apiKeyId).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "api_key_does_not_exists_error",
+				code:   http.StatusNotFound,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockApiKeyUseCase := mock_usecase.NewMockIApiKeyUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			uuidApiKeyId, _ := uuid.FromString(testCase.in.apiKeyId)
+			testCase.mockBehavior(mockApiKeyUseCase, mockApiHelper, uuidApiKeyId, testCase.in.httpErr)
+
+			apiKeyHandler := ApiKeyHandler{
+				useCase:    mockApiKeyUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupApiKeyPath + revokeApiKeyByIdPath
+			handlerCasePath := strings.Replace(handlerPath, ":id", testCase.in.apiKeyId, 1)
+
+			r := gin.New()
+			r.POST(handlerPath, apiKeyHandler.RevokeApiKeyById)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPost, handlerCasePath, nil)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(apiKeyTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
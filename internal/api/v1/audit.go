@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/usecase"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	groupAuditPath         = "/audit"
+	getAuditEventListPath  = "/list"
+	exportAuditEventsPath  = "/export"
+	auditExportContentType = "application/x-ndjson"
+	auditExportFileName    = "audit.ndjson"
+)
+
+type (
+	IAuditHandler interface {
+		GetAuditEventList(c *gin.Context)
+		ExportAuditEventList(c *gin.Context)
+	}
+
+	AuditHandler struct {
+		useCase    usecase.IAuditUseCase
+		helper     api.IHelper
+		httpErrors *config.HTTPErrors
+	}
+)
+
+func (h *AuditHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupAuditPath, api.RequirePermission(model.PermissionAuditRead))
+	{
+		group.GET(getAuditEventListPath, h.GetAuditEventList)
+		group.GET(exportAuditEventsPath, h.ExportAuditEventList)
+	}
+}
+
+// parseAuditEventFilter reads the audit event filter from the query params.
+func (h *AuditHandler) parseAuditEventFilter(c *gin.Context) (dto.AuditEventFilter, error) {
+	var filter dto.AuditEventFilter
+
+	if action := c.Query("action"); action != "" {
+		filter.Action = &action
+	}
+	if actor := c.Query("actor"); actor != "" {
+		filter.Actor = &actor
+	}
+	if outcome := c.Query("outcome"); outcome != "" {
+		filter.Outcome = &outcome
+	}
+	if targetId := c.Query("targetId"); targetId != "" {
+		filter.TargetId = &targetId
+	}
+	if rawFrom := c.Query("from"); rawFrom != "" {
+		from, err := time.Parse(time.RFC3339, rawFrom)
+		if err != nil {
+			return filter, h.httpErrors.AuditFilter
+		}
+		filter.From = &from
+	}
+	if rawTo := c.Query("to"); rawTo != "" {
+		to, err := time.Parse(time.RFC3339, rawTo)
+		if err != nil {
+			return filter, h.httpErrors.AuditFilter
+		}
+		filter.To = &to
+	}
+
+	return filter, nil
+}
+
+// GetAuditEventList
+// @Summary Get list
+// @Tags Audit
+// @Description Get list of audit events of create, delete and execute actions from newest to oldest, available only for admin
+// @Produce json
+// @Success 200 {object} schema.AuditEventPaginationPage
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param limit query int true "Limit param of pagination" default(20)
+// @Param offset query int true "Offset param of pagination" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Param action query string false "Action to filter events by, e.g. bash.execute"
+// @Param actor query string false "Name or subject of the actor to filter events by"
+// @Param outcome query string false "Outcome to filter events by" Enums(success, failure)
+// @Param targetId query string false "ID of the target entity to filter events by"
+// @Param from query string false "Inclusive lower bound of the event time in RFC 3339"
+// @Param to query string false "Exclusive upper bound of the event time in RFC 3339"
+// @Security BearerAuth
+// @Router /audit/list [get]
+func (h *AuditHandler) GetAuditEventList(c *gin.Context) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if limit < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if offset < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	filter, err := h.parseAuditEventFilter(c)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	paginationParams := pagination.LimitOffsetParams{
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
+	}
+
+	auditEventList, err := h.useCase.GetAuditEventPaginationPage(c.Request.Context(), filter, paginationParams)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, auditEventList)
+}
+
+// ExportAuditEventList
+// @Summary Export
+// @Tags Audit
+// @Description Export audit events from oldest to newest as newline delimited json, one event per line. Available only for admin
+// @Produce application/x-ndjson
+// @Success 200 {object} model.AuditEvent
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param action query string false "Action to filter events by, e.g. bash.execute"
+// @Param actor query string false "Name or subject of the actor to filter events by"
+// @Param outcome query string false "Outcome to filter events by" Enums(success, failure)
+// @Param targetId query string false "ID of the target entity to filter events by"
+// @Param from query string false "Inclusive lower bound of the event time in RFC 3339"
+// @Param to query string false "Exclusive upper bound of the event time in RFC 3339"
+// @Security BearerAuth
+// @Router /audit/export [get]
+func (h *AuditHandler) ExportAuditEventList(c *gin.Context) {
+	filter, err := h.parseAuditEventFilter(c)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.Header("Content-Type", auditExportContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", auditExportFileName))
+	encoder := api.NewNdjsonEncoder[*model.AuditEvent](c.Writer)
+
+	api.RenderExport(c, h.helper, encoder, func(fn func(auditEvent *model.AuditEvent) error) error {
+		return h.useCase.ExportAuditEventList(c.Request.Context(), filter, fn)
+	})
+}
+
+func GetAuditHandler() api.IHandler {
+	return &AuditHandler{
+		useCase:    usecase.GetAuditUseCase(),
+		helper:     api.GetHelper(),
+		httpErrors: config.GetHTTPErrors(),
+	}
+}
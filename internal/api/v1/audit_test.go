@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	mock_api "pg-sh-scripts/internal/api/mock"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	mock_usecase "pg-sh-scripts/internal/usecase/mock"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const auditTestDataDir = "audit_testdata"
+
+func TestAuditHandler_ExportAuditEventList(t *testing.T) {
+	type (
+		inStruct struct {
+			query   string
+			filter  dto.AuditEventFilter
+			httpErr error
+		}
+
+		expectedStruct struct {
+			golden      string
+			code        int
+			contentType string
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	action := model.AuditActionBashExecute
+	from := time.Date(2024, 4, 14, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2024, 4, 14, 15, 50, 21, 0, time.UTC)
+	auditEventList := []*model.AuditEvent{
+		{
+			Id:         uuid.FromStringOrNil("9a7c1e2b-4d5f-4a6b-8c9d-0e1f2a3b4c5d"),
+			Action:     model.AuditActionBashExecute,
+			Actor:      "deploy",
+			ClientIp:   "10.0.0.12",
+			Method:     http.MethodPost,
+			Path:       "/api/v1/bash/execute/list",
+			TargetIds:  []string{"59628b82-356c-4745-bc81-187015cde387"},
+			Outcome:    model.AuditOutcomeSuccess,
+			StatusCode: http.StatusOK,
+			CreatedAt:  createdAt,
+		},
+		{
+			Id:         uuid.FromStringOrNil("4c1f7a52-8a0e-4c55-b7a6-3a2f1d9e6b10"),
+			Action:     model.AuditActionBashExecute,
+			Actor:      "viewer",
+			ClientIp:   "10.0.0.13",
+			Method:     http.MethodPost,
+			Path:       "/api/v1/bash/execute/list",
+			TargetIds:  []string{},
+			Outcome:    model.AuditOutcomeFailure,
+			StatusCode: http.StatusForbidden,
+			CreatedAt:  createdAt.Add(time.Second),
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIAuditUseCase, *mock_api.MockIHelper, dto.AuditEventFilter, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				query:  "?action=bash.execute&from=2024-04-14T00:00:00Z",
+				filter: dto.AuditEventFilter{Action: &action, From: &from},
+			},
+			mockBehavior: func(mu *mock_usecase.MockIAuditUseCase, mh *mock_api.MockIHelper, filter dto.AuditEventFilter, err error) {
+				mu.EXPECT().ExportAuditEventList(gomock.Any(), filter, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, filter dto.AuditEventFilter, fn func(auditEvent *model.AuditEvent) error) error {
+						for _, auditEvent := range auditEventList {
+							if err := fn(auditEvent); err != nil {
+								return err
+							}
+						}
+						return nil
+					},
+				)
+			},
+			expected: expectedStruct{
+				golden:      "default_audit_event_export",
+				code:        http.StatusOK,
+				contentType: auditExportContentType,
+			},
+		},
+		{
+			name: "Validation from error",
+			in: inStruct{
+				query:   "?from=yesterday",
+				httpErr: httpErrors.AuditFilter,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIAuditUseCase, mh *mock_api.MockIHelper, filter dto.AuditEventFilter, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden:      "audit_filter_error",
+				code:        http.StatusBadRequest,
+				contentType: "application/json; charset=utf-8",
+			},
+		},
+		{
+			name: "Export error",
+			in: inStruct{
+				httpErr: httpErrors.AuditExport,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIAuditUseCase, mh *mock_api.MockIHelper, filter dto.AuditEventFilter, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().ExportAuditEventList(gomock.Any(), filter, gomock.Any()).Return(err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden:      "audit_export_error",
+				code:        http.StatusInternalServerError,
+				contentType: "application/json; charset=utf-8",
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuditUseCase := mock_usecase.NewMockIAuditUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(mockAuditUseCase, mockApiHelper, testCase.in.filter, testCase.in.httpErr)
+
+			auditHandler := AuditHandler{
+				useCase:    mockAuditUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupAuditPath + exportAuditEventsPath
+
+			r := gin.New()
+			r.GET(handlerPath, auditHandler.ExportAuditEventList)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, handlerPath+testCase.in.query, nil)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(auditTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, testCase.expected.contentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
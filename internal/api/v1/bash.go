@@ -1,18 +1,24 @@
 package v1
 
 import (
+	"context"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"pg-sh-scripts/internal/api"
 	"pg-sh-scripts/internal/config"
 	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
 	"pg-sh-scripts/internal/msg"
 	"pg-sh-scripts/internal/schema"
 	"pg-sh-scripts/internal/usecase"
 	"pg-sh-scripts/pkg/sql/pagination"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -23,7 +29,10 @@ const (
 	getBashListPath     = "/list"
 	createBashPath      = ""
 	execBashListPath    = "/execute/list"
+	execBashInlinePath  = "/execute/inline"
 	removeBashPath      = "/:id"
+
+	idempotencyKeyHeader = "Idempotency-Key"
 )
 
 type (
@@ -32,26 +41,50 @@ type (
 		GetBashFileById(c *gin.Context)
 		GetBashList(c *gin.Context)
 		CreateBash(c *gin.Context)
-		ExecBash(c *gin.Context)
+		ExecBashList(c *gin.Context)
+		ExecBashInline(c *gin.Context)
 		RemoveBashById(c *gin.Context)
 	}
 
 	BashHandler struct {
-		useCase    usecase.IBashUseCase
-		helper     api.IHelper
-		httpErrors *config.HTTPErrors
+		useCase      usecase.IBashUseCase
+		auditUseCase usecase.IAuditUseCase
+		helper       api.IHelper
+		httpErrors   *config.HTTPErrors
 	}
 )
 
 func (h *BashHandler) Register(rg *gin.RouterGroup) {
 	group := rg.Group(groupBashPath)
 	{
-		group.GET(getBashByIdPath, h.GetBashById)
-		group.GET(getBashFileByIdPath, h.GetBashFileById)
-		group.GET(getBashListPath, h.GetBashList)
-		group.POST(createBashPath, h.CreateBash)
-		group.POST(execBashListPath, h.ExecBashList)
-		group.DELETE(removeBashPath, h.RemoveBashById)
+		group.GET(getBashByIdPath, api.RequirePermission(model.PermissionBashRead), h.GetBashById)
+		group.GET(getBashFileByIdPath, api.RequirePermission(model.PermissionBashRead), h.GetBashFileById)
+		group.GET(getBashListPath, api.RequirePermission(model.PermissionBashRead), h.GetBashList)
+		group.POST(
+			createBashPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashCreate),
+			api.RequirePermission(model.PermissionBashWrite),
+			h.CreateBash,
+		)
+		group.POST(
+			execBashListPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashExecute),
+			api.RequirePermission(model.PermissionBashExecute),
+			h.ExecBashList,
+		)
+		group.POST(
+			execBashInlinePath,
+			api.Audit(h.auditUseCase, model.AuditActionBashExecuteInline),
+			api.RequirePermission(model.PermissionBashExecute),
+			api.RequirePermission(model.PermissionBashExecuteInline),
+			h.ExecBashInline,
+		)
+		group.DELETE(
+			removeBashPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashDelete),
+			api.RequirePermission(model.PermissionBashWrite),
+			h.RemoveBashById,
+		)
 	}
 }
 
@@ -62,20 +95,22 @@ func (h *BashHandler) Register(rg *gin.RouterGroup) {
 // @Produce json
 // @Success 200 {object} model.Bash
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param id path string true "ID of bash script"
+// @Security BearerAuth
 // @Router /bash/{id} [get]
 func (h *BashHandler) GetBashById(c *gin.Context) {
 	bashId, err := uuid.FromString(c.Param("id"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashId)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
 		return
 	}
 
-	bash, err := h.useCase.GetBashById(bashId)
+	bash, err := h.useCase.GetBashById(c.Request.Context(), bashId)
 	if err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
@@ -89,20 +124,22 @@ func (h *BashHandler) GetBashById(c *gin.Context) {
 // @Produce x-www-form-urlencoded
 // @Success 200 {file} binary
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param id path string true "ID of bash script"
+// @Security BearerAuth
 // @Router /bash/{id}/file [get]
 func (h *BashHandler) GetBashFileById(c *gin.Context) {
 	bashId, err := uuid.FromString(c.Param("id"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashId)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
 		return
 	}
 
-	bashFileBuffer, bashTitle, err := h.useCase.GetBashFileBufferById(bashId)
+	bashFileBuffer, bashTitle, err := h.useCase.GetBashFileBufferById(c.Request.Context(), bashId)
 	if err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
@@ -121,47 +158,92 @@ func (h *BashHandler) GetBashFileById(c *gin.Context) {
 // GetBashList
 // @Summary Get list
 // @Tags Bash
-// @Description Get list of bash scripts
+// @Description Get list of bash scripts.
+// @Description With the cursor param the keyset page ordered by creation time is returned: items, limit and the next and prev tokens instead of offset and total.
 // @Produce json
 // @Success 200 {object} schema.BashPaginationPage
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param limit query int true "Limit param of pagination" default(20)
-// @Param offset query int true "Offset param of pagination" default(0)
+// @Param offset query int false "Offset param of pagination, required without cursor" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Param cursor query string false "Next or prev token of keyset pagination, empty for the first page"
+// @Param sort query string false "Comma-separated fields to sort by: title, createdAt, with - for descending order, not allowed with cursor since keyset pages are ordered by createdAt" example(-createdAt,title)
+// @Param title query string false "Filter by the title"
+// @Param title[ne] query string false "Filter by the title not equal to"
+// @Param title[like] query string false "Filter by the title containing the substring, case-insensitive"
+// @Param createdAt[gte] query string false "Filter by the creation time from, RFC 3339" format(date-time)
+// @Param createdAt[lt] query string false "Filter by the creation time before, RFC 3339" format(date-time)
+// @Security BearerAuth
 // @Router /bash/list [get]
 func (h *BashHandler) GetBashList(c *gin.Context) {
+	listParams, fieldErrors := dto.BashListSchema.Parse(c.Request.URL.Query())
+	if len(fieldErrors) > 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), schema.WithFieldErrors(h.httpErrors.Validate, fieldErrors))
+		api.RenderError(c, httpError)
+		return
+	}
+	filter := dto.BashFilter{Query: listParams}
+
 	limit, err := strconv.Atoi(c.Query("limit"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationLimitParamMustBeInt)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
 		return
 	}
 	if limit < 0 {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationLimitParamGTEZero)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	keysetParams, err := getKeysetParams(c, limit, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+	if keysetParams != nil {
+		bashList, err := h.useCase.GetBashKeysetPage(c.Request.Context(), filter, *keysetParams)
+		if err != nil {
+			httpError := h.helper.ParseError(c.Request.Context(), err)
+			api.RenderError(c, httpError)
+			return
+		}
+
+		c.JSON(http.StatusOK, bashList)
 		return
 	}
 
 	offset, err := strconv.Atoi(c.Query("offset"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationOffsetParamMustBeInt)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
 		return
 	}
 	if offset < 0 {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationOffsetParamGTEZero)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
 	paginationParams := pagination.LimitOffsetParams{
-		Limit:  limit,
-		Offset: offset,
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
 	}
 
-	bashList, err := h.useCase.GetBashPaginationPage(paginationParams)
+	bashList, err := h.useCase.GetBashPaginationPage(c.Request.Context(), filter, paginationParams)
 	if err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
@@ -176,22 +258,25 @@ func (h *BashHandler) GetBashList(c *gin.Context) {
 // @Produce json
 // @Success 200 {object} model.Bash
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param file formData file true "Bash script file"
+// @Security BearerAuth
 // @Router /bash [post]
 func (h *BashHandler) CreateBash(c *gin.Context) {
 	file, err := c.FormFile("file")
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashFileUpload)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashFileUpload)
+		api.RenderError(c, httpError)
 		return
 	}
 
-	bash, err := h.useCase.CreateBash(file)
+	bash, err := h.useCase.CreateBash(c.Request.Context(), file)
 	if err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
+	api.AddAuditTargets(c, bash.Id.String())
 
 	c.JSON(http.StatusOK, bash)
 }
@@ -199,37 +284,129 @@ func (h *BashHandler) CreateBash(c *gin.Context) {
 // ExecBashList
 // @Summary Execute List
 // @Tags Bash
-// @Description Execute list of bash scripts
+// @Description Execute list of bash scripts.
+// @Description A repeated request with the same Idempotency-Key returns the runs of the original request instead of starting new ones.
 // @Accept json
 // @Produce json
-// @Success 200 {object} schema.Message
+// @Success 200 {object} schema.ExecBashList
+// @Failure 409 {object} schema.HTTPError
+// @Failure 422 {object} schema.HTTPError
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param isSync query bool true "Execute type: if true, then in a multithreading, otherwise in a single thread"
+// @Param Idempotency-Key header string false "Unique key of the request to safely retry it"
 // @Param execute body []dto.ExecBash true "List of execute bash script models"
+// @Security BearerAuth
 // @Router /bash/execute/list [post]
 func (h *BashHandler) ExecBashList(c *gin.Context) {
 	isSync, err := strconv.ParseBool(c.Query("isSync"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashExecuteIsSync)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashExecuteIsSync)
+		api.RenderError(c, httpError)
 		return
 	}
 
 	execBashDTOList := make([]dto.ExecBash, 0)
 
 	if err := c.ShouldBindJSON(&execBashDTOList); err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashExecuteDTOList)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashExecuteDTOList)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	for _, execBashDTO := range execBashDTOList {
+		api.AddAuditTargets(c, execBashDTO.Id.String())
+	}
+
+	// The run ids and the idempotency key are stored even if the client goes away during a sync execution.
+	runIds, err := h.useCase.ExecBashList(
+		context.WithoutCancel(c.Request.Context()),
+		api.GetPrincipal(c),
+		isSync,
+		execBashDTOList,
+		c.GetHeader(idempotencyKeyHeader),
+	)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
-	if err := h.useCase.ExecBashList(isSync, execBashDTOList); err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+	for _, runId := range runIds {
+		api.AddAuditTargets(c, runId.String())
+	}
+
+	c.JSON(http.StatusOK, schema.ExecBashList{Message: msg.OK, RunIds: runIds})
+}
+
+// ExecBashInline
+// @Summary Execute Inline
+// @Tags Bash
+// @Description Execute inline bash script without saving it, the run and its logs are removed after the retention period.
+// @Description Requires the bash:execute-inline permission of the admin role, since the access lists of the scripts do not cover inline scripts.
+// @Description Accepts a JSON body or a multipart form with the script file and optional timeoutSeconds, repeated args and repeated env fields in KEY=VALUE form.
+// @Accept json,mpfd
+// @Produce json
+// @Success 200 {object} model.BashRun
+// @Failure 422 {object} schema.HTTPError
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param execute body dto.ExecBashInline true "Execute inline bash script model"
+// @Security BearerAuth
+// @Router /bash/execute/inline [post]
+func (h *BashHandler) ExecBashInline(c *gin.Context) {
+	var (
+		execBashInlineDTO dto.ExecBashInline
+		file              *multipart.FileHeader
+	)
+
+	if c.ContentType() == binding.MIMEMultipartPOSTForm {
+		formFile, err := c.FormFile("file")
+		if err != nil {
+			httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashFileUpload)
+			api.RenderError(c, httpError)
+			return
+		}
+		file = formFile
+
+		if rawTimeout := c.PostForm("timeoutSeconds"); rawTimeout != "" {
+			timeout, err := strconv.Atoi(rawTimeout)
+			if err != nil {
+				httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashExecuteInlineDTO)
+				api.RenderError(c, httpError)
+				return
+			}
+			execBashInlineDTO.TimeoutSeconds = time.Duration(timeout)
+		}
+
+		execBashInlineDTO.Args = c.PostFormArray("args")
+
+		rawEnv := c.PostFormArray("env")
+		execBashInlineDTO.Env = make(map[string]string, len(rawEnv))
+		for _, variable := range rawEnv {
+			name, value, ok := strings.Cut(variable, "=")
+			if !ok {
+				httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashExecuteInlineDTO)
+				api.RenderError(c, httpError)
+				return
+			}
+			execBashInlineDTO.Env[name] = value
+		}
+	} else if err := c.ShouldBindJSON(&execBashInlineDTO); err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashExecuteInlineDTO)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashRun, err := h.useCase.ExecBashInline(c.Request.Context(), execBashInlineDTO, file)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
+	api.AddAuditTargets(c, bashRun.Id.String())
 
-	c.JSON(http.StatusOK, schema.Message{Message: msg.OK})
+	c.JSON(http.StatusOK, bashRun)
 }
 
 // RemoveBashById
@@ -239,20 +416,22 @@ func (h *BashHandler) ExecBashList(c *gin.Context) {
 // @Produce json
 // @Success 200 {object} model.Bash
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param id path string true "ID of bash script"
+// @Security BearerAuth
 // @Router /bash/{id} [delete]
 func (h *BashHandler) RemoveBashById(c *gin.Context) {
 	bashId, err := uuid.FromString(c.Param("id"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashId)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
 		return
 	}
 
-	bash, err := h.useCase.RemoveBashById(bashId)
+	bash, err := h.useCase.RemoveBashById(c.Request.Context(), bashId)
 	if err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
@@ -261,8 +440,9 @@ func (h *BashHandler) RemoveBashById(c *gin.Context) {
 
 func GetBashHandler() api.IHandler {
 	return &BashHandler{
-		useCase:    usecase.GeBashUseCase(),
-		helper:     api.GetHelper(),
-		httpErrors: config.GetHTTPErrors(),
+		useCase:      usecase.GeBashUseCase(),
+		auditUseCase: usecase.GetAuditUseCase(),
+		helper:       api.GetHelper(),
+		httpErrors:   config.GetHTTPErrors(),
 	}
 }
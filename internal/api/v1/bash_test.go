@@ -8,6 +8,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path"
 	mock_api "pg-sh-scripts/internal/api/mock"
@@ -18,9 +19,11 @@ import (
 	"pg-sh-scripts/internal/type/alias"
 	mock_usecase "pg-sh-scripts/internal/usecase/mock"
 	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/validation"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 
@@ -62,7 +65,7 @@ func TestBashHandler_GetBashById(t *testing.T) {
 				httpErr: nil,
 			},
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, err error) {
-				mu.EXPECT().GetBashById(bashId).Return(&model.Bash{}, nil)
+				mu.EXPECT().GetBashById(gomock.Any(), bashId).Return(&model.Bash{}, nil)
 			},
 			expected: expectedStruct{
 				golden: "default_bash",
@@ -78,7 +81,7 @@ func TestBashHandler_GetBashById(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "bash_id_error",
@@ -96,8 +99,8 @@ func TestBashHandler_GetBashById(t *testing.T) {
 				errors.As(err, &httpErr)
 
 				gomock.InOrder(
-					mu.EXPECT().GetBashById(bashId).Return(nil, err),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mu.EXPECT().GetBashById(gomock.Any(), bashId).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -179,7 +182,7 @@ func TestBashHandler_GetBashFileById(t *testing.T) {
 				httpErr: nil,
 			},
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, err error) {
-				mu.EXPECT().GetBashFileBufferById(bashId).Return(&bytes.Buffer{}, "", nil)
+				mu.EXPECT().GetBashFileBufferById(gomock.Any(), bashId).Return(&bytes.Buffer{}, "", nil)
 			},
 			expected: expectedStruct{
 				header: http.Header{
@@ -200,7 +203,7 @@ func TestBashHandler_GetBashFileById(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				header: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
@@ -219,8 +222,8 @@ func TestBashHandler_GetBashFileById(t *testing.T) {
 				errors.As(err, &httpErr)
 
 				gomock.InOrder(
-					mu.EXPECT().GetBashFileBufferById(bashId).Return(nil, "", err),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mu.EXPECT().GetBashFileBufferById(gomock.Any(), bashId).Return(nil, "", err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -279,6 +282,7 @@ func TestBashHandler_GetBashList(t *testing.T) {
 	type (
 		inStruct struct {
 			paginationParams pagination.LimitOffsetParams
+			totalMode        string
 			httpErr          error
 			limitExists      bool
 			offsetExists     bool
@@ -291,6 +295,8 @@ func TestBashHandler_GetBashList(t *testing.T) {
 	)
 
 	httpErrors := config.GetHTTPErrors()
+	listParams, _ := dto.BashListSchema.Parse(url.Values{})
+	defaultFilter := dto.BashFilter{Query: listParams}
 
 	testCases := []struct {
 		name         string
@@ -308,6 +314,8 @@ func TestBashHandler_GetBashList(t *testing.T) {
 			},
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
 				mu.EXPECT().GetBashPaginationPage(
+					gomock.Any(),
+					defaultFilter,
 					paginationParams,
 				).Return(
 					alias.BashLimitOffsetPage{},
@@ -319,6 +327,37 @@ func TestBashHandler_GetBashList(t *testing.T) {
 				code:   http.StatusOK,
 			},
 		},
+		{
+			name: "Success without total",
+			in: inStruct{
+				paginationParams: pagination.LimitOffsetParams{
+					Limit:     1,
+					TotalMode: pagination.TotalModeNone,
+				},
+				totalMode:    "none",
+				httpErr:      nil,
+				limitExists:  true,
+				offsetExists: true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
+				mu.EXPECT().GetBashPaginationPage(
+					gomock.Any(),
+					defaultFilter,
+					paginationParams,
+				).Return(
+					alias.BashLimitOffsetPage{
+						Limit:     paginationParams.Limit,
+						TotalMode: pagination.TotalModeNone,
+						HasMore:   true,
+					},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "none_total_pagination_page",
+				code:   http.StatusOK,
+			},
+		},
 		{
 			name: "Limit param must be int error",
 			in: inStruct{
@@ -330,7 +369,7 @@ func TestBashHandler_GetBashList(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_limit_param_int_error",
@@ -350,7 +389,7 @@ func TestBashHandler_GetBashList(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_limit_param_gte_zero_error",
@@ -368,7 +407,7 @@ func TestBashHandler_GetBashList(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_offset_param_int_error",
@@ -388,13 +427,32 @@ func TestBashHandler_GetBashList(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_offset_param_gte_zero_error",
 				code:   http.StatusUnprocessableEntity,
 			},
 		},
+		{
+			name: "Total mode param error",
+			in: inStruct{
+				paginationParams: pagination.LimitOffsetParams{},
+				totalMode:        "count",
+				httpErr:          httpErrors.PaginationTotalModeParam,
+				limitExists:      true,
+				offsetExists:     true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "pagination_total_mode_param_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
 		{
 			name: "Getting bash pagination page error",
 			in: inStruct{
@@ -409,12 +467,14 @@ func TestBashHandler_GetBashList(t *testing.T) {
 
 				gomock.InOrder(
 					mu.EXPECT().GetBashPaginationPage(
+						gomock.Any(),
+						defaultFilter,
 						paginationParams,
 					).Return(
 						alias.BashLimitOffsetPage{},
 						err,
 					),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -461,6 +521,230 @@ func TestBashHandler_GetBashList(t *testing.T) {
 			if testCase.in.offsetExists {
 				requestQueryParams.Add("offset", strconv.Itoa(testCase.in.paginationParams.Offset))
 			}
+			if testCase.in.totalMode != "" {
+				requestQueryParams.Add("totalMode", testCase.in.totalMode)
+			}
+			request.URL.RawQuery = requestQueryParams.Encode()
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(bashTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestBashHandler_GetBashList_Keyset(t *testing.T) {
+	type (
+		inStruct struct {
+			paginationParams pagination.KeysetParams
+			cursor           string
+			sort             string
+			httpErr          error
+			offsetExists     bool
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+	listParams, _ := dto.BashListSchema.Parse(url.Values{})
+	defaultFilter := dto.BashFilter{Query: listParams}
+	cursor := &pagination.Cursor{
+		CreatedAt: time.Date(2024, 4, 14, 15, 50, 21, 907561000, time.UTC),
+		Id:        "59628b82-356c-4745-bc81-187015cde387",
+	}
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIBashUseCase, *mock_api.MockIHelper, pagination.KeysetParams, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "First page",
+			in: inStruct{
+				paginationParams: pagination.KeysetParams{Limit: 20},
+				cursor:           "",
+				httpErr:          nil,
+				offsetExists:     false,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.KeysetParams, err error) {
+				mu.EXPECT().GetBashKeysetPage(
+					gomock.Any(),
+					defaultFilter,
+					paginationParams,
+				).Return(
+					alias.BashKeysetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_keyset_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Next page",
+			in: inStruct{
+				paginationParams: pagination.KeysetParams{Limit: 20, Cursor: cursor},
+				cursor:           cursor.Encode(),
+				httpErr:          nil,
+				offsetExists:     false,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.KeysetParams, err error) {
+				mu.EXPECT().GetBashKeysetPage(
+					gomock.Any(),
+					defaultFilter,
+					paginationParams,
+				).Return(
+					alias.BashKeysetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_keyset_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Cursor param error",
+			in: inStruct{
+				paginationParams: pagination.KeysetParams{Limit: 20},
+				cursor:           "cursor",
+				httpErr:          httpErrors.PaginationCursorParam,
+				offsetExists:     false,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.KeysetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "pagination_cursor_param_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Cursor with offset error",
+			in: inStruct{
+				paginationParams: pagination.KeysetParams{Limit: 20},
+				cursor:           "",
+				httpErr:          httpErrors.PaginationCursorWithOffset,
+				offsetExists:     true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.KeysetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "pagination_cursor_with_offset_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Sort with cursor error",
+			in: inStruct{
+				paginationParams: pagination.KeysetParams{Limit: 20},
+				cursor:           "",
+				sort:             "-createdAt",
+				httpErr: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "sort", Reason: "excluded_with=cursor", Value: "-createdAt"},
+				}),
+				offsetExists: false,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.KeysetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "pagination_cursor_with_sort_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Getting bash keyset page error",
+			in: inStruct{
+				paginationParams: pagination.KeysetParams{Limit: 20},
+				cursor:           "",
+				httpErr:          httpErrors.BashGetPaginationPage,
+				offsetExists:     false,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, paginationParams pagination.KeysetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().GetBashKeysetPage(
+						gomock.Any(),
+						defaultFilter,
+						paginationParams,
+					).Return(
+						alias.BashKeysetPage{},
+						err,
+					),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "get_keyset_page_error",
+				code:   http.StatusBadRequest,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashUseCase := mock_usecase.NewMockIBashUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(
+				mockBashUseCase,
+				mockApiHelper,
+				testCase.in.paginationParams,
+				testCase.in.httpErr,
+			)
+
+			bashHandler := BashHandler{
+				useCase:    mockBashUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupBashPath + getBashListPath
+
+			r := gin.New()
+			r.GET(handlerPath, bashHandler.GetBashList)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, handlerPath, nil)
+
+			requestQueryParams := request.URL.Query()
+			requestQueryParams.Add("limit", strconv.Itoa(testCase.in.paginationParams.Limit))
+			requestQueryParams.Add("cursor", testCase.in.cursor)
+			if testCase.in.offsetExists {
+				requestQueryParams.Add("offset", "0")
+			}
+			if testCase.in.sort != "" {
+				requestQueryParams.Add("sort", testCase.in.sort)
+			}
 			request.URL.RawQuery = requestQueryParams.Encode()
 
 			r.ServeHTTP(recorder, request)
@@ -507,7 +791,7 @@ func TestBashHandler_CreateBash(t *testing.T) {
 				httpErr:      nil,
 			},
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, err error) {
-				mu.EXPECT().CreateBash(gomock.Any()).Return(&model.Bash{}, nil)
+				mu.EXPECT().CreateBash(gomock.Any(), gomock.Any()).Return(&model.Bash{}, nil)
 			},
 			expected: expectedStruct{
 				golden: "default_bash",
@@ -523,7 +807,7 @@ func TestBashHandler_CreateBash(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "upload_file_error",
@@ -541,8 +825,8 @@ func TestBashHandler_CreateBash(t *testing.T) {
 				errors.As(err, &httpErr)
 
 				gomock.InOrder(
-					mu.EXPECT().CreateBash(gomock.Any()).Return(nil, err),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mu.EXPECT().CreateBash(gomock.Any(), gomock.Any()).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -633,11 +917,12 @@ func TestBashHandler_CreateBash(t *testing.T) {
 func TestBashHandler_ExecBashList(t *testing.T) {
 	type (
 		inStruct struct {
-			isSync       bool
-			dto          []dto.ExecBash
-			httpErr      error
-			isSyncExists bool
-			isDTOExists  bool
+			isSync         bool
+			dto            []dto.ExecBash
+			idempotencyKey string
+			httpErr        error
+			isSyncExists   bool
+			isDTOExists    bool
 		}
 
 		expectedStruct struct {
@@ -647,11 +932,12 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 	)
 
 	httpErrors := config.GetHTTPErrors()
+	runIds := []uuid.UUID{uuid.FromStringOrNil("7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90")}
 
 	testCases := []struct {
 		name         string
 		in           inStruct
-		mockBehavior func(*mock_usecase.MockIBashUseCase, *mock_api.MockIHelper, bool, []dto.ExecBash, error)
+		mockBehavior func(*mock_usecase.MockIBashUseCase, *mock_api.MockIHelper, bool, []dto.ExecBash, string, error)
 		expected     expectedStruct
 	}{
 		{
@@ -663,14 +949,56 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 				isSyncExists: true,
 				isDTOExists:  true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, err error) {
-				mu.EXPECT().ExecBashList(isSync, dto).Return(nil)
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
+				mu.EXPECT().ExecBashList(gomock.Any(), gomock.Nil(), isSync, dto, key).Return(runIds, nil)
 			},
 			expected: expectedStruct{
 				golden: "exec_scripts",
 				code:   http.StatusOK,
 			},
 		},
+		{
+			name: "Success with idempotency key",
+			in: inStruct{
+				isSync:         true,
+				dto:            make([]dto.ExecBash, 1),
+				idempotencyKey: "deploy-1",
+				httpErr:        nil,
+				isSyncExists:   true,
+				isDTOExists:    true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
+				mu.EXPECT().ExecBashList(gomock.Any(), gomock.Nil(), isSync, dto, key).Return(runIds, nil)
+			},
+			expected: expectedStruct{
+				golden: "exec_scripts",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Idempotency key mismatch error",
+			in: inStruct{
+				isSync:         true,
+				dto:            make([]dto.ExecBash, 1),
+				idempotencyKey: "deploy-1",
+				httpErr:        httpErrors.IdempotencyKeyMismatch,
+				isSyncExists:   true,
+				isDTOExists:    true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().ExecBashList(gomock.Any(), gomock.Nil(), isSync, dto, key).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "idempotency_key_mismatch_error",
+				code:   http.StatusConflict,
+			},
+		},
 		{
 			name: "Validation isSync param error",
 			in: inStruct{
@@ -680,10 +1008,10 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 				isSyncExists: false,
 				isDTOExists:  true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "bash_execute_is_sync_error",
@@ -699,10 +1027,10 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 				isSyncExists: true,
 				isDTOExists:  false,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "bash_execute_dto_list_error",
@@ -718,13 +1046,13 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 				isSyncExists: true,
 				isDTOExists:  true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
 
 				gomock.InOrder(
-					mu.EXPECT().ExecBashList(isSync, dto).Return(err),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mu.EXPECT().ExecBashList(gomock.Any(), gomock.Nil(), isSync, dto, key).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -732,6 +1060,32 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 				code:   http.StatusBadRequest,
 			},
 		},
+		{
+			name: "Validation fields error",
+			in: inStruct{
+				isSync: true,
+				dto:    []dto.ExecBash{{TimeoutSeconds: -1}},
+				httpErr: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "$[0].id", Reason: "required", Value: uuid.Nil},
+					{Field: "$[0].timeoutSeconds", Reason: "min=0", Value: time.Duration(-1)},
+				}),
+				isSyncExists: true,
+				isDTOExists:  true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, isSync bool, dto []dto.ExecBash, key string, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().ExecBashList(gomock.Any(), gomock.Nil(), isSync, dto, key).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "validate_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
 	}
 
 	gin.SetMode(gin.TestMode)
@@ -748,6 +1102,7 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 				mockApiHelper,
 				testCase.in.isSync,
 				testCase.in.dto,
+				testCase.in.idempotencyKey,
 				testCase.in.httpErr,
 			)
 
@@ -773,6 +1128,9 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 			request := httptest.NewRequest(http.MethodPost, handlerPath, &b)
+			if testCase.in.idempotencyKey != "" {
+				request.Header.Add(idempotencyKeyHeader, testCase.in.idempotencyKey)
+			}
 
 			if testCase.in.isSyncExists {
 				requestQueryParams := request.URL.Query()
@@ -796,6 +1154,219 @@ func TestBashHandler_ExecBashList(t *testing.T) {
 	}
 }
 
+func TestBashHandler_ExecBashInline(t *testing.T) {
+	type (
+		inStruct struct {
+			dto         dto.ExecBashInline
+			rawBody     string
+			rawEnv      []string
+			isMultipart bool
+			httpErr     error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIBashUseCase, *mock_api.MockIHelper, dto.ExecBashInline, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				dto: dto.ExecBashInline{
+					Body:           "echo $GREETING $1",
+					TimeoutSeconds: 10,
+					Args:           []string{"world"},
+					Env:            map[string]string{"GREETING": "hello"},
+				},
+				httpErr: nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, dto dto.ExecBashInline, err error) {
+				mu.EXPECT().ExecBashInline(gomock.Any(), dto, nil).Return(&model.BashRun{}, nil)
+			},
+			expected: expectedStruct{
+				golden: "default_bash_run",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Success multipart",
+			in: inStruct{
+				dto: dto.ExecBashInline{
+					TimeoutSeconds: 10,
+					Args:           []string{"world"},
+					Env:            map[string]string{"GREETING": "hello"},
+				},
+				rawEnv:      []string{"GREETING=hello"},
+				isMultipart: true,
+				httpErr:     nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, dto dto.ExecBashInline, err error) {
+				mu.EXPECT().ExecBashInline(gomock.Any(), dto, gomock.Not(nil)).Return(&model.BashRun{}, nil)
+			},
+			expected: expectedStruct{
+				golden: "default_bash_run",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Validation exec body error",
+			in: inStruct{
+				rawBody: "[]",
+				httpErr: httpErrors.BashExecuteInlineDTO,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, dto dto.ExecBashInline, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "bash_execute_inline_dto_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Validation multipart env error",
+			in: inStruct{
+				rawEnv:      []string{"GREETING"},
+				isMultipart: true,
+				httpErr:     httpErrors.BashExecuteInlineDTO,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, dto dto.ExecBashInline, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "bash_execute_inline_dto_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Executing bash error",
+			in: inStruct{
+				dto: dto.ExecBashInline{
+					Body: "echo $1",
+				},
+				httpErr: httpErrors.BashExecute,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, dto dto.ExecBashInline, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().ExecBashInline(gomock.Any(), dto, nil).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "executing_bash_error",
+				code:   http.StatusBadRequest,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashUseCase := mock_usecase.NewMockIBashUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(
+				mockBashUseCase,
+				mockApiHelper,
+				testCase.in.dto,
+				testCase.in.httpErr,
+			)
+
+			bashHandler := BashHandler{
+				useCase:    mockBashUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupBashPath + execBashInlinePath
+
+			r := gin.New()
+			r.POST(handlerPath, bashHandler.ExecBashInline)
+
+			var (
+				b           bytes.Buffer
+				contentType = "application/json"
+			)
+
+			if testCase.in.isMultipart {
+				w := multipart.NewWriter(&b)
+
+				fw, err := w.CreateFormFile("file", bashTestFile)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+				if _, err := fw.Write([]byte("echo $GREETING $1")); err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				if testCase.in.dto.TimeoutSeconds > 0 {
+					timeout := strconv.Itoa(int(testCase.in.dto.TimeoutSeconds))
+					if err := w.WriteField("timeoutSeconds", timeout); err != nil {
+						t.Fatalf("%s Error: %s", t.Name(), err)
+					}
+				}
+				for _, arg := range testCase.in.dto.Args {
+					if err := w.WriteField("args", arg); err != nil {
+						t.Fatalf("%s Error: %s", t.Name(), err)
+					}
+				}
+				for _, variable := range testCase.in.rawEnv {
+					if err := w.WriteField("env", variable); err != nil {
+						t.Fatalf("%s Error: %s", t.Name(), err)
+					}
+				}
+				if err := w.Close(); err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+				contentType = w.FormDataContentType()
+			} else if testCase.in.rawBody != "" {
+				b.WriteString(testCase.in.rawBody)
+			} else {
+				body, err := json.Marshal(testCase.in.dto)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+				b.Write(body)
+			}
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPost, handlerPath, &b)
+			request.Header.Add("Content-Type", contentType)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(bashTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
 func TestBashHandler_RemoveBashById(t *testing.T) {
 	type (
 		inStruct struct {
@@ -824,7 +1395,7 @@ func TestBashHandler_RemoveBashById(t *testing.T) {
 				httpErr: nil,
 			},
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, err error) {
-				mu.EXPECT().RemoveBashById(bashId).Return(&model.Bash{}, nil)
+				mu.EXPECT().RemoveBashById(gomock.Any(), bashId).Return(&model.Bash{}, nil)
 			},
 			expected: expectedStruct{
 				golden: "default_bash",
@@ -840,7 +1411,7 @@ func TestBashHandler_RemoveBashById(t *testing.T) {
 			mockBehavior: func(mu *mock_usecase.MockIBashUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "bash_id_error",
@@ -858,8 +1429,8 @@ func TestBashHandler_RemoveBashById(t *testing.T) {
 				errors.As(err, &httpErr)
 
 				gomock.InOrder(
-					mu.EXPECT().RemoveBashById(bashId).Return(nil, err),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mu.EXPECT().RemoveBashById(gomock.Any(), bashId).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	groupBashAclPath           = "/bash"
+	getBashAclListByBashIdPath = "/:id/acl/list"
+	createBashAclPath          = "/:id/acl"
+	removeBashAclPath          = "/:id/acl/:aclId"
+)
+
+type (
+	IBashAclHandler interface {
+		GetBashAclListByBashId(c *gin.Context)
+		CreateBashAcl(c *gin.Context)
+		RemoveBashAclById(c *gin.Context)
+	}
+
+	BashAclHandler struct {
+		useCase      usecase.IBashAclUseCase
+		auditUseCase usecase.IAuditUseCase
+		helper       api.IHelper
+		httpErrors   *config.HTTPErrors
+	}
+)
+
+func (h *BashAclHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupBashAclPath)
+	{
+		group.GET(
+			getBashAclListByBashIdPath,
+			api.RequirePermission(model.PermissionBashAclManage),
+			h.GetBashAclListByBashId,
+		)
+		group.POST(
+			createBashAclPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashAclCreate),
+			api.RequirePermission(model.PermissionBashAclManage),
+			h.CreateBashAcl,
+		)
+		group.DELETE(
+			removeBashAclPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashAclDelete),
+			api.RequirePermission(model.PermissionBashAclManage),
+			h.RemoveBashAclById,
+		)
+	}
+}
+
+// GetBashAclListByBashId
+// @Summary Get acl list by bash id
+// @Tags Bash Acl
+// @Description Get access control list of bash script, available only for admin
+// @Produce json
+// @Success 200 {array} model.BashAcl
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Security BearerAuth
+// @Router /bash/{id}/acl/list [get]
+func (h *BashAclHandler) GetBashAclListByBashId(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashAclList, err := h.useCase.GetBashAclListByBashId(c.Request.Context(), bashId)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashAclList)
+}
+
+// CreateBashAcl
+// @Summary Create acl entry
+// @Tags Bash Acl
+// @Description Allow the role or the subject (api key id) to execute bash script. A script with entries can be executed only by the listed roles and the more privileged ones, the listed subjects and admin. Available only for admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.BashAcl
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Param bashAcl body dto.CreateBashAcl true "Create bash acl entry model, either role or subject"
+// @Security BearerAuth
+// @Router /bash/{id}/acl [post]
+func (h *BashAclHandler) CreateBashAcl(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	var createBashAclDTO dto.CreateBashAcl
+
+	if err := c.ShouldBindJSON(&createBashAclDTO); err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashAclCreateDTO)
+		api.RenderError(c, httpError)
+		return
+	}
+	createBashAclDTO.BashId = bashId
+
+	bashAcl, err := h.useCase.CreateBashAcl(c.Request.Context(), createBashAclDTO)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+	api.AddAuditTargets(c, bashAcl.Id.String())
+
+	c.JSON(http.StatusOK, bashAcl)
+}
+
+// RemoveBashAclById
+// @Summary Remove acl entry by id
+// @Tags Bash Acl
+// @Description Remove access control list entry of bash script by id, available only for admin
+// @Produce json
+// @Success 200 {object} model.BashAcl
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Param aclId path string true "ID of bash acl entry"
+// @Security BearerAuth
+// @Router /bash/{id}/acl/{aclId} [delete]
+func (h *BashAclHandler) RemoveBashAclById(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashAclId, err := uuid.FromString(c.Param("aclId"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashAclId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashAcl, err := h.useCase.RemoveBashAclById(c.Request.Context(), bashId, bashAclId)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashAcl)
+}
+
+func GetBashAclHandler() api.IHandler {
+	return &BashAclHandler{
+		useCase:      usecase.GetBashAclUseCase(),
+		auditUseCase: usecase.GetAuditUseCase(),
+		helper:       api.GetHelper(),
+		httpErrors:   config.GetHTTPErrors(),
+	}
+}
@@ -1,9 +1,13 @@
 package v1
 
 import (
+	"fmt"
 	"net/http"
 	"pg-sh-scripts/internal/api"
 	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
 	"pg-sh-scripts/internal/usecase"
 	"pg-sh-scripts/pkg/sql/pagination"
 	"strconv"
@@ -15,11 +19,16 @@ import (
 const (
 	groupBashLogPath           = "/bash/log"
 	getBashLogListByBashIdPath = "/:bashId/list"
+	exportBashLogByBashIdPath  = "/:bashId/export"
+	groupBashRunLogPath        = "/bash/run"
+	getBashLogListByRunIdPath  = "/:runId/log"
 )
 
 type (
 	IBashLogHandler interface {
-		GetBashLogUseCase(c *gin.Context)
+		GetBashLogListByBashId(c *gin.Context)
+		GetBashLogListByRunId(c *gin.Context)
+		ExportBashLogByBashId(c *gin.Context)
 	}
 
 	BashLogHandler struct {
@@ -32,68 +41,303 @@ type (
 func (h *BashLogHandler) Register(rg *gin.RouterGroup) {
 	group := rg.Group(groupBashLogPath)
 	{
-		group.GET(getBashLogListByBashIdPath, h.GetBashLogListByBashId)
+		group.GET(
+			getBashLogListByBashIdPath,
+			api.RequirePermission(model.PermissionBashLogRead),
+			h.GetBashLogListByBashId,
+		)
+		group.GET(
+			exportBashLogByBashIdPath,
+			api.RequirePermission(model.PermissionBashLogRead),
+			h.ExportBashLogByBashId,
+		)
+	}
+
+	runGroup := rg.Group(groupBashRunLogPath)
+	{
+		runGroup.GET(
+			getBashLogListByRunIdPath,
+			api.RequirePermission(model.PermissionBashLogRead),
+			h.GetBashLogListByRunId,
+		)
 	}
 }
 
+// parseBashLogFilter reads the run id, sort and filter params shared by the list and the export of bash logs.
+func (h *BashLogHandler) parseBashLogFilter(c *gin.Context) (dto.BashLogFilter, error) {
+	listParams, fieldErrors := dto.BashLogListSchema.Parse(c.Request.URL.Query())
+	if len(fieldErrors) > 0 {
+		return dto.BashLogFilter{}, schema.WithFieldErrors(h.httpErrors.Validate, fieldErrors)
+	}
+	filter := dto.BashLogFilter{Query: listParams}
+
+	if rawRunId := c.Query("runId"); rawRunId != "" {
+		runId, err := uuid.FromString(rawRunId)
+		if err != nil {
+			return filter, h.httpErrors.BashRunId
+		}
+		filter.RunId = &runId
+	}
+	return filter, nil
+}
+
 // GetBashLogListByBashId
 // @Summary Get list by bash id
 // @Tags Bash Log
-// @Description Get list of bash logs by bash id
+// @Description Get list of bash logs by bash id.
+// @Description With the cursor param the keyset page ordered by creation time is returned: items, limit and the next and prev tokens instead of offset and total.
 // @Produce json
 // @Success 200 {object} schema.BashLogPaginationPage
 // @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
 // @Param bashId path string true "ID of bash script"
 // @Param limit query int true "Limit param of pagination" default(20)
-// @Param offset query int true "Offset param of pagination" default(0)
+// @Param offset query int false "Offset param of pagination, required without cursor" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Param cursor query string false "Next or prev token of keyset pagination, empty for the first page"
+// @Param runId query string false "ID of bash run to filter logs by"
+// @Param sort query string false "Comma-separated fields to sort by: isError, createdAt, with - for descending order, not allowed with cursor since keyset pages are ordered by createdAt" example(-createdAt)
+// @Param isError query bool false "Filter by the stream of the log: true for stderr, false for stdout"
+// @Param createdAt[gte] query string false "Filter by the creation time from, RFC 3339" format(date-time)
+// @Param createdAt[lt] query string false "Filter by the creation time before, RFC 3339" format(date-time)
+// @Param from query string false "Filter by the creation time from, inclusive, RFC 3339" format(date-time)
+// @Param to query string false "Filter by the creation time to, exclusive, RFC 3339" format(date-time)
+// @Param text query string false "Filter by the substring of the log body ignoring case"
+// @Param regex query string false "Filter by the POSIX regular expression of the log body, (?i) prefix ignores case"
+// @Security BearerAuth
 // @Router /bash/log/{bashId}/list [get]
 func (h *BashLogHandler) GetBashLogListByBashId(c *gin.Context) {
 	bashId, err := uuid.FromString(c.Param("bashId"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.BashId)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	filter, err := h.parseBashLogFilter(c)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
 	limit, err := strconv.Atoi(c.Query("limit"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationLimitParamMustBeInt)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
 		return
 	}
 	if limit < 0 {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationLimitParamGTEZero)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	keysetParams, err := getKeysetParams(c, limit, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+	if keysetParams != nil {
+		bashLogList, err := h.useCase.GetBashLogKeysetPageByBashId(
+			c.Request.Context(),
+			bashId,
+			filter,
+			*keysetParams,
+		)
+		if err != nil {
+			httpError := h.helper.ParseError(c.Request.Context(), err)
+			api.RenderError(c, httpError)
+			return
+		}
+
+		c.JSON(http.StatusOK, bashLogList)
 		return
 	}
 
 	offset, err := strconv.Atoi(c.Query("offset"))
 	if err != nil {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationOffsetParamMustBeInt)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
 		return
 	}
 	if offset < 0 {
-		httpError := h.helper.ParseError(h.httpErrors.PaginationOffsetParamGTEZero)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
 	paginationParams := pagination.LimitOffsetParams{
-		Limit:  limit,
-		Offset: offset,
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
 	}
 
-	bashLogList, err := h.useCase.GetBashLogPaginationPageByBashId(bashId, paginationParams)
+	bashLogList, err := h.useCase.GetBashLogPaginationPageByBashId(
+		c.Request.Context(),
+		bashId,
+		filter,
+		paginationParams,
+	)
 	if err != nil {
-		httpError := h.helper.ParseError(err)
-		c.JSON(httpError.HTTPCode, httpError)
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
 		return
 	}
 
 	c.JSON(http.StatusOK, bashLogList)
 }
 
+// GetBashLogListByRunId
+// @Summary Get list by run id
+// @Tags Bash Log
+// @Description Get list of bash logs of a single bash script run.
+// @Description With the cursor param the keyset page ordered by creation time is returned: items, limit and the next and prev tokens instead of offset and total.
+// @Produce json
+// @Success 200 {object} schema.BashLogPaginationPage
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param runId path string true "ID of bash run"
+// @Param limit query int true "Limit param of pagination" default(20)
+// @Param offset query int false "Offset param of pagination, required without cursor" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Param cursor query string false "Next or prev token of keyset pagination, empty for the first page"
+// @Security BearerAuth
+// @Router /bash/run/{runId}/log [get]
+func (h *BashLogHandler) GetBashLogListByRunId(c *gin.Context) {
+	runId, err := uuid.FromString(c.Param("runId"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashRunId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if limit < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	keysetParams, err := getKeysetParams(c, limit, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+	if keysetParams != nil {
+		bashLogList, err := h.useCase.GetBashLogKeysetPageByRunId(c.Request.Context(), runId, *keysetParams)
+		if err != nil {
+			httpError := h.helper.ParseError(c.Request.Context(), err)
+			api.RenderError(c, httpError)
+			return
+		}
+
+		c.JSON(http.StatusOK, bashLogList)
+		return
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if offset < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	paginationParams := pagination.LimitOffsetParams{
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
+	}
+
+	bashLogList, err := h.useCase.GetBashLogPaginationPageByRunId(c.Request.Context(), runId, paginationParams)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashLogList)
+}
+
+// ExportBashLogByBashId
+// @Summary Export by bash id
+// @Tags Bash Log
+// @Description Export all bash logs of the bash script matching the filters of the list as a file: txt lines with the time and the stream, newline delimited json or csv with a header.
+// @Produce plain
+// @Produce application/x-ndjson
+// @Produce text/csv
+// @Success 200 {file} binary
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param bashId path string true "ID of bash script"
+// @Param format query string false "Format of the file" Enums(txt, ndjson, csv) default(txt)
+// @Param runId query string false "ID of bash run to filter logs by"
+// @Param sort query string false "Comma-separated fields to sort by: isError, createdAt, with - for descending order" example(-createdAt)
+// @Param isError query bool false "Filter by the stream of the log: true for stderr, false for stdout"
+// @Param from query string false "Filter by the creation time from, inclusive, RFC 3339" format(date-time)
+// @Param to query string false "Filter by the creation time to, exclusive, RFC 3339" format(date-time)
+// @Param text query string false "Filter by the substring of the log body ignoring case"
+// @Param regex query string false "Filter by the POSIX regular expression of the log body, (?i) prefix ignores case"
+// @Security BearerAuth
+// @Router /bash/log/{bashId}/export [get]
+func (h *BashLogHandler) ExportBashLogByBashId(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("bashId"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	format := c.DefaultQuery("format", bashLogExportFormatTxt)
+	contentType, ok := bashLogExportContentTypes[format]
+	if !ok {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashLogExportFormat)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	filter, err := h.parseBashLogFilter(c)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"bash_log_%s.%s\"", bashId, format))
+	encoder := newBashLogEncoder(format, c.Writer)
+
+	api.RenderExport(c, h.helper, encoder, func(fn func(bashLog *model.BashLog) error) error {
+		return h.useCase.ExportBashLogByBashId(c.Request.Context(), bashId, filter, fn)
+	})
+}
+
 func GetBashLogHandler() api.IHandler {
 	return &BashLogHandler{
 		useCase:    usecase.GetBashLogUseCase(),
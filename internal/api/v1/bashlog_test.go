@@ -1,20 +1,27 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path"
 	mock_api "pg-sh-scripts/internal/api/mock"
 	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
 	"pg-sh-scripts/internal/schema"
 	"pg-sh-scripts/internal/type/alias"
 	mock_usecase "pg-sh-scripts/internal/usecase/mock"
 	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/sql/query"
+	"pg-sh-scripts/pkg/validation"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
@@ -28,6 +35,9 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 	type (
 		inStruct struct {
 			bashId           string
+			runId            string
+			listQuery        url.Values
+			listParams       *query.Params
 			paginationParams pagination.LimitOffsetParams
 			httpErr          error
 			limitExists      bool
@@ -41,11 +51,13 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 	)
 
 	httpErrors := config.GetHTTPErrors()
+	defaultListParams, _ := dto.BashLogListSchema.Parse(url.Values{})
+	createdAtFrom := time.Date(2024, 4, 14, 0, 0, 0, 0, time.UTC)
 
 	testCases := []struct {
 		name         string
 		in           inStruct
-		mockBehavior func(*mock_usecase.MockIBashLogUseCase, *mock_api.MockIHelper, uuid.UUID, pagination.LimitOffsetParams, error)
+		mockBehavior func(*mock_usecase.MockIBashLogUseCase, *mock_api.MockIHelper, uuid.UUID, dto.BashLogFilter, pagination.LimitOffsetParams, error)
 		expected     expectedStruct
 	}{
 		{
@@ -57,9 +69,11 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:      true,
 				offsetExists:     true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				mu.EXPECT().GetBashLogPaginationPageByBashId(
+					gomock.Any(),
 					bashId,
+					filter,
 					paginationParams,
 				).Return(
 					alias.BashLogLimitOffsetPage{},
@@ -80,16 +94,191 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:      true,
 				offsetExists:     true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "bash_id_error",
 				code:   http.StatusUnprocessableEntity,
 			},
 		},
+		{
+			name: "Success with run id filter",
+			in: inStruct{
+				bashId:           uuid.NewV4().String(),
+				runId:            uuid.NewV4().String(),
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          nil,
+				limitExists:      true,
+				offsetExists:     true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
+				mu.EXPECT().GetBashLogPaginationPageByBashId(
+					gomock.Any(),
+					bashId,
+					filter,
+					paginationParams,
+				).Return(
+					alias.BashLogLimitOffsetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_pagination_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Success with sort and filters",
+			in: inStruct{
+				bashId: uuid.NewV4().String(),
+				listQuery: url.Values{
+					"sort":           {"-isError,createdAt"},
+					"isError":        {"true"},
+					"createdAt[gte]": {createdAtFrom.Format(time.RFC3339)},
+				},
+				listParams: &query.Params{
+					Conditions: []query.Condition{
+						{Column: "created_at", Operator: query.OperatorGte, Value: createdAtFrom},
+						{Column: "is_error", Operator: query.OperatorEq, Value: true},
+					},
+					Orders: []query.Order{{Column: "is_error", Desc: true}, {Column: "created_at"}, {Column: "id"}},
+				},
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          nil,
+				limitExists:      true,
+				offsetExists:     true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
+				mu.EXPECT().GetBashLogPaginationPageByBashId(
+					gomock.Any(),
+					bashId,
+					filter,
+					paginationParams,
+				).Return(
+					alias.BashLogLimitOffsetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_pagination_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Success with time range and text filters",
+			in: inStruct{
+				bashId: uuid.NewV4().String(),
+				listQuery: url.Values{
+					"from":  {createdAtFrom.Format(time.RFC3339)},
+					"to":    {createdAtFrom.Add(24 * time.Hour).Format(time.RFC3339)},
+					"text":  {"timeout"},
+					"regex": {"^error: [0-9]+"},
+				},
+				listParams: &query.Params{
+					Conditions: []query.Condition{
+						{Column: "created_at", Operator: query.OperatorGte, Value: createdAtFrom},
+						{Column: "body", Operator: query.OperatorMatch, Value: "^error: [0-9]+"},
+						{Column: "body", Operator: query.OperatorLike, Value: "%timeout%"},
+						{Column: "created_at", Operator: query.OperatorLt, Value: createdAtFrom.Add(24 * time.Hour)},
+					},
+					Orders: []query.Order{{Column: "created_at"}, {Column: "id"}},
+				},
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          nil,
+				limitExists:      true,
+				offsetExists:     true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
+				mu.EXPECT().GetBashLogPaginationPageByBashId(
+					gomock.Any(),
+					bashId,
+					filter,
+					paginationParams,
+				).Return(
+					alias.BashLogLimitOffsetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_pagination_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Sort and filters validation error",
+			in: inStruct{
+				bashId: uuid.NewV4().String(),
+				listQuery: url.Values{
+					"sort":            {"body"},
+					"isError":         {"yes"},
+					"createdAt[like]": {"2024"},
+				},
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "createdAt[like]", Reason: "operator", Value: "2024"},
+					{Field: "isError", Reason: "boolean", Value: "yes"},
+					{Field: "sort", Reason: "oneof", Value: "body"},
+				}),
+				limitExists:  true,
+				offsetExists: true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "list_query_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Sort with cursor error",
+			in: inStruct{
+				bashId: uuid.NewV4().String(),
+				listQuery: url.Values{
+					"cursor": {""},
+					"sort":   {"-createdAt"},
+				},
+				paginationParams: pagination.LimitOffsetParams{Limit: 20},
+				httpErr: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "sort", Reason: "excluded_with=cursor", Value: "-createdAt"},
+				}),
+				limitExists: true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "pagination_cursor_with_sort_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Run id must be uuid error",
+			in: inStruct{
+				bashId:           uuid.NewV4().String(),
+				runId:            "uuid",
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          httpErrors.BashRunId,
+				limitExists:      true,
+				offsetExists:     true,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "bash_run_id_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
 		{
 			name: "Limit param must be int error",
 			in: inStruct{
@@ -99,10 +288,10 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:      false,
 				offsetExists:     true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_limit_param_int_error",
@@ -120,10 +309,10 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:  true,
 				offsetExists: true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_limit_param_gte_zero_error",
@@ -139,10 +328,10 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:      true,
 				offsetExists:     false,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_offset_param_int_error",
@@ -160,10 +349,10 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:  true,
 				offsetExists: true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
-				mh.EXPECT().ParseError(err).Return(httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
 			},
 			expected: expectedStruct{
 				golden: "pagination_offset_param_gte_zero_error",
@@ -179,18 +368,20 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 				limitExists:      true,
 				offsetExists:     true,
 			},
-			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams, err error) {
 				var httpErr *schema.HTTPError
 				errors.As(err, &httpErr)
 				gomock.InOrder(
 					mu.EXPECT().GetBashLogPaginationPageByBashId(
+						gomock.Any(),
 						bashId,
+						filter,
 						paginationParams,
 					).Return(
 						alias.BashLogLimitOffsetPage{},
 						err,
 					),
-					mh.EXPECT().ParseError(err).Return(httpErr),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
 				)
 			},
 			expected: expectedStruct{
@@ -210,10 +401,18 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 			mockBashLogUseCase := mock_usecase.NewMockIBashLogUseCase(ctrl)
 			mockApiHelper := mock_api.NewMockIHelper(ctrl)
 			uuidBashId, _ := uuid.FromString(testCase.in.bashId)
+			filter := dto.BashLogFilter{Query: defaultListParams}
+			if testCase.in.listParams != nil {
+				filter.Query = *testCase.in.listParams
+			}
+			if uuidRunId, err := uuid.FromString(testCase.in.runId); err == nil {
+				filter.RunId = &uuidRunId
+			}
 			testCase.mockBehavior(
 				mockBashLogUseCase,
 				mockApiHelper,
 				uuidBashId,
+				filter,
 				testCase.in.paginationParams,
 				testCase.in.httpErr,
 			)
@@ -240,6 +439,12 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 			if testCase.in.offsetExists {
 				requestQueryParams.Add("offset", strconv.Itoa(testCase.in.paginationParams.Offset))
 			}
+			if testCase.in.runId != "" {
+				requestQueryParams.Add("runId", testCase.in.runId)
+			}
+			for key, values := range testCase.in.listQuery {
+				requestQueryParams[key] = values
+			}
 			request.URL.RawQuery = requestQueryParams.Encode()
 
 			r.ServeHTTP(recorder, request)
@@ -257,3 +462,367 @@ func TestBashLogHandler_GetBashLogListByBashId(t *testing.T) {
 		})
 	}
 }
+
+func TestBashLogHandler_GetBashLogListByRunId(t *testing.T) {
+	type (
+		inStruct struct {
+			runId            string
+			paginationParams pagination.LimitOffsetParams
+			httpErr          error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIBashLogUseCase, *mock_api.MockIHelper, uuid.UUID, pagination.LimitOffsetParams, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				runId:            uuid.NewV4().String(),
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, runId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+				mu.EXPECT().GetBashLogPaginationPageByRunId(
+					gomock.Any(),
+					runId,
+					paginationParams,
+				).Return(
+					alias.BashLogLimitOffsetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				golden: "default_pagination_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Run id must be uuid error",
+			in: inStruct{
+				runId:            "uuid",
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          httpErrors.BashRunId,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, runId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "bash_run_id_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Bash run does not exists error",
+			in: inStruct{
+				runId:            uuid.NewV4().String(),
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          httpErrors.BashRunDoesNotExists,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, runId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				gomock.InOrder(
+					mu.EXPECT().GetBashLogPaginationPageByRunId(
+						gomock.Any(),
+						runId,
+						paginationParams,
+					).Return(
+						alias.BashLogLimitOffsetPage{},
+						err,
+					),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "bash_run_does_not_exists_error",
+				code:   http.StatusNotFound,
+			},
+		},
+		{
+			name: "Getting bash log pagination page by run id error",
+			in: inStruct{
+				runId:            uuid.NewV4().String(),
+				paginationParams: pagination.LimitOffsetParams{},
+				httpErr:          httpErrors.BashLogGetPaginationPageByRunId,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, runId uuid.UUID, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				gomock.InOrder(
+					mu.EXPECT().GetBashLogPaginationPageByRunId(
+						gomock.Any(),
+						runId,
+						paginationParams,
+					).Return(
+						alias.BashLogLimitOffsetPage{},
+						err,
+					),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "get_pagination_page_by_run_id_error",
+				code:   http.StatusBadRequest,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashLogUseCase := mock_usecase.NewMockIBashLogUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			uuidRunId, _ := uuid.FromString(testCase.in.runId)
+			testCase.mockBehavior(
+				mockBashLogUseCase,
+				mockApiHelper,
+				uuidRunId,
+				testCase.in.paginationParams,
+				testCase.in.httpErr,
+			)
+
+			bashLogHandler := BashLogHandler{
+				useCase:    mockBashLogUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupBashRunLogPath + getBashLogListByRunIdPath
+			handlerCasePath := strings.Replace(handlerPath, ":runId", testCase.in.runId, 1)
+
+			r := gin.New()
+			r.GET(handlerPath, bashLogHandler.GetBashLogListByRunId)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, handlerCasePath, nil)
+
+			requestQueryParams := request.URL.Query()
+			requestQueryParams.Add("limit", strconv.Itoa(testCase.in.paginationParams.Limit))
+			requestQueryParams.Add("offset", strconv.Itoa(testCase.in.paginationParams.Offset))
+			request.URL.RawQuery = requestQueryParams.Encode()
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(bashlogTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestBashLogHandler_ExportBashLogByBashId(t *testing.T) {
+	type (
+		inStruct struct {
+			query   string
+			httpErr error
+		}
+
+		expectedStruct struct {
+			golden             string
+			code               int
+			contentType        string
+			contentDisposition string
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+	defaultListParams, _ := dto.BashLogListSchema.Parse(url.Values{})
+
+	bashId := uuid.FromStringOrNil("59628b82-356c-4745-bc81-187015cde387")
+	runId := uuid.FromStringOrNil("7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90")
+	createdAt := time.Date(2024, 4, 14, 15, 50, 21, 907561000, time.UTC)
+	bashLogList := []*model.BashLog{
+		{
+			Id:        uuid.FromStringOrNil("f4f4d096-ef4a-4649-8346-a952e2ca27d3"),
+			BashId:    &bashId,
+			RunId:     &runId,
+			Body:      "deploy started",
+			IsError:   false,
+			CreatedAt: createdAt,
+		},
+		{
+			Id:        uuid.FromStringOrNil("0b8e7d7a-6c1b-4f3e-9d2a-5e4f3c2b1a09"),
+			BashId:    &bashId,
+			RunId:     &runId,
+			Body:      `error: "timeout", retrying`,
+			IsError:   true,
+			CreatedAt: createdAt.Add(time.Second),
+		},
+		{
+			Id:        uuid.FromStringOrNil("3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f"),
+			BashId:    &bashId,
+			RunId:     &runId,
+			Body:      `=HYPERLINK("http://example.com")`,
+			IsError:   false,
+			CreatedAt: createdAt.Add(2 * time.Second),
+		},
+	}
+
+	exportBashLogList := func(mu *mock_usecase.MockIBashLogUseCase) {
+		mu.EXPECT().ExportBashLogByBashId(gomock.Any(), bashId, dto.BashLogFilter{Query: defaultListParams}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, fn func(bashLog *model.BashLog) error) error {
+				for _, bashLog := range bashLogList {
+					if err := fn(bashLog); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	}
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIBashLogUseCase, *mock_api.MockIHelper, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success txt",
+			in:   inStruct{},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, err error) {
+				exportBashLogList(mu)
+			},
+			expected: expectedStruct{
+				golden:             "default_bash_log_export_txt",
+				code:               http.StatusOK,
+				contentType:        "text/plain; charset=utf-8",
+				contentDisposition: `attachment; filename="bash_log_59628b82-356c-4745-bc81-187015cde387.txt"`,
+			},
+		},
+		{
+			name: "Success ndjson",
+			in:   inStruct{query: "?format=ndjson"},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, err error) {
+				exportBashLogList(mu)
+			},
+			expected: expectedStruct{
+				golden:             "default_bash_log_export_ndjson",
+				code:               http.StatusOK,
+				contentType:        "application/x-ndjson",
+				contentDisposition: `attachment; filename="bash_log_59628b82-356c-4745-bc81-187015cde387.ndjson"`,
+			},
+		},
+		{
+			name: "Success csv",
+			in:   inStruct{query: "?format=csv"},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, err error) {
+				exportBashLogList(mu)
+			},
+			expected: expectedStruct{
+				golden:             "default_bash_log_export_csv",
+				code:               http.StatusOK,
+				contentType:        "text/csv; charset=utf-8",
+				contentDisposition: `attachment; filename="bash_log_59628b82-356c-4745-bc81-187015cde387.csv"`,
+			},
+		},
+		{
+			name: "Export format error",
+			in: inStruct{
+				query:   "?format=xml",
+				httpErr: httpErrors.BashLogExportFormat,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden:      "bash_log_export_format_error",
+				code:        http.StatusUnprocessableEntity,
+				contentType: "application/json; charset=utf-8",
+			},
+		},
+		{
+			name: "Export error",
+			in: inStruct{
+				query:   "?format=csv",
+				httpErr: httpErrors.BashLogExport,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIBashLogUseCase, mh *mock_api.MockIHelper, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().ExportBashLogByBashId(gomock.Any(), bashId, gomock.Any(), gomock.Any()).Return(err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden:      "bash_log_export_error",
+				code:        http.StatusInternalServerError,
+				contentType: "application/json; charset=utf-8",
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashLogUseCase := mock_usecase.NewMockIBashLogUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(mockBashLogUseCase, mockApiHelper, testCase.in.httpErr)
+
+			bashLogHandler := BashLogHandler{
+				useCase:    mockBashLogUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupBashLogPath + exportBashLogByBashIdPath
+
+			r := gin.New()
+			r.GET(handlerPath, bashLogHandler.ExportBashLogByBashId)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				http.MethodGet,
+				strings.Replace(handlerPath, ":bashId", bashId.String(), 1)+testCase.in.query,
+				nil,
+			)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(bashlogTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, testCase.expected.contentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, testCase.expected.contentDisposition, recorder.Header().Get("Content-Disposition"))
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
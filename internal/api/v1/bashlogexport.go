@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	bashLogExportFormatTxt    = "txt"
+	bashLogExportFormatNdjson = "ndjson"
+	bashLogExportFormatCsv    = "csv"
+)
+
+var (
+	bashLogExportContentTypes = map[string]string{
+		bashLogExportFormatTxt:    "text/plain; charset=utf-8",
+		bashLogExportFormatNdjson: "application/x-ndjson",
+		bashLogExportFormatCsv:    "text/csv; charset=utf-8",
+	}
+
+	bashLogExportCsvHeader = []string{"id", "bashId", "runId", "isError", "createdAt", "body"}
+
+	// bashLogExportCsvFormulaPrefixes start the cells that spreadsheets evaluate as formulas.
+	bashLogExportCsvFormulaPrefixes = "=+-@\t\r"
+)
+
+type (
+	txtBashLogEncoder struct {
+		w io.Writer
+	}
+
+	// csvBashLogEncoder writes the header before the first log, or at the end of the empty export,
+	// so nothing is written while the export can still fail with an http error.
+	csvBashLogEncoder struct {
+		writer        *csv.Writer
+		headerWritten bool
+	}
+)
+
+func newBashLogEncoder(format string, w io.Writer) api.ExportEncoder[*model.BashLog] {
+	switch format {
+	case bashLogExportFormatNdjson:
+		return api.NewNdjsonEncoder[*model.BashLog](w)
+	case bashLogExportFormatCsv:
+		return &csvBashLogEncoder{writer: csv.NewWriter(w)}
+	default:
+		return &txtBashLogEncoder{w: w}
+	}
+}
+
+func getBashLogStream(bashLog *model.BashLog) string {
+	if bashLog.IsError {
+		return "stderr"
+	}
+	return "stdout"
+}
+
+// getCsvSafeCell prefixes the cell that a spreadsheet would run as a formula with a quote,
+// so the log body of the export is always shown as text.
+func getCsvSafeCell(cell string) string {
+	if cell != "" && strings.ContainsRune(bashLogExportCsvFormulaPrefixes, rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+func (e *txtBashLogEncoder) Encode(bashLog *model.BashLog) error {
+	_, err := fmt.Fprintf(
+		e.w,
+		"%s [%s] %s\n",
+		bashLog.CreatedAt.Format(time.RFC3339Nano),
+		getBashLogStream(bashLog),
+		bashLog.Body,
+	)
+	return err
+}
+
+func (e *txtBashLogEncoder) Flush() error {
+	return nil
+}
+
+func (e *csvBashLogEncoder) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.writer.Write(bashLogExportCsvHeader)
+}
+
+func (e *csvBashLogEncoder) Encode(bashLog *model.BashLog) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	var bashId, runId string
+	if bashLog.BashId != nil {
+		bashId = bashLog.BashId.String()
+	}
+	if bashLog.RunId != nil {
+		runId = bashLog.RunId.String()
+	}
+
+	return e.writer.Write([]string{
+		bashLog.Id.String(),
+		bashId,
+		runId,
+		strconv.FormatBool(bashLog.IsError),
+		bashLog.CreatedAt.Format(time.RFC3339Nano),
+		getCsvSafeCell(bashLog.Body),
+	})
+}
+
+func (e *csvBashLogEncoder) Flush() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
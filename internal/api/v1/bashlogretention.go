@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/usecase"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	groupBashLogRetentionPath          = "/bash"
+	getBashLogRetentionByBashIdPath    = "/:id/log/retention"
+	setBashLogRetentionPath            = "/:id/log/retention"
+	removeBashLogRetentionByBashIdPath = "/:id/log/retention"
+	pruneBashLogPath                   = "/log/prune"
+)
+
+type (
+	IBashLogRetentionHandler interface {
+		GetBashLogRetentionByBashId(c *gin.Context)
+		SetBashLogRetention(c *gin.Context)
+		RemoveBashLogRetentionByBashId(c *gin.Context)
+		PruneBashLog(c *gin.Context)
+	}
+
+	BashLogRetentionHandler struct {
+		useCase      usecase.IBashLogRetentionUseCase
+		auditUseCase usecase.IAuditUseCase
+		helper       api.IHelper
+		httpErrors   *config.HTTPErrors
+	}
+)
+
+func (h *BashLogRetentionHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupBashLogRetentionPath)
+	{
+		group.GET(
+			getBashLogRetentionByBashIdPath,
+			api.RequirePermission(model.PermissionBashLogManage),
+			h.GetBashLogRetentionByBashId,
+		)
+		group.PUT(
+			setBashLogRetentionPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashLogRetentionSet),
+			api.RequirePermission(model.PermissionBashLogManage),
+			h.SetBashLogRetention,
+		)
+		group.DELETE(
+			removeBashLogRetentionByBashIdPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashLogRetentionDelete),
+			api.RequirePermission(model.PermissionBashLogManage),
+			h.RemoveBashLogRetentionByBashId,
+		)
+		group.POST(
+			pruneBashLogPath,
+			api.Audit(h.auditUseCase, model.AuditActionBashLogPrune),
+			api.RequirePermission(model.PermissionBashLogManage),
+			h.PruneBashLog,
+		)
+	}
+}
+
+// GetBashLogRetentionByBashId
+// @Summary Get log retention by bash id
+// @Tags Bash Log Retention
+// @Description Get log retention of bash script, the null limits are taken from the global retention. Available only for admin
+// @Produce json
+// @Success 200 {object} model.BashLogRetention
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Security BearerAuth
+// @Router /bash/{id}/log/retention [get]
+func (h *BashLogRetentionHandler) GetBashLogRetentionByBashId(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashLogRetention, err := h.useCase.GetBashLogRetentionByBashId(c.Request.Context(), bashId)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashLogRetention)
+}
+
+// SetBashLogRetention
+// @Summary Set log retention
+// @Tags Bash Log Retention
+// @Description Set log retention of bash script: logs older than maxAgeSeconds, beyond the maxRows newest logs or outside the keepRuns newest runs are pruned.
+// @Description The null limits are taken from the global retention and the zero ones keep the logs without limit. Available only for admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.BashLogRetention
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Param bashLogRetention body dto.SetBashLogRetention true "Set bash log retention model"
+// @Security BearerAuth
+// @Router /bash/{id}/log/retention [put]
+func (h *BashLogRetentionHandler) SetBashLogRetention(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	var setBashLogRetentionDTO dto.SetBashLogRetention
+
+	if err := c.ShouldBindJSON(&setBashLogRetentionDTO); err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashLogRetentionSetDTO)
+		api.RenderError(c, httpError)
+		return
+	}
+	setBashLogRetentionDTO.BashId = bashId
+
+	bashLogRetention, err := h.useCase.SetBashLogRetention(c.Request.Context(), setBashLogRetentionDTO)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashLogRetention)
+}
+
+// RemoveBashLogRetentionByBashId
+// @Summary Remove log retention by bash id
+// @Tags Bash Log Retention
+// @Description Remove log retention of bash script, so the global retention is applied to its logs. Available only for admin
+// @Produce json
+// @Success 200 {object} model.BashLogRetention
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Security BearerAuth
+// @Router /bash/{id}/log/retention [delete]
+func (h *BashLogRetentionHandler) RemoveBashLogRetentionByBashId(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashLogRetention, err := h.useCase.RemoveBashLogRetentionByBashId(c.Request.Context(), bashId)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashLogRetention)
+}
+
+// PruneBashLog
+// @Summary Prune logs
+// @Tags Bash Log Retention
+// @Description Prune bash logs outside the retention right away by batches, the dry run only counts the logs which would be pruned. Available only for admin
+// @Produce json
+// @Success 200 {object} schema.BashLogPrune
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param dryRun query bool false "Count the prunable logs without removing them" default(false)
+// @Security BearerAuth
+// @Router /bash/log/prune [post]
+func (h *BashLogRetentionHandler) PruneBashLog(c *gin.Context) {
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dryRun", "false"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashLogPruneDryRunParam)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	bashLogPrune, err := h.useCase.PruneBashLog(c.Request.Context(), dryRun)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashLogPrune)
+}
+
+func GetBashLogRetentionHandler() api.IHandler {
+	return &BashLogRetentionHandler{
+		useCase:      usecase.GetBashLogRetentionUseCase(),
+		auditUseCase: usecase.GetAuditUseCase(),
+		helper:       api.GetHelper(),
+		httpErrors:   config.GetHTTPErrors(),
+	}
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/usecase"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	groupBashRunPath           = "/bash"
+	getBashRunListByBashIdPath = "/:id/run/list"
+)
+
+type (
+	IBashRunHandler interface {
+		GetBashRunListByBashId(c *gin.Context)
+	}
+
+	BashRunHandler struct {
+		useCase    usecase.IBashRunUseCase
+		helper     api.IHelper
+		httpErrors *config.HTTPErrors
+	}
+)
+
+func (h *BashRunHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupBashRunPath)
+	{
+		group.GET(
+			getBashRunListByBashIdPath,
+			api.RequirePermission(model.PermissionBashLogRead),
+			h.GetBashRunListByBashId,
+		)
+	}
+}
+
+// GetBashRunListByBashId
+// @Summary Get list by bash id
+// @Tags Bash Run
+// @Description Get list of bash script runs by bash id
+// @Produce json
+// @Success 200 {object} schema.BashRunPaginationPage
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Param limit query int true "Limit param of pagination" default(20)
+// @Param offset query int true "Offset param of pagination" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Security BearerAuth
+// @Router /bash/{id}/run/list [get]
+func (h *BashRunHandler) GetBashRunListByBashId(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if limit < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if offset < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	paginationParams := pagination.LimitOffsetParams{
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
+	}
+
+	bashRunList, err := h.useCase.GetBashRunPaginationPageByBashId(c.Request.Context(), bashId, paginationParams)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashRunList)
+}
+
+func GetBashRunHandler() api.IHandler {
+	return &BashRunHandler{
+		useCase:    usecase.GetBashRunUseCase(),
+		helper:     api.GetHelper(),
+		httpErrors: config.GetHTTPErrors(),
+	}
+}
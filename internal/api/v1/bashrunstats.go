@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/usecase"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	groupBashRunStatsPath         = "/bash"
+	getBashRunStatsByBashIdPath   = "/:id/stats"
+	groupBashRunStatsOverviewPath = "/stats"
+	getBashRunStatsOverviewPath   = "/overview"
+)
+
+type (
+	IBashRunStatsHandler interface {
+		GetBashRunStatsByBashId(c *gin.Context)
+		GetBashRunStatsOverview(c *gin.Context)
+	}
+
+	BashRunStatsHandler struct {
+		useCase    usecase.IBashRunStatsUseCase
+		helper     api.IHelper
+		httpErrors *config.HTTPErrors
+	}
+)
+
+func (h *BashRunStatsHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupBashRunStatsPath)
+	{
+		group.GET(
+			getBashRunStatsByBashIdPath,
+			api.RequirePermission(model.PermissionBashLogRead),
+			h.GetBashRunStatsByBashId,
+		)
+	}
+
+	overviewGroup := rg.Group(groupBashRunStatsOverviewPath)
+	{
+		overviewGroup.GET(
+			getBashRunStatsOverviewPath,
+			api.RequirePermission(model.PermissionBashLogRead),
+			h.GetBashRunStatsOverview,
+		)
+	}
+}
+
+// GetBashRunStatsByBashId
+// @Summary Get stats by bash id
+// @Tags Bash Run Stats
+// @Description Get run stats of bash script over the windows: counts by outcome, success rate among the finished runs,
+// @Description p50, p95 and max duration in seconds, last success and last failure
+// @Produce json
+// @Success 200 {object} schema.BashStats
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of bash script"
+// @Param windows query string false "Comma separated configured windows like 24h,7d, all configured windows by default"
+// @Security BearerAuth
+// @Router /bash/{id}/stats [get]
+func (h *BashRunStatsHandler) GetBashRunStatsByBashId(c *gin.Context) {
+	bashId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.BashId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	var windowNames []string
+	for _, windowName := range strings.Split(c.Query("windows"), ",") {
+		if windowName = strings.TrimSpace(windowName); windowName != "" {
+			windowNames = append(windowNames, windowName)
+		}
+	}
+
+	bashStats, err := h.useCase.GetBashRunStatsByBashId(c.Request.Context(), bashId, windowNames)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, bashStats)
+}
+
+// GetBashRunStatsOverview
+// @Summary Get stats overview
+// @Tags Bash Run Stats
+// @Description Get run stats of all scripts and of every script run within the window, the scripts are ordered by the unsuccessful runs.
+// @Description The overview is read from the rollup when the rollups are enabled, rolledUpAt is null when it's aggregated on request
+// @Produce json
+// @Success 200 {object} schema.StatsOverview
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param window query string false "Configured window like 7d, the first configured window by default"
+// @Security BearerAuth
+// @Router /stats/overview [get]
+func (h *BashRunStatsHandler) GetBashRunStatsOverview(c *gin.Context) {
+	statsOverview, err := h.useCase.GetBashRunStatsOverview(c.Request.Context(), c.Query("window"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, statsOverview)
+}
+
+func GetBashRunStatsHandler() api.IHandler {
+	return &BashRunStatsHandler{
+		useCase:    usecase.GetBashRunStatsUseCase(),
+		helper:     api.GetHelper(),
+		httpErrors: config.GetHTTPErrors(),
+	}
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/sql/query"
+	"pg-sh-scripts/pkg/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	cursorQueryParam    = "cursor"
+	offsetQueryParam    = "offset"
+	totalModeQueryParam = "totalMode"
+)
+
+// getTotalMode returns the total mode of the limit offset page,
+// the empty mode is returned without the param, so the total is counted exactly.
+func getTotalMode(c *gin.Context, httpErrors *config.HTTPErrors) (pagination.TotalMode, error) {
+	rawTotalMode, ok := c.GetQuery(totalModeQueryParam)
+	if !ok {
+		return "", nil
+	}
+
+	totalMode, err := pagination.ParseTotalMode(rawTotalMode)
+	if err != nil {
+		return "", httpErrors.PaginationTotalModeParam
+	}
+	return totalMode, nil
+}
+
+// getKeysetParams returns the keyset pagination params when the request has the cursor param,
+// the empty cursor requests the first page, nil is returned for the limit offset pagination.
+// The keyset page is always ordered by creation time, so the sort param is rejected with the cursor.
+func getKeysetParams(c *gin.Context, limit int, httpErrors *config.HTTPErrors) (*pagination.KeysetParams, error) {
+	rawCursor, ok := c.GetQuery(cursorQueryParam)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := c.GetQuery(offsetQueryParam); ok {
+		return nil, httpErrors.PaginationCursorWithOffset
+	}
+	if rawSort, ok := c.GetQuery(query.SortParam); ok {
+		return nil, schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+			{Field: query.SortParam, Reason: "excluded_with=" + cursorQueryParam, Value: rawSort},
+		})
+	}
+
+	cursor, err := pagination.DecodeCursor(rawCursor)
+	if err != nil {
+		return nil, httpErrors.PaginationCursorParam
+	}
+
+	return &pagination.KeysetParams{
+		Limit:  limit,
+		Cursor: cursor,
+	}, nil
+}
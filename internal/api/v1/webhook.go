@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/api"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/usecase"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	groupWebhookPath                      = "/webhook"
+	getWebhookListPath                    = "/list"
+	getWebhookDeliveryListByWebhookIdPath = "/:id/delivery/list"
+	createWebhookPath                     = ""
+	removeWebhookPath                     = "/:id"
+)
+
+type (
+	IWebhookHandler interface {
+		GetWebhookList(c *gin.Context)
+		GetWebhookDeliveryListByWebhookId(c *gin.Context)
+		CreateWebhook(c *gin.Context)
+		RemoveWebhookById(c *gin.Context)
+	}
+
+	WebhookHandler struct {
+		useCase      usecase.IWebhookUseCase
+		auditUseCase usecase.IAuditUseCase
+		helper       api.IHelper
+		httpErrors   *config.HTTPErrors
+	}
+)
+
+func (h *WebhookHandler) Register(rg *gin.RouterGroup) {
+	group := rg.Group(groupWebhookPath)
+	{
+		group.GET(getWebhookListPath, api.RequirePermission(model.PermissionWebhookRead), h.GetWebhookList)
+		group.GET(
+			getWebhookDeliveryListByWebhookIdPath,
+			api.RequirePermission(model.PermissionWebhookRead),
+			h.GetWebhookDeliveryListByWebhookId,
+		)
+		group.POST(
+			createWebhookPath,
+			api.Audit(h.auditUseCase, model.AuditActionWebhookCreate),
+			api.RequirePermission(model.PermissionWebhookWrite),
+			h.CreateWebhook,
+		)
+		group.DELETE(
+			removeWebhookPath,
+			api.Audit(h.auditUseCase, model.AuditActionWebhookDelete),
+			api.RequirePermission(model.PermissionWebhookWrite),
+			h.RemoveWebhookById,
+		)
+	}
+}
+
+// GetWebhookList
+// @Summary Get list
+// @Tags Webhook
+// @Description Get list of webhooks
+// @Produce json
+// @Success 200 {object} schema.WebhookPaginationPage
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param limit query int true "Limit param of pagination" default(20)
+// @Param offset query int true "Offset param of pagination" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Security BearerAuth
+// @Router /webhook/list [get]
+func (h *WebhookHandler) GetWebhookList(c *gin.Context) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if limit < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if offset < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	paginationParams := pagination.LimitOffsetParams{
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
+	}
+
+	webhookList, err := h.useCase.GetWebhookPaginationPage(c.Request.Context(), paginationParams)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookList)
+}
+
+// GetWebhookDeliveryListByWebhookId
+// @Summary Get delivery list by webhook id
+// @Tags Webhook
+// @Description Get list of webhook delivery attempts by webhook id
+// @Produce json
+// @Success 200 {object} schema.WebhookDeliveryPaginationPage
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of webhook"
+// @Param limit query int true "Limit param of pagination" default(20)
+// @Param offset query int true "Offset param of pagination" default(0)
+// @Param totalMode query string false "Total mode of pagination: exact (default), estimate or none" Enums(exact, estimate, none)
+// @Security BearerAuth
+// @Router /webhook/{id}/delivery/list [get]
+func (h *WebhookHandler) GetWebhookDeliveryListByWebhookId(c *gin.Context) {
+	webhookId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.WebhookId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if limit < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationLimitParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamMustBeInt)
+		api.RenderError(c, httpError)
+		return
+	}
+	if offset < 0 {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.PaginationOffsetParamGTEZero)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	totalMode, err := getTotalMode(c, h.httpErrors)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	paginationParams := pagination.LimitOffsetParams{
+		Limit:     limit,
+		Offset:    offset,
+		TotalMode: totalMode,
+	}
+
+	webhookDeliveryList, err := h.useCase.GetWebhookDeliveryPaginationPageByWebhookId(
+		c.Request.Context(),
+		webhookId,
+		paginationParams,
+	)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookDeliveryList)
+}
+
+// CreateWebhook
+// @Summary Create
+// @Tags Webhook
+// @Description Create webhook, global if bashId is omitted, otherwise for the specified bash script
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Webhook
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param webhook body dto.CreateWebhook true "Create webhook model"
+// @Security BearerAuth
+// @Router /webhook [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var createWebhookDTO dto.CreateWebhook
+
+	if err := c.ShouldBindJSON(&createWebhookDTO); err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.WebhookCreateDTO)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	webhook, err := h.useCase.CreateWebhook(c.Request.Context(), createWebhookDTO)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+	api.AddAuditTargets(c, webhook.Id.String())
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// RemoveWebhookById
+// @Summary Remove by id
+// @Tags Webhook
+// @Description Remove webhook by id
+// @Produce json
+// @Success 200 {object} model.Webhook
+// @Failure 500 {object} schema.HTTPError
+// @Failure default {object} schema.Problem "RFC 7807 document, returned instead of schema.HTTPError for Accept: application/problem+json"
+// @Param id path string true "ID of webhook"
+// @Security BearerAuth
+// @Router /webhook/{id} [delete]
+func (h *WebhookHandler) RemoveWebhookById(c *gin.Context) {
+	webhookId, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), h.httpErrors.WebhookId)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	webhook, err := h.useCase.RemoveWebhookById(c.Request.Context(), webhookId)
+	if err != nil {
+		httpError := h.helper.ParseError(c.Request.Context(), err)
+		api.RenderError(c, httpError)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+func GetWebhookHandler() api.IHandler {
+	return &WebhookHandler{
+		useCase:      usecase.GetWebhookUseCase(),
+		auditUseCase: usecase.GetAuditUseCase(),
+		helper:       api.GetHelper(),
+		httpErrors:   config.GetHTTPErrors(),
+	}
+}
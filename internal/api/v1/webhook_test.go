@@ -0,0 +1,401 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	mock_api "pg-sh-scripts/internal/api/mock"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/type/alias"
+	mock_usecase "pg-sh-scripts/internal/usecase/mock"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"strings"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const webhookTestDataDir = "webhook_testdata"
+
+func TestWebhookHandler_GetWebhookList(t *testing.T) {
+	type (
+		inStruct struct {
+			limit   string
+			offset  string
+			httpErr error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIWebhookUseCase, *mock_api.MockIHelper, pagination.LimitOffsetParams, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				limit:   "0",
+				offset:  "0",
+				httpErr: nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
+				mu.EXPECT().GetWebhookPaginationPage(gomock.Any(), paginationParams).Return(alias.WebhookLimitOffsetPage{}, nil)
+			},
+			expected: expectedStruct{
+				golden: "default_pagination_page",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Validation limit param must be int error",
+			in: inStruct{
+				limit:   "limit",
+				offset:  "0",
+				httpErr: httpErrors.PaginationLimitParamMustBeInt,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "pagination_limit_param_int_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Getting pagination page error",
+			in: inStruct{
+				limit:   "0",
+				offset:  "0",
+				httpErr: httpErrors.WebhookGetPaginationPage,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, paginationParams pagination.LimitOffsetParams, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().GetWebhookPaginationPage(gomock.Any(), paginationParams).Return(alias.WebhookLimitOffsetPage{}, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "get_pagination_page_error",
+				code:   http.StatusBadRequest,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUseCase := mock_usecase.NewMockIWebhookUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(
+				mockWebhookUseCase,
+				mockApiHelper,
+				pagination.LimitOffsetParams{},
+				testCase.in.httpErr,
+			)
+
+			webhookHandler := WebhookHandler{
+				useCase:    mockWebhookUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupWebhookPath + getWebhookListPath
+
+			r := gin.New()
+			r.GET(handlerPath, webhookHandler.GetWebhookList)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, handlerPath, nil)
+
+			requestQueryParams := request.URL.Query()
+			requestQueryParams.Add("limit", testCase.in.limit)
+			requestQueryParams.Add("offset", testCase.in.offset)
+			request.URL.RawQuery = requestQueryParams.Encode()
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(webhookTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestWebhookHandler_CreateWebhook(t *testing.T) {
+	type (
+		inStruct struct {
+			body    string
+			dto     dto.CreateWebhook
+			httpErr error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIWebhookUseCase, *mock_api.MockIHelper, dto.CreateWebhook, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				body: `{"url":"https://example.com/hooks","secret":"secret","events":["run.failure"]}`,
+				dto: dto.CreateWebhook{
+					Url:    "https://example.com/hooks",
+					Secret: "secret",
+					Events: []string{"run.failure"},
+				},
+				httpErr: nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, dto dto.CreateWebhook, err error) {
+				mu.EXPECT().CreateWebhook(gomock.Any(), dto).Return(&model.Webhook{Secret: "secret"}, nil)
+			},
+			expected: expectedStruct{
+				golden: "default_webhook",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Validation create body error",
+			in: inStruct{
+				body:    `{"url":`,
+				httpErr: httpErrors.WebhookCreateDTO,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, dto dto.CreateWebhook, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "webhook_create_dto_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Validation url error",
+			in: inStruct{
+				body: `{"url":"example.com","secret":"secret","events":["run.failure"]}`,
+				dto: dto.CreateWebhook{
+					Url:    "example.com",
+					Secret: "secret",
+					Events: []string{"run.failure"},
+				},
+				httpErr: httpErrors.WebhookUrl,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, dto dto.CreateWebhook, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().CreateWebhook(gomock.Any(), dto).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "webhook_url_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUseCase := mock_usecase.NewMockIWebhookUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			testCase.mockBehavior(mockWebhookUseCase, mockApiHelper, testCase.in.dto, testCase.in.httpErr)
+
+			webhookHandler := WebhookHandler{
+				useCase:    mockWebhookUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupWebhookPath + createWebhookPath
+
+			r := gin.New()
+			r.POST(handlerPath, webhookHandler.CreateWebhook)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				http.MethodPost,
+				handlerPath,
+				bytes.NewBufferString(testCase.in.body),
+			)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(webhookTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestWebhookHandler_RemoveWebhookById(t *testing.T) {
+	type (
+		inStruct struct {
+			webhookId string
+			httpErr   error
+		}
+
+		expectedStruct struct {
+			golden string
+			code   int
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_usecase.MockIWebhookUseCase, *mock_api.MockIHelper, uuid.UUID, error)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				webhookId: uuid.NewV4().String(),
+				httpErr:   nil,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, webhookId uuid.UUID, err error) {
+				mu.EXPECT().RemoveWebhookById(gomock.Any(), webhookId).Return(&model.Webhook{}, nil)
+			},
+			expected: expectedStruct{
+				golden: "default_webhook",
+				code:   http.StatusOK,
+			},
+		},
+		{
+			name: "Webhook id must be uuid error",
+			in: inStruct{
+				webhookId: "uuid",
+				httpErr:   httpErrors.WebhookId,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, webhookId uuid.UUID, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+				mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr)
+			},
+			expected: expectedStruct{
+				golden: "webhook_id_error",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name: "Webhook does not exists error",
+			in: inStruct{
+				webhookId: uuid.NewV4().String(),
+				httpErr:   httpErrors.WebhookDoesNotExists,
+			},
+			mockBehavior: func(mu *mock_usecase.MockIWebhookUseCase, mh *mock_api.MockIHelper, webhookId uuid.UUID, err error) {
+				var httpErr *schema.HTTPError
+				errors.As(err, &httpErr)
+
+				gomock.InOrder(
+					mu.EXPECT().RemoveWebhookById(gomock.Any(), webhookId).Return(nil, err),
+					mh.EXPECT().ParseError(gomock.Any(), err).Return(httpErr),
+				)
+			},
+			expected: expectedStruct{
+				golden: "webhook_does_not_exists_error",
+				code:   http.StatusNotFound,
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUseCase := mock_usecase.NewMockIWebhookUseCase(ctrl)
+			mockApiHelper := mock_api.NewMockIHelper(ctrl)
+			uuidWebhookId, _ := uuid.FromString(testCase.in.webhookId)
+			testCase.mockBehavior(mockWebhookUseCase, mockApiHelper, uuidWebhookId, testCase.in.httpErr)
+
+			webhookHandler := WebhookHandler{
+				useCase:    mockWebhookUseCase,
+				helper:     mockApiHelper,
+				httpErrors: httpErrors,
+			}
+
+			handlerPath := groupWebhookPath + removeWebhookPath
+			handlerCasePath := strings.Replace(handlerPath, ":id", testCase.in.webhookId, 1)
+
+			r := gin.New()
+			r.DELETE(handlerPath, webhookHandler.RemoveWebhookById)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodDelete, handlerCasePath, nil)
+
+			r.ServeHTTP(recorder, request)
+
+			content, err := os.ReadFile(
+				path.Join(webhookTestDataDir, testCase.expected.golden+".golden"),
+			)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+			expectedBody := string(content)
+
+			assert.Equal(t, testCase.expected.code, recorder.Code)
+			assert.Equal(t, expectedBody, recorder.Body.String())
+		})
+	}
+}
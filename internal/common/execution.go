@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+//go:generate mockgen -source=./execution.go  -destination=./mock/execution.go
+
+type (
+	// IExecutionGroup tracks running bash script executions,
+	// so the server can wait for them or interrupt them on shutdown.
+	IExecutionGroup interface {
+		Context() context.Context
+		Add() bool
+		Done()
+		Interrupt()
+		Wait(ctx context.Context) error
+		RunStarted()
+		RunFinished()
+		Running() int64
+	}
+
+	ExecutionGroup struct {
+		ctx     context.Context
+		cancel  context.CancelFunc
+		wg      sync.WaitGroup
+		m       sync.Mutex
+		running atomic.Int64
+	}
+)
+
+var (
+	executionGroupInstance *ExecutionGroup
+	executionGroupOnce     sync.Once
+)
+
+// Context returns the context of executions which is canceled on interruption.
+func (g *ExecutionGroup) Context() context.Context {
+	return g.ctx
+}
+
+// Add registers a new execution, it returns false when the group is already interrupted.
+func (g *ExecutionGroup) Add() bool {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if g.ctx.Err() != nil {
+		return false
+	}
+	g.wg.Add(1)
+	return true
+}
+
+func (g *ExecutionGroup) Done() {
+	g.wg.Done()
+}
+
+func (g *ExecutionGroup) Interrupt() {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	g.cancel()
+}
+
+// Wait blocks until all executions are finished or the context is done.
+func (g *ExecutionGroup) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RunStarted registers a started bash script, a single execution may run several of them.
+func (g *ExecutionGroup) RunStarted() {
+	g.running.Add(1)
+}
+
+func (g *ExecutionGroup) RunFinished() {
+	g.running.Add(-1)
+}
+
+// Running returns the number of currently running bash scripts.
+func (g *ExecutionGroup) Running() int64 {
+	return g.running.Load()
+}
+
+func GetExecutionGroup() IExecutionGroup {
+	executionGroupOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		executionGroupInstance = &ExecutionGroup{
+			ctx:    ctx,
+			cancel: cancel,
+		}
+	})
+
+	return executionGroupInstance
+}
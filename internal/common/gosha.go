@@ -4,92 +4,430 @@ import (
 	"bufio"
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"os"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/config/redaction"
 	"pg-sh-scripts/internal/dto"
 	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/metrics"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
 	"pg-sh-scripts/internal/service"
 	"pg-sh-scripts/pkg/gosha"
 	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/redact"
+	"pg-sh-scripts/pkg/tracing"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "pg-sh-scripts/internal/common"
+
+	bashIdAttributeKey    = attribute.Key("bash.id")
+	runIdAttributeKey     = attribute.Key("bash.run.id")
+	runStatusAttributeKey = attribute.Key("bash.run.status")
+	exitCodeAttributeKey  = attribute.Key("process.exit.code")
+
+	// maxScanLineSize is the max size of the line of the command output, which is redacted as a whole.
+	maxScanLineSize = 1024 * 1024
 )
 
 //go:generate mockgen -source=./gosha.go  -destination=./mock/gosha.go
 
 type (
 	ICustomGoshaExec interface {
-		Run(isSync bool, commands []gosha.ICmd)
+		Run(ctx context.Context, isSync bool, commands []gosha.ICmd)
 	}
 
 	CustomGoshaExec struct {
-		goshaExec gosha.IExec
-		logger    *logging.Logger
+		goshaExec       gosha.IExec
+		executionGroup  IExecutionGroup
+		notifier        IWebhookNotifier
+		outputTailSize  int
+		inlineRetention time.Duration
+		redaction       redaction.Config
+		redactPatterns  []redact.Pattern
+		metrics         *metrics.Metrics
+		tracer          trace.Tracer
+		logger          *logging.Logger
 	}
 
-	CustomScanner struct{}
+	CustomScanner struct {
+		ctx             context.Context
+		executionGroup  IExecutionGroup
+		requestId       string
+		notifier        IWebhookNotifier
+		outputTailSize  int
+		inlineRetention time.Duration
+		redaction       redaction.Config
+		redactPatterns  []redact.Pattern
+		metrics         *metrics.Metrics
+		tracer          trace.Tracer
+		runs            sync.Map
+	}
+
+	customScannerRun struct {
+		ctx            context.Context
+		span           trace.Span
+		bashId         *uuid.UUID
+		runId          uuid.UUID
+		startedAt      time.Time
+		outputTail     []string
+		redactionCount int64
+		m              sync.Mutex
+	}
 )
 
-func (c *CustomGoshaExec) saveExecError(err error) {
+// getRedactPatterns returns the configured redaction rules followed by the builtin credential patterns.
+func getRedactPatterns(cfg redaction.Config) []redact.Pattern {
+	patterns := slices.Clone(cfg.Rules)
+	if cfg.Builtin {
+		patterns = append(patterns, redact.BuiltinPatterns...)
+	}
+	return patterns
+}
+
+// parseBashId returns the bash script id stored in the command title,
+// or nil for inline commands which are not tied to a saved bash script.
+func parseBashId(title string) (*uuid.UUID, error) {
+	if title == "" {
+		return nil, nil
+	}
+	bashId, err := uuid.FromString(title)
+	if err != nil {
+		return nil, err
+	}
+	return &bashId, nil
+}
+
+// getCmd returns the command with the id, or the empty command when it is not found,
+// so only the secrets of the server environment are redacted from its errors.
+func getCmd(commands []gosha.ICmd, id string) *gosha.Cmd {
+	for _, command := range commands {
+		if cmd, ok := command.(*gosha.Cmd); ok && cmd.Id == id {
+			return cmd
+		}
+	}
+	return &gosha.Cmd{}
+}
+
+// saveExecError stores the detail of the execute error as the error log of the run,
+// the detail is redacted like the command output since it may contain the stderr of the script.
+func (c *CustomGoshaExec) saveExecError(ctx context.Context, scanner *CustomScanner, commands []gosha.ICmd, err error) {
 	var execErr *gosha.ExecErr
 
 	bashLogService := service.GetBashLogService()
 
 	if errors.As(err, &execErr) {
-		bashId, err := uuid.FromString(execErr.Title)
+		detail, _ := scanner.redact(getCmd(commands, execErr.Id), execErr.Detail)
+		// The run is not created when the start hook fails,
+		// so there is nothing to attach the error log to.
+		if execErr.IsStartHook() {
+			c.logger.ErrorContext(ctx, fmt.Sprintf("Starting bash run: %s Error: %s", execErr.Id, detail))
+			return
+		}
+		bashId, err := parseBashId(execErr.Title)
 		if err == nil {
+			runId, _ := uuid.FromString(execErr.Id)
 			createBashLogDTO := dto.CreateBashLog{
 				BashId:  bashId,
-				Body:    execErr.Detail,
+				RunId:   runId,
+				Body:    detail,
 				IsError: true,
 			}
-			_, _ = bashLogService.Create(context.Background(), createBashLogDTO)
+			if _, err := bashLogService.Create(ctx, createBashLogDTO); err == nil {
+				c.metrics.BashLogLineWritten(true)
+			}
 		}
 	} else {
-		c.logger.Error("Unknown execute error: &v", err)
+		c.logger.ErrorContext(ctx, fmt.Sprintf("Unknown execute error: %v", err))
+	}
+}
+
+func (c *CustomGoshaExec) getScanner(ctx context.Context) *CustomScanner {
+	return &CustomScanner{
+		ctx:             ctx,
+		executionGroup:  c.executionGroup,
+		requestId:       logging.GetRequestId(ctx),
+		notifier:        c.notifier,
+		outputTailSize:  c.outputTailSize,
+		inlineRetention: c.inlineRetention,
+		redaction:       c.redaction,
+		redactPatterns:  c.redactPatterns,
+		metrics:         c.metrics,
+		tracer:          c.tracer,
 	}
 }
 
-func (c *CustomGoshaExec) Run(isSync bool, commands []gosha.ICmd) {
+// Run executes the commands under the context of the execution group,
+// only the request id and the span are taken from ctx since executions outlive the request.
+func (c *CustomGoshaExec) Run(ctx context.Context, isSync bool, commands []gosha.ICmd) {
+	if !c.executionGroup.Add() {
+		c.logger.ErrorContext(ctx, "Executing bash scripts after the server shutdown has started")
+		return
+	}
+	defer c.executionGroup.Done()
+
+	requestId := logging.GetRequestId(ctx)
+	execCtx := logging.WithRequestId(c.executionGroup.Context(), requestId)
+	scannerCtx := trace.ContextWithSpanContext(
+		logging.WithRequestId(context.Background(), requestId),
+		trace.SpanContextFromContext(ctx),
+	)
+
+	scanner := c.getScanner(scannerCtx)
 	if isSync {
-		if errs := c.goshaExec.SyncRun(&CustomScanner{}, commands); errs != nil {
+		if errs := c.goshaExec.SyncRun(execCtx, scanner, commands); errs != nil {
 			for _, err := range errs {
-				c.saveExecError(err)
+				c.saveExecError(scannerCtx, scanner, commands, err)
 			}
 		}
 	} else {
-		if err := c.goshaExec.Run(&CustomScanner{}, commands); err != nil {
-			c.saveExecError(err)
+		if err := c.goshaExec.Run(execCtx, scanner, commands); err != nil {
+			c.saveExecError(scannerCtx, scanner, commands, err)
 		}
 	}
 }
 
+func (r *customScannerRun) addRedactions(count int) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.redactionCount += int64(count)
+}
+
+func (r *customScannerRun) addOutput(msg string, outputTailSize int) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.outputTail = append(r.outputTail, msg)
+	if len(r.outputTail) > outputTailSize {
+		r.outputTail = r.outputTail[len(r.outputTail)-outputTailSize:]
+	}
+}
+
+// OnStart creates the run and starts its span, the trace context of the span
+// is added to the environment of the script. The script is not started
+// if the run can not be created, since its logs reference the run.
+func (s *CustomScanner) OnStart(cmd *gosha.Cmd) error {
+	bashId, err := parseBashId(cmd.Title)
+	if err != nil {
+		return err
+	}
+	runId, err := uuid.FromString(cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := s.tracer.Start(s.ctx, "bash run", trace.WithAttributes(runIdAttributeKey.String(runId.String())))
+	if bashId != nil {
+		span.SetAttributes(bashIdAttributeKey.String(bashId.String()))
+	}
+
+	createBashRunDTO := dto.CreateBashRun{
+		Id:     runId,
+		BashId: bashId,
+	}
+	if s.requestId != "" {
+		createBashRunDTO.RequestId = &s.requestId
+	}
+	if bashId == nil {
+		expiresAt := time.Now().Add(s.inlineRetention)
+		createBashRunDTO.ExpiresAt = &expiresAt
+	}
+	if _, err := service.GetBashRunService().Create(ctx, createBashRunDTO); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return err
+	}
+
+	run := &customScannerRun{
+		ctx:        ctx,
+		span:       span,
+		bashId:     bashId,
+		runId:      runId,
+		startedAt:  time.Now(),
+		outputTail: make([]string, 0, s.outputTailSize),
+	}
+	s.runs.Store(cmd, run)
+	cmd.Env = append(cmd.Env, tracing.GetEnv(ctx)...)
+	s.executionGroup.RunStarted()
+	s.metrics.BashRunStarted()
+
+	s.notifier.Notify(&schema.WebhookPayload{
+		Event:     model.WebhookEventRunStart,
+		BashId:    run.bashId,
+		RunId:     run.runId,
+		StartedAt: run.startedAt,
+	})
+
+	return nil
+}
+
+func (s *CustomScanner) OnFinish(cmd *gosha.Cmd, err error) {
+	var execErr *gosha.ExecErr
+
+	value, ok := s.runs.LoadAndDelete(cmd)
+	if !ok {
+		return
+	}
+	run := value.(*customScannerRun)
+
+	finishedAt := time.Now()
+	payload := &schema.WebhookPayload{
+		Event:      model.WebhookEventRunSuccess,
+		BashId:     run.bashId,
+		RunId:      run.runId,
+		StartedAt:  run.startedAt,
+		FinishedAt: &finishedAt,
+		DurationMs: finishedAt.Sub(run.startedAt).Milliseconds(),
+		OutputTail: run.outputTail,
+	}
+
+	status := model.BashRunStatusSuccess
+	exitCode := 0
+	if err != nil {
+		status = model.BashRunStatusFailure
+		exitCode = -1
+		payload.Event = model.WebhookEventRunFailure
+		payload.Error = err.Error()
+		if errors.As(err, &execErr) {
+			payload.Error = execErr.Detail
+			exitCode = execErr.ExitCode
+			if execErr.IsTimeout() {
+				status = model.BashRunStatusTimeout
+				payload.Event = model.WebhookEventRunTimeout
+			}
+			if execErr.IsInterrupted() {
+				status = model.BashRunStatusInterrupted
+			}
+		}
+	}
+
+	if payload.Error != "" {
+		var redactionCount int
+		payload.Error, redactionCount = s.redact(cmd, payload.Error)
+		run.addRedactions(redactionCount)
+	}
+
+	run.m.Lock()
+	redactionCount := run.redactionCount
+	run.m.Unlock()
+
+	_, _ = service.GetBashRunService().FinishById(run.ctx, run.runId, status, redactionCount)
+	s.executionGroup.RunFinished()
+	s.metrics.BashRunFinished(status, finishedAt.Sub(run.startedAt))
+
+	run.span.SetAttributes(runStatusAttributeKey.String(status), exitCodeAttributeKey.Int(exitCode))
+	if err != nil {
+		run.span.SetStatus(codes.Error, payload.Error)
+	}
+	run.span.End()
+
+	s.notifier.Notify(payload)
+}
+
+// getRedactor returns the redactor of the command output with the secret values of the environment
+// of the server and of the command, nil is returned when the redaction is disabled.
+func (s *CustomScanner) getRedactor(cmd *gosha.Cmd) *redact.Redactor {
+	if !s.redaction.Enabled {
+		return nil
+	}
+
+	env := append(os.Environ(), cmd.Env...)
+	secrets := redact.GetSecretValues(env, s.redaction.SecretEnvNames, s.redaction.SecretMinLength)
+	return redact.NewRedactor(s.redactPatterns, secrets)
+}
+
+// redact returns the text with the secrets of the command redacted, the text is returned as is
+// when the redaction is disabled.
+func (s *CustomScanner) redact(cmd *gosha.Cmd, text string) (string, int) {
+	redactor := s.getRedactor(cmd)
+	if redactor == nil {
+		return text, 0
+	}
+	return redactor.Redact(text)
+}
+
+// Scan stores every word of the command output as a bash log, the secrets are redacted from the whole line
+// before it is split into the words, so the rules can match across whitespace like in Authorization: Bearer token.
+// The redacted words are stored and added to the output tail of the webhooks.
 func (s *CustomScanner) Scan(stdout io.ReadCloser, cmd *gosha.Cmd) error {
 	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxScanLineSize)
 	bashLogService := service.GetBashLogService()
 
-	bashId, err := uuid.FromString(cmd.Title)
+	bashId, err := parseBashId(cmd.Title)
+	if err != nil {
+		return err
+	}
+	runId, err := uuid.FromString(cmd.Id)
 	if err != nil {
 		return err
 	}
 
-	scanner.Split(bufio.ScanWords)
+	ctx := s.ctx
+	var run *customScannerRun
+	if value, ok := s.runs.Load(cmd); ok {
+		run = value.(*customScannerRun)
+		ctx = run.ctx
+	}
+
+	redactor := s.getRedactor(cmd)
+
 	for scanner.Scan() {
-		msg := scanner.Text()
-		createBashLogDTO := dto.CreateBashLog{
-			BashId:  bashId,
-			Body:    msg,
-			IsError: false,
+		line := scanner.Text()
+		if redactor != nil {
+			var redactionCount int
+			line, redactionCount = redactor.Redact(line)
+			if redactionCount > 0 && run != nil {
+				run.addRedactions(redactionCount)
+			}
 		}
-		if _, err := bashLogService.Create(context.Background(), createBashLogDTO); err != nil {
-			return err
+		for _, msg := range strings.Fields(line) {
+			createBashLogDTO := dto.CreateBashLog{
+				BashId:  bashId,
+				RunId:   runId,
+				Body:    msg,
+				IsError: false,
+			}
+			if _, err := bashLogService.Create(ctx, createBashLogDTO); err != nil {
+				return err
+			}
+			s.metrics.BashLogLineWritten(false)
+			if run != nil {
+				run.addOutput(msg, s.outputTailSize)
+			}
 		}
 	}
-	return nil
+	return scanner.Err()
 }
 
 func GetCustomGoshaExec() ICustomGoshaExec {
+	cfg := config.GetConfig()
+
 	return &CustomGoshaExec{
-		goshaExec: gosha.GetExec(),
-		logger:    log.GetLogger(),
+		goshaExec:       gosha.GetExec(),
+		executionGroup:  GetExecutionGroup(),
+		notifier:        GetWebhookNotifier(),
+		outputTailSize:  cfg.Webhook.OutputTailSize,
+		inlineRetention: cfg.Inline.RetentionSeconds,
+		redaction:       cfg.Redaction,
+		redactPatterns:  getRedactPatterns(cfg.Redaction),
+		metrics:         metrics.GetMetrics(),
+		tracer:          otel.Tracer(tracerName),
+		logger:          log.GetLogger(),
 	}
 }
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/pkg/jwks"
+	"sync"
+	"time"
+)
+
+const jwksRequestTimeout = 10 * time.Second
+
+var (
+	jwksProviderInstance *jwks.Provider
+	jwksProviderOnce     sync.Once
+)
+
+// GetJWKSProvider returns the key set of the token issuer, it is refreshed by the server.
+func GetJWKSProvider() *jwks.Provider {
+	jwksProviderOnce.Do(func() {
+		jwksProviderInstance = jwks.NewProvider(
+			config.GetConfig().Jwt.JWKSSource,
+			&http.Client{Timeout: jwksRequestTimeout},
+		)
+	})
+
+	return jwksProviderInstance
+}
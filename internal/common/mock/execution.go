@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./execution.go
+
+// Package mock_common is a generated GoMock package.
+package mock_common
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIExecutionGroup is a mock of IExecutionGroup interface.
+type MockIExecutionGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExecutionGroupMockRecorder
+}
+
+// MockIExecutionGroupMockRecorder is the mock recorder for MockIExecutionGroup.
+type MockIExecutionGroupMockRecorder struct {
+	mock *MockIExecutionGroup
+}
+
+// NewMockIExecutionGroup creates a new mock instance.
+func NewMockIExecutionGroup(ctrl *gomock.Controller) *MockIExecutionGroup {
+	mock := &MockIExecutionGroup{ctrl: ctrl}
+	mock.recorder = &MockIExecutionGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExecutionGroup) EXPECT() *MockIExecutionGroupMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIExecutionGroup) Add() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIExecutionGroupMockRecorder) Add() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIExecutionGroup)(nil).Add))
+}
+
+// Context mocks base method.
+func (m *MockIExecutionGroup) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockIExecutionGroupMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockIExecutionGroup)(nil).Context))
+}
+
+// Done mocks base method.
+func (m *MockIExecutionGroup) Done() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Done")
+}
+
+// Done indicates an expected call of Done.
+func (mr *MockIExecutionGroupMockRecorder) Done() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MockIExecutionGroup)(nil).Done))
+}
+
+// Interrupt mocks base method.
+func (m *MockIExecutionGroup) Interrupt() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Interrupt")
+}
+
+// Interrupt indicates an expected call of Interrupt.
+func (mr *MockIExecutionGroupMockRecorder) Interrupt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interrupt", reflect.TypeOf((*MockIExecutionGroup)(nil).Interrupt))
+}
+
+// RunFinished mocks base method.
+func (m *MockIExecutionGroup) RunFinished() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunFinished")
+}
+
+// RunFinished indicates an expected call of RunFinished.
+func (mr *MockIExecutionGroupMockRecorder) RunFinished() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunFinished", reflect.TypeOf((*MockIExecutionGroup)(nil).RunFinished))
+}
+
+// RunStarted mocks base method.
+func (m *MockIExecutionGroup) RunStarted() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunStarted")
+}
+
+// RunStarted indicates an expected call of RunStarted.
+func (mr *MockIExecutionGroupMockRecorder) RunStarted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunStarted", reflect.TypeOf((*MockIExecutionGroup)(nil).RunStarted))
+}
+
+// Running mocks base method.
+func (m *MockIExecutionGroup) Running() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Running")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Running indicates an expected call of Running.
+func (mr *MockIExecutionGroupMockRecorder) Running() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Running", reflect.TypeOf((*MockIExecutionGroup)(nil).Running))
+}
+
+// Wait mocks base method.
+func (m *MockIExecutionGroup) Wait(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockIExecutionGroupMockRecorder) Wait(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockIExecutionGroup)(nil).Wait), ctx)
+}
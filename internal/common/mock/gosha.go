@@ -5,6 +5,7 @@
 package mock_common
 
 import (
+	context "context"
 	gosha "pg-sh-scripts/pkg/gosha"
 	reflect "reflect"
 
@@ -35,13 +36,13 @@ func (m *MockICustomGoshaExec) EXPECT() *MockICustomGoshaExecMockRecorder {
 }
 
 // Run mocks base method.
-func (m *MockICustomGoshaExec) Run(isSync bool, commands []gosha.ICmd) {
+func (m *MockICustomGoshaExec) Run(ctx context.Context, isSync bool, commands []gosha.ICmd) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Run", isSync, commands)
+	m.ctrl.Call(m, "Run", ctx, isSync, commands)
 }
 
 // Run indicates an expected call of Run.
-func (mr *MockICustomGoshaExecMockRecorder) Run(isSync, commands interface{}) *gomock.Call {
+func (mr *MockICustomGoshaExecMockRecorder) Run(ctx, isSync, commands interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICustomGoshaExec)(nil).Run), isSync, commands)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICustomGoshaExec)(nil).Run), ctx, isSync, commands)
 }
@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./webhook.go
+
+// Package mock_common is a generated GoMock package.
+package mock_common
+
+import (
+	schema "pg-sh-scripts/internal/schema"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIWebhookNotifier is a mock of IWebhookNotifier interface.
+type MockIWebhookNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookNotifierMockRecorder
+}
+
+// MockIWebhookNotifierMockRecorder is the mock recorder for MockIWebhookNotifier.
+type MockIWebhookNotifierMockRecorder struct {
+	mock *MockIWebhookNotifier
+}
+
+// NewMockIWebhookNotifier creates a new mock instance.
+func NewMockIWebhookNotifier(ctrl *gomock.Controller) *MockIWebhookNotifier {
+	mock := &MockIWebhookNotifier{ctrl: ctrl}
+	mock.recorder = &MockIWebhookNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookNotifier) EXPECT() *MockIWebhookNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockIWebhookNotifier) Notify(payload *schema.WebhookPayload) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Notify", payload)
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockIWebhookNotifierMockRecorder) Notify(payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockIWebhookNotifier)(nil).Notify), payload)
+}
@@ -0,0 +1,172 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/webhook"
+	"sync"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./webhook.go  -destination=./mock/webhook.go
+
+type (
+	IWebhookNotifier interface {
+		Notify(payload *schema.WebhookPayload)
+	}
+
+	WebhookNotifier struct {
+		client        webhook.IClient
+		deliveryGroup *WebhookDeliveryGroup
+		logger        *logging.Logger
+	}
+
+	// WebhookDeliveryGroup tracks the webhook deliveries running in the background,
+	// so the server can wait for them on shutdown before the database is closed.
+	WebhookDeliveryGroup struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+		m      sync.Mutex
+		closed bool
+	}
+)
+
+var (
+	webhookDeliveryGroupInstance *WebhookDeliveryGroup
+	webhookDeliveryGroupOnce     sync.Once
+)
+
+// Go runs the delivery in the background, it returns false when the group is already closed.
+func (g *WebhookDeliveryGroup) Go(deliver func(ctx context.Context)) bool {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if g.closed {
+		return false
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		deliver(g.ctx)
+	}()
+	return true
+}
+
+// Close stops accepting deliveries and waits for the running ones until the context is done,
+// then the rest of them are canceled, so neither their retries nor their logs outlive the database.
+func (g *WebhookDeliveryGroup) Close(ctx context.Context) error {
+	g.m.Lock()
+	g.closed = true
+	g.m.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		g.cancel()
+		return ctx.Err()
+	}
+}
+
+func GetWebhookDeliveryGroup() *WebhookDeliveryGroup {
+	webhookDeliveryGroupOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		webhookDeliveryGroupInstance = &WebhookDeliveryGroup{
+			ctx:    ctx,
+			cancel: cancel,
+		}
+	})
+
+	return webhookDeliveryGroupInstance
+}
+
+func (n *WebhookNotifier) deliver(ctx context.Context, wh *model.Webhook, event string, payload []byte) {
+	webhookDeliveryService := service.GetWebhookDeliveryService()
+
+	req := &webhook.Request{
+		Url:     wh.Url,
+		Secret:  wh.Secret,
+		Event:   event,
+		Payload: payload,
+	}
+
+	err := n.client.Send(ctx, req, func(attempt webhook.Attempt) {
+		createWebhookDeliveryDTO := dto.CreateWebhookDelivery{
+			WebhookId:  wh.Id,
+			Event:      event,
+			Payload:    payload,
+			Attempt:    attempt.Number,
+			StatusCode: attempt.StatusCode,
+			IsSuccess:  attempt.Err == nil,
+		}
+		if attempt.Err != nil {
+			createWebhookDeliveryDTO.Error = attempt.Err.Error()
+		}
+		_, _ = webhookDeliveryService.Create(ctx, createWebhookDeliveryDTO)
+	})
+	if err != nil {
+		n.logger.Error(fmt.Sprintf("Delivering webhook: %v event: %s Error: %s", wh.Id, event, err))
+	}
+}
+
+func (n *WebhookNotifier) Notify(payload *schema.WebhookPayload) {
+	webhookService := service.GetWebhookService()
+
+	bashId := uuid.Nil
+	if payload.BashId != nil {
+		bashId = *payload.BashId
+	}
+
+	webhooks, err := webhookService.GetListByBashIdAndEvent(
+		context.Background(),
+		bashId,
+		payload.Event,
+	)
+	if err != nil || len(webhooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		n.logger.Error(fmt.Sprintf("Marshaling webhook payload Error: %s", err))
+		return
+	}
+
+	for _, wh := range webhooks {
+		if !n.deliveryGroup.Go(func(ctx context.Context) { n.deliver(ctx, wh, payload.Event, body) }) {
+			n.logger.Error(fmt.Sprintf("Delivering webhook: %v event: %s Error: server is shutting down", wh.Id, payload.Event))
+		}
+	}
+}
+
+func GetWebhookNotifier() IWebhookNotifier {
+	cfg := config.GetConfig()
+
+	clientConfig := webhook.ClientConfig{
+		RetryCount:        cfg.Webhook.RetryCount,
+		RetrySleepSeconds: cfg.Webhook.RetrySleepSeconds,
+		TimeoutSeconds:    cfg.Webhook.TimeoutSeconds,
+	}
+
+	return &WebhookNotifier{
+		client:        webhook.GetClient(&clientConfig),
+		deliveryGroup: GetWebhookDeliveryGroup(),
+		logger:        log.GetLogger(),
+	}
+}
@@ -0,0 +1,6 @@
+package auth
+
+type Config struct {
+	Enabled  bool   `yaml:"enabled" env:"AUTH_ENABLED"`
+	AdminKey string `               env:"AUTH_ADMIN_KEY"`
+}
@@ -5,9 +5,22 @@ import (
 	"log"
 	"os"
 	"pg-sh-scripts/internal/config/api"
+	"pg-sh-scripts/internal/config/auth"
+	"pg-sh-scripts/internal/config/health"
+	"pg-sh-scripts/internal/config/i18n"
+	"pg-sh-scripts/internal/config/idempotency"
+	"pg-sh-scripts/internal/config/inline"
+	"pg-sh-scripts/internal/config/jwt"
+	"pg-sh-scripts/internal/config/metrics"
+	"pg-sh-scripts/internal/config/partition"
 	"pg-sh-scripts/internal/config/postgres"
 	"pg-sh-scripts/internal/config/project"
+	"pg-sh-scripts/internal/config/redaction"
+	"pg-sh-scripts/internal/config/retention"
 	"pg-sh-scripts/internal/config/server"
+	"pg-sh-scripts/internal/config/stats"
+	"pg-sh-scripts/internal/config/tracing"
+	"pg-sh-scripts/internal/config/webhook"
 	"sync"
 
 	"github.com/joho/godotenv"
@@ -16,10 +29,23 @@ import (
 )
 
 type Config struct {
-	Project  project.Config
-	Server   server.Config
-	Api      api.Config      `yaml:"api"`
-	Postgres postgres.Config `yaml:"postgres"`
+	Project     project.Config
+	Server      server.Config      `yaml:"server"`
+	Api         api.Config         `yaml:"api"`
+	Postgres    postgres.Config    `yaml:"postgres"`
+	Webhook     webhook.Config     `yaml:"webhook"`
+	Inline      inline.Config      `yaml:"inline"`
+	Idempotency idempotency.Config `yaml:"idempotency"`
+	Auth        auth.Config        `yaml:"auth"`
+	Jwt         jwt.Config         `yaml:"jwt"`
+	Metrics     metrics.Config     `yaml:"metrics"`
+	Health      health.Config      `yaml:"health"`
+	Tracing     tracing.Config     `yaml:"tracing"`
+	I18n        i18n.Config        `yaml:"i18n"`
+	Retention   retention.Config   `yaml:"retention"`
+	Partition   partition.Config   `yaml:"partition"`
+	Stats       stats.Config       `yaml:"stats"`
+	Redaction   redaction.Config   `yaml:"redaction"`
 }
 
 var (
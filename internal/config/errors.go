@@ -25,15 +25,102 @@ type HTTPErrors struct {
 	BashExecuteDTOList    error
 	BashExecute           error
 	BashRemove            error
+	BashExecuteInlineDTO  error
+	BashExecuteInlineBody error
+	BashExecuteInlineEnv  error
 
 	// Bash Log Errors
 	BashLogGetPaginationPageByBashId error
+	BashLogGetPaginationPageByRunId  error
+	BashLogExportFormat              error
+	BashLogExport                    error
+	BashLogFilterRegex               error
+
+	// Bash Run Errors
+	BashRunId                        error
+	BashRunDoesNotExists             error
+	BashRunGetPaginationPageByBashId error
+
+	// Webhook Errors
+	WebhookId                                   error
+	WebhookCreateDTO                            error
+	WebhookUrl                                  error
+	WebhookSecret                               error
+	WebhookEvents                               error
+	WebhookCreate                               error
+	WebhookDoesNotExists                        error
+	WebhookGetPaginationPage                    error
+	WebhookRemove                               error
+	WebhookDeliveryGetPaginationPageByWebhookId error
+
+	// Idempotency Key Errors
+	IdempotencyKey           error
+	IdempotencyKeyMismatch   error
+	IdempotencyKeyInProgress error
+	IdempotencyKeyGet        error
+	IdempotencyKeyCreate     error
+
+	// Api Key Errors
+	ApiKeyUnauthorized      error
+	ApiKeyRevoked           error
+	ApiKeyExpired           error
+	ApiKeyId                error
+	ApiKeyCreateDTO         error
+	ApiKeyName              error
+	ApiKeyExpiresAt         error
+	ApiKeyCreate            error
+	ApiKeyDoesNotExists     error
+	ApiKeyGetPaginationPage error
+	ApiKeyRevoke            error
+	ApiKeyExpire            error
+	ApiKeyExpireDTO         error
+	ApiKeyRole              error
+
+	// Access Errors
+	AccessPermissionDenied error
+	AccessBashAclDenied    error
+
+	// Bash Acl Errors
+	BashAclId              error
+	BashAclCreateDTO       error
+	BashAclRoleOrSubject   error
+	BashAclCreate          error
+	BashAclDoesNotExists   error
+	BashAclGetListByBashId error
+	BashAclRemove          error
+
+	// Token Errors
+	TokenInvalid error
+	TokenExpired error
+
+	// Audit Errors
+	AuditGetPaginationPage error
+	AuditFilter            error
+	AuditExport            error
+	AuditCreate            error
+
+	// Bash Log Retention Errors
+	BashLogRetentionSetDTO        error
+	BashLogRetentionDoesNotExists error
+	BashLogRetentionGet           error
+	BashLogRetentionSet           error
+	BashLogRetentionRemove        error
+	BashLogPrune                  error
+	BashLogPruneDryRunParam       error
+
+	// Bash Run Stats Errors
+	BashRunStatsWindowParam error
+	BashRunStatsGetByBashId error
+	BashRunStatsGetOverview error
 
 	// Pagination
 	PaginationLimitParamMustBeInt  error
 	PaginationLimitParamGTEZero    error
 	PaginationOffsetParamMustBeInt error
 	PaginationOffsetParamGTEZero   error
+	PaginationCursorParam          error
+	PaginationCursorWithOffset     error
+	PaginationTotalModeParam       error
 }
 
 var (
@@ -48,6 +135,11 @@ func setHTTPErrors(errors *HTTPErrors) {
 		ServiceCode: 0,
 		Detail:      "Internal Error",
 	}
+	errors.Validate = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 1,
+		Detail:      "The request contains invalid fields",
+	}
 
 	// Pagination
 	errors.PaginationLimitParamMustBeInt = &schema.HTTPError{
@@ -70,6 +162,21 @@ func setHTTPErrors(errors *HTTPErrors) {
 		ServiceCode: 103,
 		Detail:      "The offset pagination parameter must be greater than or equal to zero",
 	}
+	errors.PaginationCursorParam = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 104,
+		Detail:      "The cursor pagination parameter must be the next or prev token of the previous page",
+	}
+	errors.PaginationCursorWithOffset = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 105,
+		Detail:      "The cursor and offset pagination parameters can not be used together",
+	}
+	errors.PaginationTotalModeParam = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 106,
+		Detail:      "The totalMode pagination parameter must be one of: exact, estimate, none",
+	}
 
 	// Bash Errors
 	errors.BashId = &schema.HTTPError{
@@ -137,6 +244,21 @@ func setHTTPErrors(errors *HTTPErrors) {
 		ServiceCode: 212,
 		Detail:      "An error occurred while deleting the bash script",
 	}
+	errors.BashExecuteInlineDTO = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 213,
+		Detail:      "Invalid body of the request to start executing inline bash script",
+	}
+	errors.BashExecuteInlineBody = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 214,
+		Detail:      "The inline bash script body should not be an empty string",
+	}
+	errors.BashExecuteInlineEnv = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 215,
+		Detail:      "The environment variable names must consist of letters, digits and underscores and not start with a digit",
+	}
 
 	// Bash Log Errors
 	errors.BashLogGetPaginationPageByBashId = &schema.HTTPError{
@@ -144,6 +266,331 @@ func setHTTPErrors(errors *HTTPErrors) {
 		ServiceCode: 300,
 		Detail:      "An error occurred while receiving the pagination page of bash log scripts",
 	}
+	errors.BashLogGetPaginationPageByRunId = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 301,
+		Detail:      "An error occurred while receiving the pagination page of bash log scripts by run",
+	}
+	errors.BashLogExportFormat = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 302,
+		Detail:      "The export format must be one of: txt, ndjson, csv",
+	}
+	errors.BashLogExport = &schema.HTTPError{
+		HTTPCode:    http.StatusInternalServerError,
+		ServiceCode: 303,
+		Detail:      "An error occurred while exporting bash logs",
+	}
+	errors.BashLogFilterRegex = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 304,
+		Detail:      "The regex filter is not a valid postgres regular expression",
+	}
+
+	// Webhook Errors
+	errors.WebhookId = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 400,
+		Detail:      "The webhook id must be of type uuid4 like 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+	}
+	errors.WebhookCreateDTO = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 401,
+		Detail:      "Invalid body of the request to create a webhook",
+	}
+	errors.WebhookUrl = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 402,
+		Detail:      "The webhook url must be an absolute http or https url",
+	}
+	errors.WebhookSecret = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 403,
+		Detail:      "The webhook secret should not be an empty string",
+	}
+	errors.WebhookEvents = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 404,
+		Detail:      "The webhook events must be a non-empty list of: run.start, run.success, run.failure, run.timeout",
+	}
+	errors.WebhookCreate = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 405,
+		Detail:      "An error occurred during the creation of the webhook entity",
+	}
+	errors.WebhookDoesNotExists = &schema.HTTPError{
+		HTTPCode:    http.StatusNotFound,
+		ServiceCode: 406,
+		Detail:      "The specified webhook does not exists",
+	}
+	errors.WebhookGetPaginationPage = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 407,
+		Detail:      "An error occurred while receiving the pagination page of webhooks",
+	}
+	errors.WebhookRemove = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 408,
+		Detail:      "An error occurred while deleting the webhook",
+	}
+	errors.WebhookDeliveryGetPaginationPageByWebhookId = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 409,
+		Detail:      "An error occurred while receiving the pagination page of webhook deliveries",
+	}
+
+	// Bash Run Errors
+	errors.BashRunId = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 500,
+		Detail:      "The bash run id must be of type uuid4 like 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+	}
+	errors.BashRunDoesNotExists = &schema.HTTPError{
+		HTTPCode:    http.StatusNotFound,
+		ServiceCode: 501,
+		Detail:      "The specified bash run does not exists",
+	}
+	errors.BashRunGetPaginationPageByBashId = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 502,
+		Detail:      "An error occurred while receiving the pagination page of bash runs",
+	}
+
+	// Idempotency Key Errors
+	errors.IdempotencyKey = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 600,
+		Detail:      "The Idempotency-Key header must not be longer than 255 characters",
+	}
+	errors.IdempotencyKeyMismatch = &schema.HTTPError{
+		HTTPCode:    http.StatusConflict,
+		ServiceCode: 601,
+		Detail:      "The Idempotency-Key has already been used with a different request body",
+	}
+	errors.IdempotencyKeyInProgress = &schema.HTTPError{
+		HTTPCode:    http.StatusConflict,
+		ServiceCode: 602,
+		Detail:      "The request with the same Idempotency-Key is still being processed",
+	}
+	errors.IdempotencyKeyGet = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 603,
+		Detail:      "An error occurred while receiving the result of the request by Idempotency-Key",
+	}
+	errors.IdempotencyKeyCreate = &schema.HTTPError{
+		HTTPCode:    http.StatusInternalServerError,
+		ServiceCode: 604,
+		Detail:      "An error occurred while reserving the Idempotency-Key",
+	}
+
+	// Api Key Errors
+	errors.ApiKeyUnauthorized = &schema.HTTPError{
+		HTTPCode:    http.StatusUnauthorized,
+		ServiceCode: 700,
+		Detail:      "The request must contain a valid api key in the Authorization: Bearer header",
+	}
+	errors.ApiKeyRevoked = &schema.HTTPError{
+		HTTPCode:    http.StatusUnauthorized,
+		ServiceCode: 701,
+		Detail:      "The api key has been revoked",
+	}
+	errors.ApiKeyExpired = &schema.HTTPError{
+		HTTPCode:    http.StatusUnauthorized,
+		ServiceCode: 702,
+		Detail:      "The api key has expired",
+	}
+	errors.ApiKeyId = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 703,
+		Detail:      "The api key id must be of type uuid4 like 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+	}
+	errors.ApiKeyCreateDTO = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 704,
+		Detail:      "Invalid body of the request to create an api key",
+	}
+	errors.ApiKeyName = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 705,
+		Detail:      "The api key name should not be an empty string",
+	}
+	errors.ApiKeyExpiresAt = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 706,
+		Detail:      "The api key expiration time must be in the future",
+	}
+	errors.ApiKeyCreate = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 707,
+		Detail:      "An error occurred during the creation of the api key entity",
+	}
+	errors.ApiKeyDoesNotExists = &schema.HTTPError{
+		HTTPCode:    http.StatusNotFound,
+		ServiceCode: 708,
+		Detail:      "The specified api key does not exists",
+	}
+	errors.ApiKeyGetPaginationPage = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 709,
+		Detail:      "An error occurred while receiving the pagination page of api keys",
+	}
+	errors.ApiKeyRevoke = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 710,
+		Detail:      "An error occurred while revoking the api key",
+	}
+	errors.ApiKeyExpire = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 711,
+		Detail:      "An error occurred while expiring the api key",
+	}
+	errors.ApiKeyExpireDTO = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 712,
+		Detail:      "Invalid body of the request to expire an api key",
+	}
+	errors.ApiKeyRole = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 713,
+		Detail:      "The api key role must be one of: viewer, operator, author, admin",
+	}
+
+	// Access Errors
+	errors.AccessPermissionDenied = &schema.HTTPError{
+		HTTPCode:    http.StatusForbidden,
+		ServiceCode: 800,
+		Detail:      "The role of the caller does not have the permission for this request",
+	}
+	errors.AccessBashAclDenied = &schema.HTTPError{
+		HTTPCode:    http.StatusForbidden,
+		ServiceCode: 801,
+		Detail:      "The caller is not allowed to execute the bash script by its access control list",
+	}
+
+	// Bash Acl Errors
+	errors.BashAclId = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 900,
+		Detail:      "The bash acl id must be of type uuid4 like 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+	}
+	errors.BashAclCreateDTO = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 901,
+		Detail:      "Invalid body of the request to create a bash acl entry",
+	}
+	errors.BashAclRoleOrSubject = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 902,
+		Detail:      "The bash acl entry must contain either a role of: viewer, operator, author, admin or a non-empty subject",
+	}
+	errors.BashAclCreate = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 903,
+		Detail:      "An error occurred during the creation of the bash acl entry",
+	}
+	errors.BashAclDoesNotExists = &schema.HTTPError{
+		HTTPCode:    http.StatusNotFound,
+		ServiceCode: 904,
+		Detail:      "The specified bash acl entry does not exists",
+	}
+	errors.BashAclGetListByBashId = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 905,
+		Detail:      "An error occurred while receiving the bash acl entries",
+	}
+	errors.BashAclRemove = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 906,
+		Detail:      "An error occurred while deleting the bash acl entry",
+	}
+
+	// Token Errors
+	errors.TokenInvalid = &schema.HTTPError{
+		HTTPCode:    http.StatusUnauthorized,
+		ServiceCode: 1000,
+		Detail:      "The bearer token is invalid: wrong signature, issuer or audience",
+	}
+	errors.TokenExpired = &schema.HTTPError{
+		HTTPCode:    http.StatusUnauthorized,
+		ServiceCode: 1001,
+		Detail:      "The bearer token has expired",
+	}
+
+	// Audit Errors
+	errors.AuditGetPaginationPage = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1100,
+		Detail:      "An error occurred while receiving the pagination page of audit events",
+	}
+	errors.AuditFilter = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1101,
+		Detail:      "Invalid audit filter: outcome must be success or failure, from and to must be RFC 3339 and from must be before to",
+	}
+	errors.AuditExport = &schema.HTTPError{
+		HTTPCode:    http.StatusInternalServerError,
+		ServiceCode: 1102,
+		Detail:      "An error occurred while exporting audit events",
+	}
+	errors.AuditCreate = &schema.HTTPError{
+		HTTPCode:    http.StatusInternalServerError,
+		ServiceCode: 1103,
+		Detail:      "An error occurred while recording the audit event",
+	}
+
+	// Bash Log Retention Errors
+	errors.BashLogRetentionSetDTO = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 1200,
+		Detail:      "The bash log retention must be a json object with the optional maxAgeSeconds, maxRows and keepRuns numbers",
+	}
+	errors.BashLogRetentionDoesNotExists = &schema.HTTPError{
+		HTTPCode:    http.StatusNotFound,
+		ServiceCode: 1201,
+		Detail:      "The bash script has no log retention of its own",
+	}
+	errors.BashLogRetentionGet = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1202,
+		Detail:      "An error occurred while receiving the bash log retention",
+	}
+	errors.BashLogRetentionSet = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1203,
+		Detail:      "An error occurred while setting the bash log retention",
+	}
+	errors.BashLogRetentionRemove = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1204,
+		Detail:      "An error occurred while removing the bash log retention",
+	}
+	errors.BashLogPrune = &schema.HTTPError{
+		HTTPCode:    http.StatusInternalServerError,
+		ServiceCode: 1205,
+		Detail:      "An error occurred while pruning bash logs",
+	}
+	errors.BashLogPruneDryRunParam = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 1206,
+		Detail:      "The dryRun param must be a boolean",
+	}
+
+	// Bash Run Stats Errors
+	errors.BashRunStatsWindowParam = &schema.HTTPError{
+		HTTPCode:    http.StatusUnprocessableEntity,
+		ServiceCode: 1300,
+		Detail:      "The window param must be one of the configured stats windows like 24h, 7d or 30d",
+	}
+	errors.BashRunStatsGetByBashId = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1301,
+		Detail:      "An error occurred while receiving the bash script stats",
+	}
+	errors.BashRunStatsGetOverview = &schema.HTTPError{
+		HTTPCode:    http.StatusBadRequest,
+		ServiceCode: 1302,
+		Detail:      "An error occurred while receiving the stats overview",
+	}
 }
 
 func GetHTTPErrors() *HTTPErrors {
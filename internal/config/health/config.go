@@ -0,0 +1,8 @@
+package health
+
+import "time"
+
+type Config struct {
+	CheckTimeoutSeconds time.Duration `yaml:"checkTimeoutSeconds"`
+	MaxRunningScripts   int64         `yaml:"maxRunningScripts" env:"HEALTH_MAX_RUNNING_SCRIPTS"`
+}
@@ -0,0 +1,5 @@
+package i18n
+
+type Config struct {
+	DefaultLanguage string `yaml:"defaultLanguage" env:"I18N_DEFAULT_LANGUAGE" env-default:"en"`
+}
@@ -0,0 +1,8 @@
+package idempotency
+
+import "time"
+
+type Config struct {
+	TTLSeconds           time.Duration `yaml:"ttlSeconds"`
+	PruneIntervalSeconds time.Duration `yaml:"pruneIntervalSeconds"`
+}
@@ -0,0 +1,8 @@
+package inline
+
+import "time"
+
+type Config struct {
+	RetentionSeconds     time.Duration `yaml:"retentionSeconds"`
+	PruneIntervalSeconds time.Duration `yaml:"pruneIntervalSeconds"`
+}
@@ -0,0 +1,16 @@
+package jwt
+
+import "time"
+
+type Config struct {
+	Enabled                bool              `yaml:"enabled"                env:"JWT_ENABLED"`
+	JWKSSource             string            `yaml:"jwksSource"             env:"JWT_JWKS_SOURCE"`
+	RefreshIntervalSeconds time.Duration     `yaml:"refreshIntervalSeconds"`
+	Issuer                 string            `yaml:"issuer"                 env:"JWT_ISSUER"`
+	Audience               string            `yaml:"audience"               env:"JWT_AUDIENCE"`
+	LeewaySeconds          time.Duration     `yaml:"leewaySeconds"`
+	SubjectClaim           string            `yaml:"subjectClaim"`
+	NameClaim              string            `yaml:"nameClaim"`
+	RolesClaim             string            `yaml:"rolesClaim"`
+	RoleMapping            map[string]string `yaml:"roleMapping"`
+}
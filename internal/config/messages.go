@@ -0,0 +1,164 @@
+package config
+
+import (
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/pkg/i18n"
+	"reflect"
+	"sync"
+)
+
+const (
+	LanguageEn = "en"
+	LanguageRu = "ru"
+)
+
+var (
+	errorMessagesInstance map[string]i18n.Messages
+	errorMessagesOnce     sync.Once
+)
+
+// getErrorMessagesEn collects the details of the http errors, so the english messages
+// are declared once in setHTTPErrors.
+func getErrorMessagesEn(errors *HTTPErrors) i18n.Messages {
+	messages := make(i18n.Messages)
+
+	value := reflect.ValueOf(errors).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if httpErr, ok := value.Field(i).Interface().(*schema.HTTPError); ok {
+			messages[httpErr.ServiceCode] = httpErr.Detail
+		}
+	}
+
+	return messages
+}
+
+func getErrorMessagesRu() i18n.Messages {
+	return i18n.Messages{
+		// Base Errors
+		0: "Внутренняя ошибка",
+		1: "Запрос содержит неверные поля",
+
+		// Pagination
+		100: "Параметр пагинации limit должен быть целым числом",
+		101: "Параметр пагинации limit должен быть больше или равен нулю",
+		102: "Параметр пагинации offset должен быть целым числом",
+		103: "Параметр пагинации offset должен быть больше или равен нулю",
+		104: "Параметр пагинации cursor должен быть токеном next или prev предыдущей страницы",
+		105: "Параметры пагинации cursor и offset не могут использоваться вместе",
+		106: "Параметр пагинации totalMode должен быть одним из: exact, estimate, none",
+
+		// Bash Errors
+		200: "ID Bash скрипта должен быть типа uuid4, например 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+		201: "Файл Bash скрипта не был загружен",
+		202: "Расширение файла должно быть только .sh",
+		203: "Название файла не должно быть пустой строкой",
+		204: "Содержимое файла не должно быть пустой строкой",
+		205: "Произошла ошибка при чтении содержимого файла",
+		206: "Произошла ошибка при создании Bash скрипта",
+		207: "Указанный Bash скрипт не существует",
+		208: "Произошла ошибка при получении страницы Bash скриптов",
+		209: "Параметр выполнения isSync должен быть типа bool",
+		210: "Неверное тело запроса на выполнение Bash скриптов",
+		211: "Произошла ошибка при выполнении Bash скрипта",
+		212: "Произошла ошибка при удалении Bash скрипта",
+		213: "Неверное тело запроса на выполнение inline Bash скрипта",
+		214: "Тело inline Bash скрипта не должно быть пустой строкой",
+		215: "Имена переменных окружения должны состоять из букв, цифр и подчеркиваний и не начинаться с цифры",
+
+		// Bash Log Errors
+		300: "Произошла ошибка при получении страницы логов Bash скрипта",
+		301: "Произошла ошибка при получении страницы логов запуска Bash скрипта",
+		302: "Формат выгрузки должен быть одним из: txt, ndjson, csv",
+		303: "Произошла ошибка при выгрузке логов Bash скрипта",
+		304: "Фильтр regex не является корректным регулярным выражением postgres",
+
+		// Webhook Errors
+		400: "ID вебхука должен быть типа uuid4, например 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+		401: "Неверное тело запроса на создание вебхука",
+		402: "URL вебхука должен быть абсолютным http или https URL",
+		403: "Секрет вебхука не должен быть пустой строкой",
+		404: "События вебхука должны быть непустым списком из: run.start, run.success, run.failure, run.timeout",
+		405: "Произошла ошибка при создании вебхука",
+		406: "Указанный вебхук не существует",
+		407: "Произошла ошибка при получении страницы вебхуков",
+		408: "Произошла ошибка при удалении вебхука",
+		409: "Произошла ошибка при получении страницы доставок вебхука",
+
+		// Bash Run Errors
+		500: "ID запуска Bash скрипта должен быть типа uuid4, например 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+		501: "Указанный запуск Bash скрипта не существует",
+		502: "Произошла ошибка при получении страницы запусков Bash скрипта",
+
+		// Idempotency Key Errors
+		600: "Заголовок Idempotency-Key не должен быть длиннее 255 символов",
+		601: "Idempotency-Key уже был использован с другим телом запроса",
+		602: "Запрос с тем же Idempotency-Key еще обрабатывается",
+		603: "Произошла ошибка при получении результата запроса по Idempotency-Key",
+		604: "Произошла ошибка при резервировании Idempotency-Key",
+
+		// Api Key Errors
+		700: "Запрос должен содержать действительный api ключ в заголовке Authorization: Bearer",
+		701: "Api ключ отозван",
+		702: "Срок действия api ключа истек",
+		703: "ID api ключа должен быть типа uuid4, например 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+		704: "Неверное тело запроса на создание api ключа",
+		705: "Имя api ключа не должно быть пустой строкой",
+		706: "Время истечения api ключа должно быть в будущем",
+		707: "Произошла ошибка при создании api ключа",
+		708: "Указанный api ключ не существует",
+		709: "Произошла ошибка при получении страницы api ключей",
+		710: "Произошла ошибка при отзыве api ключа",
+		711: "Произошла ошибка при истечении срока действия api ключа",
+		712: "Неверное тело запроса на истечение срока действия api ключа",
+		713: "Роль api ключа должна быть одной из: viewer, operator, author, admin",
+
+		// Access Errors
+		800: "Роль вызывающего не имеет разрешения на этот запрос",
+		801: "Списком контроля доступа вызывающему запрещено выполнять Bash скрипт",
+
+		// Bash Acl Errors
+		900: "ID записи acl Bash скрипта должен быть типа uuid4, например 151a583c-0ea0-46b8-b8a6-6bdcdd51655a",
+		901: "Неверное тело запроса на создание записи acl Bash скрипта",
+		902: "Запись acl Bash скрипта должна содержать либо роль из: viewer, operator, author, admin, либо непустой subject",
+		903: "Произошла ошибка при создании записи acl Bash скрипта",
+		904: "Указанная запись acl Bash скрипта не существует",
+		905: "Произошла ошибка при получении записей acl Bash скрипта",
+		906: "Произошла ошибка при удалении записи acl Bash скрипта",
+
+		// Token Errors
+		1000: "Bearer токен недействителен: неверная подпись, издатель или аудитория",
+		1001: "Срок действия bearer токена истек",
+
+		// Audit Errors
+		1100: "Произошла ошибка при получении страницы событий аудита",
+		1101: "Неверный фильтр аудита: outcome должен быть success или failure, from и to должны быть в формате RFC 3339, а from должен быть раньше to",
+		1102: "Произошла ошибка при экспорте событий аудита",
+		1103: "Произошла ошибка при записи события аудита",
+
+		// Bash Log Retention Errors
+		1200: "Политика хранения логов Bash скрипта должна быть JSON объектом с необязательными числами maxAgeSeconds, maxRows и keepRuns",
+		1201: "У Bash скрипта нет собственной политики хранения логов",
+		1202: "Произошла ошибка при получении политики хранения логов Bash скрипта",
+		1203: "Произошла ошибка при установке политики хранения логов Bash скрипта",
+		1204: "Произошла ошибка при удалении политики хранения логов Bash скрипта",
+		1205: "Произошла ошибка при очистке логов Bash скриптов",
+		1206: "Параметр dryRun должен быть логическим значением",
+
+		// Bash Run Stats Errors
+		1300: "Параметр window должен быть одним из окон статистики из конфигурации, например 24h, 7d или 30d",
+		1301: "Произошла ошибка при получении статистики Bash скрипта",
+		1302: "Произошла ошибка при получении обзора статистики",
+	}
+}
+
+// GetErrorMessages returns the catalog of the http error details keyed by the language and the service code.
+func GetErrorMessages() map[string]i18n.Messages {
+	errorMessagesOnce.Do(func() {
+		errorMessagesInstance = map[string]i18n.Messages{
+			LanguageEn: getErrorMessagesEn(GetHTTPErrors()),
+			LanguageRu: getErrorMessagesRu(),
+		}
+	})
+
+	return errorMessagesInstance
+}
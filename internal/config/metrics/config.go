@@ -0,0 +1,6 @@
+package metrics
+
+type Config struct {
+	Enabled bool   `yaml:"enabled" env:"METRICS_ENABLED"`
+	Path    string `yaml:"path"`
+}
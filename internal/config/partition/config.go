@@ -0,0 +1,12 @@
+package partition
+
+import "time"
+
+// Config is the maintenance of the monthly bash log partitions, the zero retention keeps
+// all partitions, the expired partitions are detached instead of dropped with the detach flag.
+type Config struct {
+	PremakeMonths              int           `yaml:"premakeMonths"`
+	RetentionMonths            int           `yaml:"retentionMonths"`
+	Detach                     bool          `yaml:"detach"`
+	MaintenanceIntervalSeconds time.Duration `yaml:"maintenanceIntervalSeconds"`
+}
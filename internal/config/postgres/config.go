@@ -10,4 +10,6 @@ type Config struct {
 	Port              string        `yaml:"port"              env:"POSTGRES_PORT"     env-required:"true"`
 	RetryCount        int           `yaml:"retryCount"`
 	RetrySleepSeconds time.Duration `yaml:"retrySleepSeconds"`
+	// FilterStatementTimeoutSeconds limits the filtered pages of bash logs, the zero timeout is not limited.
+	FilterStatementTimeoutSeconds time.Duration `yaml:"filterStatementTimeoutSeconds"`
 }
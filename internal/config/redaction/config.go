@@ -0,0 +1,14 @@
+package redaction
+
+import "pg-sh-scripts/pkg/redact"
+
+// Config is the redaction of the script output before it's stored: the matches of the rules and of the builtin
+// credential patterns and the values of the environment variables whose names match secretEnvNames
+// are replaced with ***, the values shorter than secretMinLength are not treated as secrets.
+type Config struct {
+	Enabled         bool             `yaml:"enabled"`
+	Builtin         bool             `yaml:"builtin"`
+	Rules           []redact.Pattern `yaml:"rules"`
+	SecretEnvNames  redact.Pattern   `yaml:"secretEnvNames"`
+	SecretMinLength int              `yaml:"secretMinLength"`
+}
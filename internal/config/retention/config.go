@@ -0,0 +1,13 @@
+package retention
+
+import "time"
+
+// Config is the global retention of bash logs, the zero limits keep the logs without limit
+// unless the script has its own retention.
+type Config struct {
+	MaxAgeSeconds        time.Duration `yaml:"maxAgeSeconds"`
+	MaxRows              int64         `yaml:"maxRows"`
+	KeepRuns             int64         `yaml:"keepRuns"`
+	BatchSize            int           `yaml:"batchSize"`
+	PruneIntervalSeconds time.Duration `yaml:"pruneIntervalSeconds"`
+}
@@ -1,5 +1,11 @@
 package server
 
+import "time"
+
 type Config struct {
-	Port string `env:"SERVER_PORT" env-required:"true"`
+	Port                   string        `env:"SERVER_PORT" env-required:"true"`
+	ShutdownTimeoutSeconds time.Duration `yaml:"shutdownTimeoutSeconds" env-default:"30s"`
+	// InterruptTimeoutSeconds bounds the wait for the scripts interrupted after the shutdown timeout
+	// and for their handlers, the database is closed after it even if some of them are still running.
+	InterruptTimeoutSeconds time.Duration `yaml:"interruptTimeoutSeconds" env-default:"10s"`
 }
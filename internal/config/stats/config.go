@@ -0,0 +1,13 @@
+package stats
+
+import (
+	"pg-sh-scripts/pkg/stats"
+	"time"
+)
+
+// Config is the bash run stats, the first window is the default one of the overview
+// and the zero rollup interval aggregates the overview from the runs on every request.
+type Config struct {
+	Windows               []stats.Window `yaml:"windows"`
+	RollupIntervalSeconds time.Duration  `yaml:"rollupIntervalSeconds"`
+}
@@ -0,0 +1,14 @@
+package tracing
+
+import "time"
+
+type Config struct {
+	Enabled     bool    `yaml:"enabled"     env:"TRACING_ENABLED"`
+	ServiceName string  `yaml:"serviceName"`
+	Exporter    string  `yaml:"exporter"    env:"TRACING_EXPORTER" env-description:"stdout/otlp"`
+	Endpoint    string  `yaml:"endpoint"    env:"TRACING_ENDPOINT"`
+	Insecure    bool    `yaml:"insecure"    env:"TRACING_INSECURE"`
+	SampleRatio float64 `yaml:"sampleRatio"`
+	// ShutdownTimeoutSeconds bounds the export of the buffered spans on shutdown.
+	ShutdownTimeoutSeconds time.Duration `yaml:"shutdownTimeoutSeconds" env-default:"5s"`
+}
@@ -0,0 +1,12 @@
+package webhook
+
+import "time"
+
+type Config struct {
+	RetryCount        int           `yaml:"retryCount"`
+	RetrySleepSeconds time.Duration `yaml:"retrySleepSeconds"`
+	TimeoutSeconds    time.Duration `yaml:"timeoutSeconds"`
+	OutputTailSize    int           `yaml:"outputTailSize"`
+	// ShutdownTimeoutSeconds bounds the wait for the running deliveries on shutdown.
+	ShutdownTimeoutSeconds time.Duration `yaml:"shutdownTimeoutSeconds" env-default:"10s"`
+}
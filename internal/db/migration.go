@@ -0,0 +1,20 @@
+package db
+
+import (
+	"github.com/pressly/goose/v3"
+)
+
+const MigrationDir = "migration"
+
+// GetLatestMigrationVersion returns the version of the newest migration in the migration dir.
+func GetLatestMigrationVersion() (int64, error) {
+	migrations, err := goose.CollectMigrations(MigrationDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, err
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, err
+	}
+	return last.Version, nil
+}
@@ -5,6 +5,8 @@ import (
 	"pg-sh-scripts/internal/config"
 	"pg-sh-scripts/pkg/client/postgres"
 	"sync"
+
+	"go.opentelemetry.io/otel"
 )
 
 var (
@@ -25,6 +27,7 @@ func GetPgClient() (postgres.IClient, error) {
 			Port:              cfg.Postgres.Port,
 			RetryCount:        cfg.Postgres.RetryCount,
 			RetrySleepSeconds: cfg.Postgres.RetrySleepSeconds,
+			Tracer:            postgres.NewQueryTracer(otel.GetTracerProvider()),
 		}
 
 		client, err := postgres.GetClient(context.Background(), &connConfig)
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+type (
+	CreateApiKey struct {
+		Name      string     `json:"name"      example:"deploy pipeline"`
+		Role      string     `json:"role"      example:"operator"`
+		ExpiresAt *time.Time `json:"expiresAt" example:"2025-04-14T15:50:21.907561+00:00"`
+	}
+
+	SaveApiKey struct {
+		Name      string
+		Prefix    string
+		Salt      string
+		Hash      string
+		Role      string
+		ExpiresAt *time.Time
+	}
+
+	ExpireApiKey struct {
+		ExpiresAt *time.Time `json:"expiresAt" example:"2025-04-14T15:50:21.907561+00:00"`
+	}
+)
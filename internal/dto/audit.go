@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type (
+	CreateAuditEvent struct {
+		Action       string
+		Actor        string
+		ActorSubject *string
+		ClientIp     string
+		RequestId    *string
+		Method       string
+		Path         string
+		TargetIds    []string
+		Outcome      string
+		StatusCode   int
+	}
+
+	AuditEventFilter struct {
+		Action   *string    `json:"action"   example:"bash.execute"`
+		Actor    *string    `json:"actor"    example:"deploy pipeline"`
+		Outcome  *string    `json:"outcome"  example:"failure"`
+		TargetId *string    `json:"targetId" example:"59628b82-356c-4745-bc81-187015cde387"`
+		From     *time.Time `json:"from"     example:"2024-04-14T00:00:00Z"`
+		To       *time.Time `json:"to"       example:"2024-04-15T00:00:00Z"`
+	}
+)
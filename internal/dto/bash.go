@@ -1,19 +1,35 @@
 package dto
 
 import (
+	"pg-sh-scripts/pkg/sql/query"
 	"time"
 
 	uuid "github.com/satori/go.uuid"
 )
 
+// ExecBashListValidation is the validate tag of the execute list,
+// its elements are validated by the tags of ExecBash.
+const ExecBashListValidation = "min=1,max=100,unique=Id"
+
 type (
+	BashFilter struct {
+		Query query.Params `json:"-"`
+	}
+
 	CreateBash struct {
 		Title string `json:"title"`
 		Body  string `json:"body"`
 	}
 
 	ExecBash struct {
-		Id             uuid.UUID     `json:"id"             swaggertype:"primitive,string"  example:"59628b82-356c-4745-bc81-187015cde387"`
-		TimeoutSeconds time.Duration `json:"timeoutSeconds" swaggertype:"primitive,integer"`
+		Id             uuid.UUID     `json:"id"             swaggertype:"primitive,string"  example:"59628b82-356c-4745-bc81-187015cde387" validate:"required"`
+		TimeoutSeconds time.Duration `json:"timeoutSeconds" swaggertype:"primitive,integer" minimum:"0" maximum:"86400" validate:"min=0,max=86400"`
+	}
+
+	ExecBashInline struct {
+		Body           string            `json:"body"           example:"echo $GREETING $1"`
+		TimeoutSeconds time.Duration     `json:"timeoutSeconds" swaggertype:"primitive,integer" minimum:"0" maximum:"86400" validate:"min=0,max=86400"`
+		Args           []string          `json:"args"           example:"world"`
+		Env            map[string]string `json:"env"`
 	}
 )
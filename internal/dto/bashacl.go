@@ -0,0 +1,9 @@
+package dto
+
+import uuid "github.com/satori/go.uuid"
+
+type CreateBashAcl struct {
+	BashId  uuid.UUID `json:"-"`
+	Role    *string   `json:"role"    example:"operator"`
+	Subject *string   `json:"subject" example:"3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"`
+}
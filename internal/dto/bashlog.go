@@ -1,9 +1,21 @@
 package dto
 
-import uuid "github.com/satori/go.uuid"
+import (
+	"pg-sh-scripts/pkg/sql/query"
 
-type CreateBashLog struct {
-	BashId  uuid.UUID `json:"bashId"  swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
-	Body    string    `json:"body"`
-	IsError bool      `json:"isError"`
-}
+	uuid "github.com/satori/go.uuid"
+)
+
+type (
+	CreateBashLog struct {
+		BashId  *uuid.UUID `json:"bashId"  swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+		RunId   uuid.UUID  `json:"runId"   swaggertype:"primitive,string" example:"7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"`
+		Body    string     `json:"body"`
+		IsError bool       `json:"isError"`
+	}
+
+	BashLogFilter struct {
+		RunId *uuid.UUID   `json:"runId" swaggertype:"primitive,string" example:"7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"`
+		Query query.Params `json:"-"`
+	}
+)
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"pg-sh-scripts/internal/config/retention"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type (
+	SetBashLogRetention struct {
+		BashId        uuid.UUID `json:"-"`
+		MaxAgeSeconds *int64    `json:"maxAgeSeconds" validate:"omitempty,gte=0" example:"604800"`
+		MaxRows       *int64    `json:"maxRows"       validate:"omitempty,gte=0" example:"100000"`
+		KeepRuns      *int64    `json:"keepRuns"      validate:"omitempty,gte=0" example:"10"`
+	}
+
+	// BashLogRetentionPolicy is the global retention applied to the scripts without their own limits,
+	// the zero limits keep the logs without limit.
+	BashLogRetentionPolicy struct {
+		MaxAgeSeconds int64
+		MaxRows       int64
+		KeepRuns      int64
+	}
+)
+
+func GetBashLogRetentionPolicy(cfg retention.Config) BashLogRetentionPolicy {
+	return BashLogRetentionPolicy{
+		MaxAgeSeconds: int64(cfg.MaxAgeSeconds / time.Second),
+		MaxRows:       cfg.MaxRows,
+		KeepRuns:      cfg.KeepRuns,
+	}
+}
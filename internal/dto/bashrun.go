@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type CreateBashRun struct {
+	Id        uuid.UUID  `json:"id"        swaggertype:"primitive,string" example:"7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"`
+	BashId    *uuid.UUID `json:"bashId"    swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+	RequestId *string    `json:"requestId"                                example:"4c1f7a52-8a0e-4c55-b7a6-3a2f1d9e6b10"`
+	ExpiresAt *time.Time `json:"expiresAt"                                example:"2024-04-15T15:50:21.907561+00:00"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type CreateIdempotencyKey struct {
+	PrincipalId string    `json:"principalId"`
+	Key         string    `json:"key"         example:"deploy-2024-04-14-1"`
+	Fingerprint string    `json:"fingerprint"`
+	ExpiresAt   time.Time `json:"expiresAt"   example:"2024-04-15T15:50:21.907561+00:00"`
+}
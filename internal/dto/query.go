@@ -0,0 +1,34 @@
+package dto
+
+import "pg-sh-scripts/pkg/sql/query"
+
+var (
+	rangeOperators  = []query.Operator{query.OperatorEq, query.OperatorGt, query.OperatorGte, query.OperatorLt, query.OperatorLte}
+	stringOperators = []query.Operator{query.OperatorEq, query.OperatorNe, query.OperatorLike}
+
+	// BashListSchema whitelists the sort and filter params of the list of bash scripts.
+	BashListSchema = query.Schema{
+		Fields: map[string]query.Field{
+			"title":     {Column: "title", Type: query.TypeString, Operators: stringOperators, Sortable: true},
+			"createdAt": {Column: "created_at", Type: query.TypeTime, Operators: rangeOperators, Sortable: true},
+		},
+		DefaultSort: []query.Order{{Column: "created_at"}},
+		Tiebreaker:  "id",
+	}
+
+	// BashLogListSchema whitelists the sort and filter params of the list of bash logs.
+	BashLogListSchema = query.Schema{
+		Fields: map[string]query.Field{
+			"isError":   {Column: "is_error", Type: query.TypeBool, Operators: []query.Operator{query.OperatorEq}, Sortable: true},
+			"createdAt": {Column: "created_at", Type: query.TypeTime, Operators: rangeOperators, Sortable: true},
+			// from and to are the inclusive and exclusive bounds of the creation time.
+			"from": {Column: "created_at", Type: query.TypeTime, Operators: []query.Operator{query.OperatorGte}, DefaultOperator: query.OperatorGte},
+			"to":   {Column: "created_at", Type: query.TypeTime, Operators: []query.Operator{query.OperatorLt}, DefaultOperator: query.OperatorLt},
+			// text and regex search the body of the log by the substring ignoring case and by the regular expression.
+			"text":  {Column: "body", Type: query.TypeString, Operators: []query.Operator{query.OperatorLike}, DefaultOperator: query.OperatorLike},
+			"regex": {Column: "body", Type: query.TypeString, Operators: []query.Operator{query.OperatorMatch}, DefaultOperator: query.OperatorMatch},
+		},
+		DefaultSort: []query.Order{{Column: "created_at"}},
+		Tiebreaker:  "id",
+	}
+)
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"encoding/json"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type (
+	CreateWebhook struct {
+		BashId *uuid.UUID `json:"bashId" swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+		Url    string     `json:"url"                                   example:"https://example.com/hooks/scripts"`
+		Secret string     `json:"secret"`
+		Events []string   `json:"events"                                example:"run.failure,run.timeout"`
+	}
+
+	CreateWebhookDelivery struct {
+		WebhookId  uuid.UUID       `json:"webhookId"  swaggertype:"primitive,string" example:"0c7d3c4c-0b4d-4c4b-9a4b-2f1a0f7a9d61"`
+		Event      string          `json:"event"`
+		Payload    json.RawMessage `json:"payload"    swaggertype:"object"`
+		Attempt    int             `json:"attempt"`
+		StatusCode int             `json:"statusCode"`
+		Error      string          `json:"error"`
+		IsSuccess  bool            `json:"isSuccess"`
+	}
+)
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const Namespace = "pg_sh_scripts"
+
+type Metrics struct {
+	registry            *prometheus.Registry
+	httpRequests        *prometheus.CounterVec
+	httpRequestDuration *prometheus.HistogramVec
+	bashRuns            *prometheus.CounterVec
+	bashRunDuration     *prometheus.HistogramVec
+	bashRunsRunning     prometheus.Gauge
+	bashLogLines        *prometheus.CounterVec
+}
+
+var (
+	metricsInstance *Metrics
+	metricsOnce     sync.Once
+)
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of handled http requests by route and status code.",
+		}, []string{"method", "route", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of handled http requests by route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		bashRuns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "bash_runs_total",
+			Help:      "Number of finished bash script runs by status.",
+		}, []string{"status"}),
+		bashRunDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "bash_run_duration_seconds",
+			Help:      "Duration of finished bash script runs by status.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600},
+		}, []string{"status"}),
+		bashRunsRunning: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "bash_runs_running",
+			Help:      "Number of currently running bash scripts.",
+		}),
+		bashLogLines: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "bash_log_lines_total",
+			Help:      "Number of written bash log lines.",
+		}, []string{"is_error"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.bashRuns,
+		m.bashRunDuration,
+		m.bashRunsRunning,
+		m.bashLogLines,
+	)
+
+	return m
+}
+
+// Register adds an extra collector, e.g. the stats of the postgres pool.
+func (m *Metrics) Register(collector prometheus.Collector) error {
+	return m.registry.Register(collector)
+}
+
+// Handler returns the http handler exposing the metrics in the prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) ObserveHTTPRequest(method string, route string, status int, duration time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpRequestDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+func (m *Metrics) BashRunStarted() {
+	m.bashRunsRunning.Inc()
+}
+
+func (m *Metrics) BashRunFinished(status string, duration time.Duration) {
+	m.bashRunsRunning.Dec()
+	m.bashRuns.WithLabelValues(status).Inc()
+	m.bashRunDuration.WithLabelValues(status).Observe(duration.Seconds())
+}
+
+func (m *Metrics) BashLogLineWritten(isError bool) {
+	m.bashLogLines.WithLabelValues(strconv.FormatBool(isError)).Inc()
+}
+
+func GetMetrics() *Metrics {
+	metricsOnce.Do(func() {
+		metricsInstance = NewMetrics()
+	})
+
+	return metricsInstance
+}
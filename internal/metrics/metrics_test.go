@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics_Handler(t *testing.T) {
+	m := NewMetrics()
+
+	m.ObserveHTTPRequest(http.MethodPost, "/api/v1/bash/execute/list", http.StatusOK, 120*time.Millisecond)
+	m.ObserveHTTPRequest(http.MethodPost, "/api/v1/bash/execute/list", http.StatusForbidden, 5*time.Millisecond)
+	m.BashRunStarted()
+	m.BashRunStarted()
+	m.BashRunFinished("success", 2*time.Second)
+	m.BashLogLineWritten(false)
+	m.BashLogLineWritten(false)
+	m.BashLogLineWritten(true)
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/plain")
+	for _, line := range []string{
+		`pg_sh_scripts_http_requests_total{method="POST",route="/api/v1/bash/execute/list",status="200"} 1`,
+		`pg_sh_scripts_http_requests_total{method="POST",route="/api/v1/bash/execute/list",status="403"} 1`,
+		`pg_sh_scripts_http_request_duration_seconds_count{method="POST",route="/api/v1/bash/execute/list"} 2`,
+		`pg_sh_scripts_bash_runs_total{status="success"} 1`,
+		`pg_sh_scripts_bash_run_duration_seconds_sum{status="success"} 2`,
+		`pg_sh_scripts_bash_runs_running 1`,
+		`pg_sh_scripts_bash_log_lines_total{is_error="false"} 2`,
+		`pg_sh_scripts_bash_log_lines_total{is_error="true"} 1`,
+	} {
+		assert.Contains(t, string(body), line)
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type ApiKey struct {
+	Id         uuid.UUID  `json:"id"         swaggertype:"primitive,string" example:"3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"`
+	Name       string     `json:"name"                                      example:"deploy pipeline"`
+	Prefix     string     `json:"prefix"                                    example:"0a1b2c3d"`
+	Salt       string     `json:"-"`
+	Hash       string     `json:"-"`
+	Role       string     `json:"role"                                      example:"operator"`
+	CreatedAt  time.Time  `json:"createdAt"                                 example:"2024-04-14T15:50:21.907561+00:00"`
+	ExpiresAt  *time.Time `json:"expiresAt"                                 example:"2025-04-14T15:50:21.907561+00:00"`
+	RevokedAt  *time.Time `json:"revokedAt"                                 example:"2024-05-14T15:50:21.907561+00:00"`
+	LastUsedAt *time.Time `json:"lastUsedAt"                                example:"2024-04-15T15:50:21.907561+00:00"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	AuditActionBashCreate             = "bash.create"
+	AuditActionBashDelete             = "bash.delete"
+	AuditActionBashExecute            = "bash.execute"
+	AuditActionBashExecuteInline      = "bash.execute_inline"
+	AuditActionBashAclCreate          = "bash_acl.create"
+	AuditActionBashAclDelete          = "bash_acl.delete"
+	AuditActionBashLogRetentionSet    = "bash_log_retention.set"
+	AuditActionBashLogRetentionDelete = "bash_log_retention.delete"
+	AuditActionBashLogPrune           = "bash_log.prune"
+	AuditActionApiKeyCreate           = "api_key.create"
+	AuditActionApiKeyRevoke           = "api_key.revoke"
+	AuditActionApiKeyExpire           = "api_key.expire"
+	AuditActionWebhookCreate          = "webhook.create"
+	AuditActionWebhookDelete          = "webhook.delete"
+	// AuditActionAccessDenied is recorded for the rejected requests that are not recorded by their own action.
+	AuditActionAccessDenied = "access.denied"
+)
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+type AuditEvent struct {
+	Id           uuid.UUID `json:"id"           swaggertype:"primitive,string" example:"9a7c1e2b-4d5f-4a6b-8c9d-0e1f2a3b4c5d"`
+	Action       string    `json:"action"                                      example:"bash.execute"`
+	Actor        string    `json:"actor"                                       example:"deploy pipeline"`
+	ActorSubject *string   `json:"actorSubject"                                example:"3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"`
+	ClientIp     string    `json:"clientIp"                                    example:"10.0.0.12"`
+	RequestId    *string   `json:"requestId"                                   example:"4c1f7a52-8a0e-4c55-b7a6-3a2f1d9e6b10"`
+	Method       string    `json:"method"                                      example:"POST"`
+	Path         string    `json:"path"                                        example:"/api/v1/bash/execute/list"`
+	TargetIds    []string  `json:"targetIds"                                   example:"59628b82-356c-4745-bc81-187015cde387"`
+	Outcome      string    `json:"outcome"                                     example:"success"`
+	StatusCode   int       `json:"statusCode"                                  example:"200"`
+	CreatedAt    time.Time `json:"createdAt"                                   example:"2024-04-14T15:50:21.907561+00:00"`
+}
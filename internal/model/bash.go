@@ -12,3 +12,7 @@ type Bash struct {
 	Body      string    `json:"body"`
 	CreatedAt time.Time `json:"createdAt"                                example:"2024-04-14T15:50:21.907561+00:00"`
 }
+
+func (b *Bash) GetKeysetKey() (time.Time, string) {
+	return b.CreatedAt, b.Id.String()
+}
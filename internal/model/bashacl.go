@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// BashAcl is the access control list entry of the bash script, when the script has entries
+// only the listed roles and subjects are allowed to execute it.
+type BashAcl struct {
+	Id        uuid.UUID `json:"id"        swaggertype:"primitive,string" example:"5b1f2e7a-3c4d-4e8f-9a0b-1c2d3e4f5a6b"`
+	BashId    uuid.UUID `json:"bashId"    swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+	Role      *string   `json:"role"                                     example:"operator"`
+	Subject   *string   `json:"subject"                                  example:"3f0c2b8e-6a8e-4d5c-9b6f-2a7d1e4c5b90"`
+	CreatedAt time.Time `json:"createdAt"                                example:"2024-04-14T15:50:21.907561+00:00"`
+}
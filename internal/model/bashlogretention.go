@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// BashLogRetention is the log retention of the bash script, the null limits are taken from the global retention
+// and the zero ones keep the logs without limit. The keep runs limit does not apply to the logs without a run.
+type BashLogRetention struct {
+	BashId        uuid.UUID `json:"bashId"        swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+	MaxAgeSeconds *int64    `json:"maxAgeSeconds"                                example:"604800"`
+	MaxRows       *int64    `json:"maxRows"                                      example:"100000"`
+	KeepRuns      *int64    `json:"keepRuns"                                     example:"10"`
+	UpdatedAt     time.Time `json:"updatedAt"                                    example:"2024-04-14T15:50:21.907561+00:00"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// BashRunStats is the aggregation of bash runs, the success rate is the share of successful runs
+// among the finished ones and the durations are in seconds, they are null without finished runs.
+type BashRunStats struct {
+	Total         int64      `json:"total"         example:"120"`
+	Success       int64      `json:"success"       example:"110"`
+	Failure       int64      `json:"failure"       example:"7"`
+	Timeout       int64      `json:"timeout"       example:"2"`
+	Interrupted   int64      `json:"interrupted"   example:"0"`
+	Running       int64      `json:"running"       example:"1"`
+	SuccessRate   *float64   `json:"successRate"   example:"0.9244"`
+	DurationP50   *float64   `json:"durationP50"   example:"1.52"`
+	DurationP95   *float64   `json:"durationP95"   example:"4.8"`
+	DurationMax   *float64   `json:"durationMax"   example:"12.31"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt" example:"2024-04-14T15:50:22.907561+00:00"`
+	LastFailureAt *time.Time `json:"lastFailureAt" example:"2024-04-13T08:10:02.101561+00:00"`
+}
+
+// BashRunWindowStats is the aggregation of bash runs started since the beginning of the window.
+type BashRunWindowStats struct {
+	Window string    `json:"window" example:"7d"`
+	Since  time.Time `json:"since"  example:"2024-04-07T15:50:21.907561+00:00"`
+	BashRunStats
+}
+
+// BashRunScriptStats is the aggregation of bash runs of the script.
+type BashRunScriptStats struct {
+	BashId uuid.UUID `json:"bashId" swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+	Title  string    `json:"title"  example:"backup"`
+	BashRunStats
+}
+
+// BashRunStatsRollup is the total stats of the window kept by the last rollup.
+type BashRunStatsRollup struct {
+	BashRunWindowStats
+	RolledUpAt time.Time `json:"rolledUpAt" example:"2024-04-14T15:45:00.000000+00:00"`
+}
@@ -0,0 +1,6 @@
+package model
+
+type MigrationStatus struct {
+	CurrentVersion int64
+	LatestVersion  int64
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type IdempotencyKey struct {
+	PrincipalId string          `json:"principalId"`
+	Key         string          `json:"key"         example:"deploy-2024-04-14-1"`
+	Fingerprint string          `json:"fingerprint"`
+	Response    json.RawMessage `json:"response"    swaggertype:"object"`
+	CreatedAt   time.Time       `json:"createdAt"   example:"2024-04-14T15:50:21.907561+00:00"`
+	ExpiresAt   time.Time       `json:"expiresAt"   example:"2024-04-15T15:50:21.907561+00:00"`
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"slices"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	RoleViewer   = "viewer"
+	RoleOperator = "operator"
+	RoleAuthor   = "author"
+	RoleAdmin    = "admin"
+)
+
+// Roles are ordered from the least to the most privileged,
+// every role has all permissions of the previous one.
+var Roles = []string{
+	RoleViewer,
+	RoleOperator,
+	RoleAuthor,
+	RoleAdmin,
+}
+
+type Permission string
+
+const (
+	PermissionBashRead      Permission = "bash:read"
+	PermissionBashExecute   Permission = "bash:execute"
+	PermissionBashWrite     Permission = "bash:write"
+	PermissionBashAclManage Permission = "bash-acl:manage"
+	PermissionBashLogRead   Permission = "bash-log:read"
+	PermissionBashLogManage Permission = "bash-log:manage"
+	PermissionWebhookRead   Permission = "webhook:read"
+	PermissionWebhookWrite  Permission = "webhook:write"
+	PermissionApiKeyManage  Permission = "api-key:manage"
+	PermissionAuditRead     Permission = "audit:read"
+	// PermissionBashExecuteInline allows executing the scripts which are not saved,
+	// it is granted to admins only since the inline scripts are not covered by the access lists of the scripts.
+	PermissionBashExecuteInline Permission = "bash:execute-inline"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleViewer: {
+		PermissionBashRead,
+		PermissionBashLogRead,
+		PermissionWebhookRead,
+	},
+	RoleOperator: {
+		PermissionBashExecute,
+	},
+	RoleAuthor: {
+		PermissionBashWrite,
+		PermissionWebhookWrite,
+	},
+	RoleAdmin: {
+		PermissionBashAclManage,
+		PermissionBashLogManage,
+		PermissionApiKeyManage,
+		PermissionAuditRead,
+		PermissionBashExecuteInline,
+	},
+}
+
+// Principal is the authenticated caller of the request.
+type Principal struct {
+	// Subject is the id of the api key or the subject of the token.
+	Subject string
+	Name    string
+	Roles   []string
+}
+
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// HasRoleAtLeast reports whether any role of the principal is the role or a more privileged one.
+func (p *Principal) HasRoleAtLeast(role string) bool {
+	roleIndex := slices.Index(Roles, role)
+	return roleIndex >= 0 && p.getMaxRoleIndex() >= roleIndex
+}
+
+// Can reports whether any role of the principal, or a less privileged one, grants the permission.
+func (p *Principal) Can(permission Permission) bool {
+	for _, role := range Roles[:p.getMaxRoleIndex()+1] {
+		if slices.Contains(rolePermissions[role], permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// getMaxRoleIndex returns the index in Roles of the most privileged role of the principal,
+// or -1 if the principal has no known role.
+func (p *Principal) getMaxRoleIndex() int {
+	maxRoleIndex := -1
+	for _, role := range p.Roles {
+		maxRoleIndex = max(maxRoleIndex, slices.Index(Roles, role))
+	}
+	return maxRoleIndex
+}
+
+func GetApiKeyPrincipal(apiKey *ApiKey) *Principal {
+	subject := ""
+	if apiKey.Id != uuid.Nil {
+		subject = apiKey.Id.String()
+	}
+	return &Principal{
+		Subject: subject,
+		Name:    apiKey.Name,
+		Roles:   []string{apiKey.Role},
+	}
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	WebhookEventRunStart   = "run.start"
+	WebhookEventRunSuccess = "run.success"
+	WebhookEventRunFailure = "run.failure"
+	WebhookEventRunTimeout = "run.timeout"
+)
+
+var WebhookEvents = []string{
+	WebhookEventRunStart,
+	WebhookEventRunSuccess,
+	WebhookEventRunFailure,
+	WebhookEventRunTimeout,
+}
+
+type (
+	Webhook struct {
+		Id        uuid.UUID  `json:"id"        swaggertype:"primitive,string" example:"0c7d3c4c-0b4d-4c4b-9a4b-2f1a0f7a9d61"`
+		BashId    *uuid.UUID `json:"bashId"    swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+		Url       string     `json:"url"                                      example:"https://example.com/hooks/scripts"`
+		Secret    string     `json:"-"`
+		Events    []string   `json:"events"                                   example:"run.failure,run.timeout"`
+		CreatedAt time.Time  `json:"createdAt"                                example:"2024-04-14T15:50:21.907561+00:00"`
+	}
+
+	WebhookDelivery struct {
+		Id         uuid.UUID       `json:"id"         swaggertype:"primitive,string" example:"b5e0a0d4-6f0e-4a43-8d3c-2f0c2e6f9b1e"`
+		WebhookId  uuid.UUID       `json:"webhookId"  swaggertype:"primitive,string" example:"0c7d3c4c-0b4d-4c4b-9a4b-2f1a0f7a9d61"`
+		Event      string          `json:"event"                                     example:"run.failure"`
+		Payload    json.RawMessage `json:"payload"    swaggertype:"object"`
+		Attempt    int             `json:"attempt"`
+		StatusCode int             `json:"statusCode"`
+		Error      string          `json:"error"`
+		IsSuccess  bool            `json:"isSuccess"`
+		CreatedAt  time.Time       `json:"createdAt"                                 example:"2024-04-14T15:50:21.907561+00:00"`
+	}
+)
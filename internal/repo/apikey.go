@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IApiKeyRepository interface {
+	GetOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+	GetOneByPrefix(ctx context.Context, prefix string) (*model.ApiKey, error)
+	GetPaginationPage(
+		ctx context.Context,
+		paginationParams pagination.LimitOffsetParams,
+	) (alias.ApiKeyLimitOffsetPage, error)
+	Create(ctx context.Context, dto dto.SaveApiKey) (*model.ApiKey, error)
+	RevokeById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+	ExpireById(ctx context.Context, id uuid.UUID, dto dto.ExpireApiKey) (*model.ApiKey, error)
+	UpdateLastUsedAtById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+}
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+)
+
+type IAuditRepository interface {
+	GetPaginationPage(
+		ctx context.Context,
+		filter dto.AuditEventFilter,
+		paginationParams pagination.LimitOffsetParams,
+	) (alias.AuditEventLimitOffsetPage, error)
+	Export(ctx context.Context, filter dto.AuditEventFilter, fn func(auditEvent *model.AuditEvent) error) error
+	Create(ctx context.Context, dto dto.CreateAuditEvent) (*model.AuditEvent, error)
+}
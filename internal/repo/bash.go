@@ -14,8 +14,14 @@ type IBashRepository interface {
 	GetOneById(ctx context.Context, id uuid.UUID) (*model.Bash, error)
 	GetPaginationPage(
 		ctx context.Context,
+		filter dto.BashFilter,
 		paginationParams pagination.LimitOffsetParams,
 	) (alias.BashLimitOffsetPage, error)
+	GetKeysetPage(
+		ctx context.Context,
+		filter dto.BashFilter,
+		paginationParams pagination.KeysetParams,
+	) (alias.BashKeysetPage, error)
 	Create(ctx context.Context, dto dto.CreateBash) (*model.Bash, error)
 	RemoveById(ctx context.Context, id uuid.UUID) (*model.Bash, error)
 }
@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IBashAclRepository interface {
+	GetOneById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error)
+	GetListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error)
+	Create(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error)
+	RemoveById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error)
+}
@@ -14,7 +14,30 @@ type IBashLogRepository interface {
 	GetPaginationPageByBashId(
 		ctx context.Context,
 		bashId uuid.UUID,
+		filter dto.BashLogFilter,
 		paginationParams pagination.LimitOffsetParams,
 	) (alias.BashLogLimitOffsetPage, error)
+	GetPaginationPageByRunId(
+		ctx context.Context,
+		runId uuid.UUID,
+		paginationParams pagination.LimitOffsetParams,
+	) (alias.BashLogLimitOffsetPage, error)
+	GetKeysetPageByBashId(
+		ctx context.Context,
+		bashId uuid.UUID,
+		filter dto.BashLogFilter,
+		paginationParams pagination.KeysetParams,
+	) (alias.BashLogKeysetPage, error)
+	GetKeysetPageByRunId(
+		ctx context.Context,
+		runId uuid.UUID,
+		paginationParams pagination.KeysetParams,
+	) (alias.BashLogKeysetPage, error)
+	ExportByBashId(
+		ctx context.Context,
+		bashId uuid.UUID,
+		filter dto.BashLogFilter,
+		fn func(bashLog *model.BashLog) error,
+	) error
 	Create(ctx context.Context, dto dto.CreateBashLog) (*model.BashLog, error)
 }
@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/pkg/sql/partition"
+)
+
+type IBashLogPartitionRepository interface {
+	GetNameList(ctx context.Context) ([]string, error)
+	Create(ctx context.Context, monthRange partition.MonthRange) error
+	Remove(ctx context.Context, name string, detach bool) error
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IBashLogRetentionRepository interface {
+	GetOneByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error)
+	Set(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error)
+	RemoveByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error)
+	CountPrunable(ctx context.Context, policy dto.BashLogRetentionPolicy) (int64, error)
+	Prune(ctx context.Context, policy dto.BashLogRetentionPolicy, batchSize int) (int64, error)
+}
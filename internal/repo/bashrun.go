@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IBashRunRepository interface {
+	GetOneById(ctx context.Context, id uuid.UUID) (*model.BashRun, error)
+	GetPaginationPageByBashId(
+		ctx context.Context,
+		bashId uuid.UUID,
+		paginationParams pagination.LimitOffsetParams,
+	) (alias.BashRunLimitOffsetPage, error)
+	Create(ctx context.Context, dto dto.CreateBashRun) (*model.BashRun, error)
+	FinishById(ctx context.Context, id uuid.UUID, status string, redactionCount int64) (*model.BashRun, error)
+	RemoveExpired(ctx context.Context) (int64, error)
+}
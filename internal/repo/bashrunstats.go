@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/stats"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IBashRunStatsRepository interface {
+	GetWindowListByBashId(ctx context.Context, bashId uuid.UUID, windows []stats.Window) ([]*model.BashRunWindowStats, error)
+	GetTotal(ctx context.Context, window stats.Window) (*model.BashRunWindowStats, error)
+	GetScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error)
+	GetRolledUpTotal(ctx context.Context, window stats.Window) (*model.BashRunStatsRollup, error)
+	GetRolledUpScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error)
+	Rollup(ctx context.Context, windows []stats.Window) (int64, error)
+}
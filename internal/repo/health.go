@@ -0,0 +1,11 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/model"
+)
+
+type IHealthRepository interface {
+	Ping(ctx context.Context) error
+	GetMigrationStatus(ctx context.Context) (*model.MigrationStatus, error)
+}
@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+)
+
+type IIdempotencyKeyRepository interface {
+	GetOneByKey(ctx context.Context, principalId string, key string) (*model.IdempotencyKey, error)
+	Create(ctx context.Context, dto dto.CreateIdempotencyKey) (*model.IdempotencyKey, error)
+	SetResponseByKey(
+		ctx context.Context,
+		principalId string,
+		key string,
+		response json.RawMessage,
+	) (*model.IdempotencyKey, error)
+	RemoveByKey(ctx context.Context, principalId string, key string) (*model.IdempotencyKey, error)
+	RemoveExpired(ctx context.Context) (int64, error)
+}
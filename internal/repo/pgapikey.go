@@ -0,0 +1,317 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PgApiKeyRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgApiKeyRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	apiKey := &model.ApiKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting api key by id: %v", id))
+	q := `
+		SELECT
+			id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+		FROM
+		    scripts.api_key
+		WHERE
+			id = $1
+	`
+
+	if err := pgxscan.Get(ctx, p.db, apiKey, q, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting api key by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting api key by id: %v Error: %s", id, err))
+		}
+		return apiKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting api key by id: %v", id))
+
+	return apiKey, nil
+}
+
+func (p PgApiKeyRepository) GetOneByPrefix(ctx context.Context, prefix string) (*model.ApiKey, error) {
+	apiKey := &model.ApiKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting api key by prefix: %s", prefix))
+	q := `
+		SELECT
+			id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+		FROM
+		    scripts.api_key
+		WHERE
+			prefix = $1
+	`
+
+	if err := pgxscan.Get(ctx, p.db, apiKey, q, prefix); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting api key by prefix: %s Error: %s, Detail: %s, Where: %s",
+					prefix,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting api key by prefix: %s Error: %s", prefix, err))
+		}
+		return apiKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting api key by prefix: %s", prefix))
+
+	return apiKey, nil
+}
+
+func (p PgApiKeyRepository) GetPaginationPage(
+	ctx context.Context,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.ApiKeyLimitOffsetPage, error) {
+	var apiKeyPaginationPage alias.ApiKeyLimitOffsetPage
+
+	p.logger.DebugContext(ctx, "Start getting api key pagination page")
+	q := `
+		SELECT
+			id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+		FROM
+		    scripts.api_key
+		ORDER BY created_at DESC
+	`
+
+	apiKeyPaginationPage, err := pagination.Paginate[*model.ApiKey](
+		ctx,
+		p.db,
+		q,
+		paginationParams,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting api key pagination page Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting api key pagination page Error: %s", err))
+		}
+		return apiKeyPaginationPage, err
+	}
+	p.logger.DebugContext(ctx, "Finish getting api key pagination page")
+
+	return apiKeyPaginationPage, nil
+}
+
+func (p PgApiKeyRepository) Create(
+	ctx context.Context,
+	dto dto.SaveApiKey,
+) (*model.ApiKey, error) {
+	apiKey := &model.ApiKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating api key with prefix: %s", dto.Prefix))
+	stmt := `
+		INSERT INTO scripts.api_key
+			(name, prefix, salt, hash, role, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+	`
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		apiKey,
+		stmt,
+		dto.Name,
+		dto.Prefix,
+		dto.Salt,
+		dto.Hash,
+		dto.Role,
+		dto.ExpiresAt,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating api key with prefix: %s Error: %s, Detail: %s, Where: %s",
+					dto.Prefix,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating api key with prefix: %s Error: %s", dto.Prefix, err))
+		}
+		return apiKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating api key with prefix: %s", dto.Prefix))
+
+	return apiKey, nil
+}
+
+func (p PgApiKeyRepository) RevokeById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	apiKey := &model.ApiKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start revoking api key by id: %v", id))
+	stmt := `
+		UPDATE
+		    scripts.api_key
+		SET
+			revoked_at = COALESCE(revoked_at, now())
+		WHERE
+			id = $1
+		RETURNING id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, apiKey, stmt, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Revoking api key by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Revoking api key by id: %v Error: %s", id, err))
+		}
+		return apiKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish revoking api key by id: %v", id))
+
+	return apiKey, nil
+}
+
+func (p PgApiKeyRepository) ExpireById(
+	ctx context.Context,
+	id uuid.UUID,
+	dto dto.ExpireApiKey,
+) (*model.ApiKey, error) {
+	apiKey := &model.ApiKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start expiring api key by id: %v", id))
+	stmt := `
+		UPDATE
+		    scripts.api_key
+		SET
+			expires_at = COALESCE($2, now())
+		WHERE
+			id = $1
+		RETURNING id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, apiKey, stmt, id, dto.ExpiresAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Expiring api key by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Expiring api key by id: %v Error: %s", id, err))
+		}
+		return apiKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish expiring api key by id: %v", id))
+
+	return apiKey, nil
+}
+
+func (p PgApiKeyRepository) UpdateLastUsedAtById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	apiKey := &model.ApiKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start updating api key last used at by id: %v", id))
+	stmt := `
+		UPDATE
+		    scripts.api_key
+		SET
+			last_used_at = now()
+		WHERE
+			id = $1
+		RETURNING id, name, prefix, salt, hash, role, created_at, expires_at, revoked_at, last_used_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, apiKey, stmt, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Updating api key last used at by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Updating api key last used at by id: %v Error: %s", id, err))
+		}
+		return apiKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish updating api key last used at by id: %v", id))
+
+	return apiKey, nil
+}
+
+func GetPgApiKeyRepository() IApiKeyRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgApiKeyRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
@@ -0,0 +1,204 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/sql/stream"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PgAuditRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+const auditEventFilterQuery = `
+	SELECT
+		id, action, actor, actor_subject, client_ip, request_id, method, path,
+		target_ids, outcome, status_code, created_at
+	FROM
+	    scripts.audit_event
+	WHERE
+	    ($1::varchar IS NULL OR action = $1)
+	    AND ($2::varchar IS NULL OR actor = $2 OR actor_subject = $2)
+	    AND ($3::varchar IS NULL OR outcome = $3)
+	    AND ($4::varchar IS NULL OR target_ids @> ARRAY[$4]::varchar[])
+	    AND ($5::timestamptz IS NULL OR created_at >= $5)
+	    AND ($6::timestamptz IS NULL OR created_at < $6)
+`
+
+func (p PgAuditRepository) GetPaginationPage(
+	ctx context.Context,
+	filter dto.AuditEventFilter,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.AuditEventLimitOffsetPage, error) {
+	var auditEventPaginationPage alias.AuditEventLimitOffsetPage
+
+	p.logger.DebugContext(ctx, "Start getting audit event pagination page")
+	q := auditEventFilterQuery + `
+	ORDER BY created_at DESC
+	`
+
+	auditEventPaginationPage, err := pagination.Paginate[*model.AuditEvent](
+		ctx,
+		p.db,
+		q,
+		paginationParams,
+		filter.Action,
+		filter.Actor,
+		filter.Outcome,
+		filter.TargetId,
+		filter.From,
+		filter.To,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting audit event pagination page Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting audit event pagination page Error: %s", err))
+		}
+		return auditEventPaginationPage, err
+	}
+	p.logger.DebugContext(ctx, "Finish getting audit event pagination page")
+
+	return auditEventPaginationPage, nil
+}
+
+// Export passes the filtered audit events to fn one by one in chronological order
+// without loading the whole result set into memory.
+func (p PgAuditRepository) Export(
+	ctx context.Context,
+	filter dto.AuditEventFilter,
+	fn func(auditEvent *model.AuditEvent) error,
+) error {
+	p.logger.DebugContext(ctx, "Start exporting audit events")
+	q := auditEventFilterQuery + `
+	ORDER BY created_at, id
+	`
+
+	if err := stream.Rows(
+		ctx,
+		p.db,
+		fn,
+		q,
+		filter.Action,
+		filter.Actor,
+		filter.Outcome,
+		filter.TargetId,
+		filter.From,
+		filter.To,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Exporting audit events Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Exporting audit events Error: %s", err))
+		}
+		return err
+	}
+	p.logger.DebugContext(ctx, "Finish exporting audit events")
+
+	return nil
+}
+
+func (p PgAuditRepository) Create(
+	ctx context.Context,
+	dto dto.CreateAuditEvent,
+) (*model.AuditEvent, error) {
+	auditEvent := &model.AuditEvent{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating audit event by action: %s", dto.Action))
+	stmt := `
+		INSERT INTO scripts.audit_event
+			(action, actor, actor_subject, client_ip, request_id, method, path, target_ids, outcome, status_code)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING
+			id, action, actor, actor_subject, client_ip, request_id, method, path,
+			target_ids, outcome, status_code, created_at
+	`
+
+	targetIds := dto.TargetIds
+	if targetIds == nil {
+		targetIds = []string{}
+	}
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		auditEvent,
+		stmt,
+		dto.Action,
+		dto.Actor,
+		dto.ActorSubject,
+		dto.ClientIp,
+		dto.RequestId,
+		dto.Method,
+		dto.Path,
+		targetIds,
+		dto.Outcome,
+		dto.StatusCode,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating audit event by action: %s Error: %s, Detail: %s, Where: %s",
+					dto.Action,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating audit event by action: %s Error: %s", dto.Action, err))
+		}
+		return auditEvent, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating audit event by action: %s", dto.Action))
+
+	return auditEvent, nil
+}
+
+func GetPgAuditRepository() IAuditRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgAuditRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
@@ -25,9 +25,12 @@ type PgBashRepository struct {
 }
 
 func (p PgBashRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "PgBashRepository.GetOneById")
+	defer span.End()
+
 	bash := &model.Bash{}
 
-	p.logger.Debug(fmt.Sprintf("Start getting bash by id: %v", id))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash by id: %v", id))
 	q := `
 		SELECT
 			id, title, body, created_at
@@ -40,7 +43,8 @@ func (p PgBashRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.
 	if err := pgxscan.Get(ctx, p.db, bash, q, id); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			p.logger.Error(
+			p.logger.ErrorContext(
+				ctx,
 				fmt.Sprintf(
 					"Getting bash by id: %v Error: %s, Detail: %s, Where: %s",
 					id,
@@ -50,34 +54,49 @@ func (p PgBashRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.
 				),
 			)
 		} else {
-			p.logger.Error(fmt.Sprintf("Getting bash by id: %v Error: %s", id, err))
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash by id: %v Error: %s", id, err))
 		}
 		return bash, err
 	}
-	p.logger.Debug(fmt.Sprintf("Finish getting bash by id: %v", id))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash by id: %v", id))
 
 	return bash, nil
 }
 
 func (p PgBashRepository) GetPaginationPage(
 	ctx context.Context,
+	filter dto.BashFilter,
 	paginationParams pagination.LimitOffsetParams,
 ) (alias.BashLimitOffsetPage, error) {
+	ctx, span := tracer.Start(ctx, "PgBashRepository.GetPaginationPage")
+	defer span.End()
+
 	var bashPaginationPage alias.BashLimitOffsetPage
 
-	p.logger.Debug("Start getting bash pagination page")
-	q := `
+	p.logger.DebugContext(ctx, "Start getting bash pagination page")
+	where, whereArgs := filter.Query.Where(1)
+	q := fmt.Sprintf(`
 		SELECT
 			id, title, body, created_at
 		FROM
 		    scripts.bash
-	`
-
-	bashPaginationPage, err := pagination.Paginate[*model.Bash](ctx, p.db, q, paginationParams)
+		WHERE
+		    %s
+	`, where)
+
+	bashPaginationPage, err := pagination.PaginateOrdered[*model.Bash](
+		ctx,
+		p.db,
+		q,
+		filter.Query.OrderBy(),
+		paginationParams,
+		whereArgs...,
+	)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			p.logger.Error(
+			p.logger.ErrorContext(
+				ctx,
 				fmt.Sprintf(
 					"Getting bash pagination page Error: %s, Detail: %s, Where: %s",
 					pgErr.Message,
@@ -86,19 +105,66 @@ func (p PgBashRepository) GetPaginationPage(
 				),
 			)
 		} else {
-			p.logger.Error(fmt.Sprintf("Getting bash pagination page Error: %s", err))
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash pagination page Error: %s", err))
 		}
 		return bashPaginationPage, err
 	}
-	p.logger.Debug("Finish getting bash pagination page")
+	p.logger.DebugContext(ctx, "Finish getting bash pagination page")
 
 	return bashPaginationPage, nil
 }
 
+func (p PgBashRepository) GetKeysetPage(
+	ctx context.Context,
+	filter dto.BashFilter,
+	paginationParams pagination.KeysetParams,
+) (alias.BashKeysetPage, error) {
+	ctx, span := tracer.Start(ctx, "PgBashRepository.GetKeysetPage")
+	defer span.End()
+
+	var bashKeysetPage alias.BashKeysetPage
+
+	p.logger.DebugContext(ctx, "Start getting bash keyset page")
+	where, whereArgs := filter.Query.Where(1)
+	q := fmt.Sprintf(`
+		SELECT
+			id, title, body, created_at
+		FROM
+		    scripts.bash
+		WHERE
+		    %s
+	`, where)
+
+	bashKeysetPage, err := pagination.PaginateKeyset[*model.Bash](ctx, p.db, q, paginationParams, whereArgs...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash keyset page Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash keyset page Error: %s", err))
+		}
+		return bashKeysetPage, err
+	}
+	p.logger.DebugContext(ctx, "Finish getting bash keyset page")
+
+	return bashKeysetPage, nil
+}
+
 func (p PgBashRepository) Create(ctx context.Context, dto dto.CreateBash) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "PgBashRepository.Create")
+	defer span.End()
+
 	bash := &model.Bash{}
 
-	p.logger.Debug(fmt.Sprintf("Start creating bash with title: %s", dto.Title))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating bash with title: %s", dto.Title))
 	stmt := `
 		INSERT INTO scripts.bash
 			(title, body)
@@ -110,7 +176,8 @@ func (p PgBashRepository) Create(ctx context.Context, dto dto.CreateBash) (*mode
 	if err := pgxscan.Get(ctx, p.db, bash, stmt, dto.Title, dto.Body); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			p.logger.Error(
+			p.logger.ErrorContext(
+				ctx,
 				fmt.Sprintf(
 					"Creating bash with title: %s Error: %s, Detail: %s, Where: %s",
 					dto.Title,
@@ -120,19 +187,22 @@ func (p PgBashRepository) Create(ctx context.Context, dto dto.CreateBash) (*mode
 				),
 			)
 		} else {
-			p.logger.Error(fmt.Sprintf("Creating bash with title: %s Error: %s", dto.Title, err))
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating bash with title: %s Error: %s", dto.Title, err))
 		}
 		return bash, err
 	}
-	p.logger.Debug(fmt.Sprintf("Finish creating bash with title: %s", dto.Title))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating bash with title: %s", dto.Title))
 
 	return bash, nil
 }
 
 func (p PgBashRepository) RemoveById(ctx context.Context, id uuid.UUID) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "PgBashRepository.RemoveById")
+	defer span.End()
+
 	bash := &model.Bash{}
 
-	p.logger.Debug(fmt.Sprintf("Start removing bash by id: %v", id))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start removing bash by id: %v", id))
 	stmt := `
 		DELETE FROM 
 		    scripts.bash
@@ -144,7 +214,8 @@ func (p PgBashRepository) RemoveById(ctx context.Context, id uuid.UUID) (*model.
 	if err := pgxscan.Get(ctx, p.db, bash, stmt, id); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			p.logger.Error(
+			p.logger.ErrorContext(
+				ctx,
 				fmt.Sprintf(
 					"Removing bash by id: %v Error: %s, Detail: %s, Where: %s",
 					id,
@@ -154,11 +225,11 @@ func (p PgBashRepository) RemoveById(ctx context.Context, id uuid.UUID) (*model.
 				),
 			)
 		} else {
-			p.logger.Error(fmt.Sprintf("Removing bash by id: %v Error: %s", id, err))
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing bash by id: %v Error: %s", id, err))
 		}
 		return bash, err
 	}
-	p.logger.Debug(fmt.Sprintf("Finish removing bash by id: %v", id))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing bash by id: %v", id))
 
 	return bash, nil
 }
@@ -0,0 +1,179 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/logging"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PgBashAclRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgBashAclRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error) {
+	bashAcl := &model.BashAcl{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash acl by id: %v", id))
+	q := `
+		SELECT
+			id, bash_id, role, subject, created_at
+		FROM
+		    scripts.bash_acl
+		WHERE
+			id = $1
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashAcl, q, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash acl by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash acl by id: %v Error: %s", id, err))
+		}
+		return bashAcl, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash acl by id: %v", id))
+
+	return bashAcl, nil
+}
+
+func (p PgBashAclRepository) GetListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error) {
+	bashAclList := make([]*model.BashAcl, 0)
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash acl list by bash id: %v", bashId))
+	q := `
+		SELECT
+			id, bash_id, role, subject, created_at
+		FROM
+		    scripts.bash_acl
+		WHERE
+			bash_id = $1
+		ORDER BY created_at
+	`
+
+	if err := pgxscan.Select(ctx, p.db, &bashAclList, q, bashId); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash acl list by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash acl list by bash id: %v Error: %s", bashId, err))
+		}
+		return bashAclList, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash acl list by bash id: %v", bashId))
+
+	return bashAclList, nil
+}
+
+func (p PgBashAclRepository) Create(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error) {
+	bashAcl := &model.BashAcl{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating bash acl for bash id: %v", dto.BashId))
+	stmt := `
+		INSERT INTO scripts.bash_acl
+			(bash_id, role, subject)
+		VALUES
+			($1, $2, $3)
+		RETURNING id, bash_id, role, subject, created_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashAcl, stmt, dto.BashId, dto.Role, dto.Subject); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating bash acl for bash id: %v Error: %s, Detail: %s, Where: %s",
+					dto.BashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating bash acl for bash id: %v Error: %s", dto.BashId, err))
+		}
+		return bashAcl, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating bash acl for bash id: %v", dto.BashId))
+
+	return bashAcl, nil
+}
+
+func (p PgBashAclRepository) RemoveById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error) {
+	bashAcl := &model.BashAcl{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start removing bash acl by id: %v", id))
+	stmt := `
+		DELETE FROM
+		    scripts.bash_acl
+		WHERE
+			id = $1
+		RETURNING id, bash_id, role, subject, created_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashAcl, stmt, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing bash acl by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing bash acl by id: %v Error: %s", id, err))
+		}
+		return bashAcl, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing bash acl by id: %v", id))
+
+	return bashAcl, nil
+}
+
+func GetPgBashAclRepository() IBashAclRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgBashAclRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"pg-sh-scripts/internal/config"
 	"pg-sh-scripts/internal/db"
 	"pg-sh-scripts/internal/dto"
 	"pg-sh-scripts/internal/log"
@@ -11,35 +12,115 @@ import (
 	"pg-sh-scripts/internal/type/alias"
 	"pg-sh-scripts/pkg/logging"
 	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/sql/stream"
+	"strconv"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PgBashLogRepository struct {
-	db     *pgxpool.Pool
-	logger *logging.Logger
+	db                     *pgxpool.Pool
+	logger                 *logging.Logger
+	filterStatementTimeout time.Duration
+}
+
+// withFilterStatementTimeout runs fn in a read only transaction limited by the statement timeout of the filters,
+// so a slow substring or regex filter is canceled by postgres instead of holding the connection.
+func (p PgBashLogRepository) withFilterStatementTimeout(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if p.filterStatementTimeout > 0 {
+		timeout := strconv.FormatInt(p.filterStatementTimeout.Milliseconds(), 10)
+		if _, err = tx.Exec(ctx, "SELECT set_config('statement_timeout', $1, true)", timeout); err != nil {
+			return err
+		}
+	}
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 func (p PgBashLogRepository) GetPaginationPageByBashId(
 	ctx context.Context,
 	bashId uuid.UUID,
+	filter dto.BashLogFilter,
 	paginationParams pagination.LimitOffsetParams,
 ) (alias.BashLogLimitOffsetPage, error) {
 	var bashLogPaginationPage alias.BashLogLimitOffsetPage
 
-	p.logger.Debug(fmt.Sprintf("Start getting bash log pagination page by bash id: %v", bashId))
-	q := `
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash log pagination page by bash id: %v", bashId))
+	where, whereArgs := filter.Query.Where(3)
+	q := fmt.Sprintf(`
 		SELECT
-			id, bash_id, body, is_error, created_at
+			id, bash_id, run_id, body, is_error, created_at
 		FROM
 		    scripts.bash_log
 		WHERE 
-		    bash_id = $1
+		    bash_id = $1 AND ($2::uuid IS NULL OR run_id = $2) AND %s
+	`, where)
+
+	err := p.withFilterStatementTimeout(ctx, func(tx pgx.Tx) error {
+		var err error
+		bashLogPaginationPage, err = pagination.PaginateOrdered[*model.BashLog](
+			ctx,
+			tx,
+			q,
+			filter.Query.OrderBy(),
+			paginationParams,
+			append([]any{bashId, filter.RunId}, whereArgs...)...,
+		)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash log pagination page by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log pagination page by bash id: %v Error: %s", bashId, err))
+		}
+		return bashLogPaginationPage, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash log pagination page by bash id: %v", bashId))
+
+	return bashLogPaginationPage, nil
+}
+
+func (p PgBashLogRepository) GetPaginationPageByRunId(
+	ctx context.Context,
+	runId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.BashLogLimitOffsetPage, error) {
+	var bashLogPaginationPage alias.BashLogLimitOffsetPage
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash log pagination page by run id: %v", runId))
+	q := `
+		SELECT
+			id, bash_id, run_id, body, is_error, created_at
+		FROM
+		    scripts.bash_log
+		WHERE
+		    run_id = $1
 	`
 
 	bashLogPaginationPage, err := pagination.Paginate[*model.BashLog](
@@ -47,49 +128,204 @@ func (p PgBashLogRepository) GetPaginationPageByBashId(
 		p.db,
 		q,
 		paginationParams,
-		bashId,
+		runId,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			p.logger.Error(
+			p.logger.ErrorContext(
+				ctx,
 				fmt.Sprintf(
-					"Getting bash log pagination page by bash id: %v Error: %s, Detail: %s, Where: %s",
-					bashId,
+					"Getting bash log pagination page by run id: %v Error: %s, Detail: %s, Where: %s",
+					runId,
 					pgErr.Message,
 					pgErr.Detail,
 					pgErr.Where,
 				),
 			)
 		} else {
-			p.logger.Error(fmt.Sprintf("Getting bash log pagination page by bash id: %v Error: %s", bashId, err))
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log pagination page by run id: %v Error: %s", runId, err))
 		}
 		return bashLogPaginationPage, err
 	}
-	p.logger.Debug(fmt.Sprintf("Finish getting bash log pagination page by bash id: %v", bashId))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash log pagination page by run id: %v", runId))
 
 	return bashLogPaginationPage, nil
 }
 
+func (p PgBashLogRepository) GetKeysetPageByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	filter dto.BashLogFilter,
+	paginationParams pagination.KeysetParams,
+) (alias.BashLogKeysetPage, error) {
+	var bashLogKeysetPage alias.BashLogKeysetPage
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash log keyset page by bash id: %v", bashId))
+	where, whereArgs := filter.Query.Where(3)
+	q := fmt.Sprintf(`
+		SELECT
+			id, bash_id, run_id, body, is_error, created_at
+		FROM
+		    scripts.bash_log
+		WHERE 
+		    bash_id = $1 AND ($2::uuid IS NULL OR run_id = $2) AND %s
+	`, where)
+
+	err := p.withFilterStatementTimeout(ctx, func(tx pgx.Tx) error {
+		var err error
+		bashLogKeysetPage, err = pagination.PaginateKeyset[*model.BashLog](
+			ctx,
+			tx,
+			q,
+			paginationParams,
+			append([]any{bashId, filter.RunId}, whereArgs...)...,
+		)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash log keyset page by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log keyset page by bash id: %v Error: %s", bashId, err))
+		}
+		return bashLogKeysetPage, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash log keyset page by bash id: %v", bashId))
+
+	return bashLogKeysetPage, nil
+}
+
+func (p PgBashLogRepository) GetKeysetPageByRunId(
+	ctx context.Context,
+	runId uuid.UUID,
+	paginationParams pagination.KeysetParams,
+) (alias.BashLogKeysetPage, error) {
+	var bashLogKeysetPage alias.BashLogKeysetPage
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash log keyset page by run id: %v", runId))
+	q := `
+		SELECT
+			id, bash_id, run_id, body, is_error, created_at
+		FROM
+		    scripts.bash_log
+		WHERE
+		    run_id = $1
+	`
+
+	bashLogKeysetPage, err := pagination.PaginateKeyset[*model.BashLog](
+		ctx,
+		p.db,
+		q,
+		paginationParams,
+		runId,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash log keyset page by run id: %v Error: %s, Detail: %s, Where: %s",
+					runId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log keyset page by run id: %v Error: %s", runId, err))
+		}
+		return bashLogKeysetPage, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash log keyset page by run id: %v", runId))
+
+	return bashLogKeysetPage, nil
+}
+
+// ExportByBashId passes the filtered bash logs to fn one by one in the order of the filter
+// without loading the whole result set into memory, the export is read as slow as the client
+// receives it, so it is not limited by the statement timeout of the filtered pages.
+func (p PgBashLogRepository) ExportByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	filter dto.BashLogFilter,
+	fn func(bashLog *model.BashLog) error,
+) error {
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start exporting bash logs by bash id: %v", bashId))
+	where, whereArgs := filter.Query.Where(3)
+	q := fmt.Sprintf(`
+		SELECT
+			id, bash_id, run_id, body, is_error, created_at
+		FROM
+		    scripts.bash_log
+		WHERE 
+		    bash_id = $1 AND ($2::uuid IS NULL OR run_id = $2) AND %s
+		%s
+	`, where, filter.Query.OrderBy())
+
+	if err := stream.Rows(ctx, p.db, fn, q, append([]any{bashId, filter.RunId}, whereArgs...)...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Exporting bash logs by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Exporting bash logs by bash id: %v Error: %s", bashId, err))
+		}
+		return err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish exporting bash logs by bash id: %v", bashId))
+
+	return nil
+}
+
 func (p PgBashLogRepository) Create(
 	ctx context.Context,
 	dto dto.CreateBashLog,
 ) (*model.BashLog, error) {
 	bashLog := &model.BashLog{}
 
-	p.logger.Debug(fmt.Sprintf("Start creating bash log by bash id: %v", dto.BashId))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating bash log by bash id: %v", dto.BashId))
 	stmt := `
 		INSERT INTO scripts.bash_log
-			(bash_id, body, is_error)
+			(bash_id, run_id, body, is_error)
 		VALUES 
-			($1, $2, $3)
-		RETURNING id, bash_id, body, is_error, created_at
+			($1, $2, $3, $4)
+		RETURNING id, bash_id, run_id, body, is_error, created_at
 	`
 
-	if err := pgxscan.Get(ctx, p.db, bashLog, stmt, dto.BashId, dto.Body, dto.IsError); err != nil {
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		bashLog,
+		stmt,
+		dto.BashId,
+		dto.RunId,
+		dto.Body,
+		dto.IsError,
+	); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			p.logger.Error(
+			p.logger.ErrorContext(
+				ctx,
 				fmt.Sprintf(
 					"Creating bash log by bash id: %v Error: %s, Detail: %s, Where: %s",
 					dto.BashId,
@@ -99,11 +335,11 @@ func (p PgBashLogRepository) Create(
 				),
 			)
 		} else {
-			p.logger.Error(fmt.Sprintf("Creating bash log by bash id: %v Error: %s", dto.BashId, err))
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating bash log by bash id: %v Error: %s", dto.BashId, err))
 		}
 		return bashLog, err
 	}
-	p.logger.Debug(fmt.Sprintf("Finish creating bash log by bash id: %v", dto.BashId))
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating bash log by bash id: %v", dto.BashId))
 
 	return bashLog, nil
 }
@@ -116,7 +352,8 @@ func GetPgBashLogRepository() IBashLogRepository {
 		panic(err)
 	}
 	return &PgBashLogRepository{
-		db:     pg.GetDB(),
-		logger: logger,
+		db:                     pg.GetDB(),
+		logger:                 logger,
+		filterStatementTimeout: config.GetConfig().Postgres.FilterStatementTimeoutSeconds,
 	}
 }
@@ -0,0 +1,181 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/sql/partition"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// bashLogPartitionBoundLayout formats the partition bounds in the time of the created_at column without time zone.
+const bashLogPartitionBoundLayout = "2006-01-02 15:04:05"
+
+type PgBashLogPartitionRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgBashLogPartitionRepository) GetNameList(ctx context.Context) ([]string, error) {
+	var names []string
+
+	p.logger.DebugContext(ctx, "Start getting bash log partition names")
+	q := `
+		SELECT
+			c.relname
+		FROM
+			pg_inherits AS i
+			JOIN pg_class AS c ON c.oid = i.inhrelid
+		WHERE
+			i.inhparent = 'scripts.bash_log'::regclass
+		ORDER BY
+			c.relname
+	`
+
+	if err := pgxscan.Select(ctx, p.db, &names, q); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash log partition names Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log partition names Error: %s", err))
+		}
+		return nil, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash log partition names, count: %d", len(names)))
+
+	return names, nil
+}
+
+// Create creates the partition of the month, the logs of the month kept by the default partition
+// are moved to the new one, since the partition can not be created while the default one has its rows.
+// The default partition is detached for the move and attached back by the same statement.
+func (p PgBashLogPartitionRepository) Create(ctx context.Context, monthRange partition.MonthRange) error {
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating bash log partition: %s", monthRange.Name))
+	// DDL can't take the bounds as parameters, the name is quoted and the bounds are formatted times.
+	from := monthRange.From.Format(bashLogPartitionBoundLayout)
+	to := monthRange.To.Format(bashLogPartitionBoundLayout)
+	stmt := fmt.Sprintf(`
+		DO $$
+		BEGIN
+			IF to_regclass('scripts.bash_log_default') IS NOT NULL THEN
+				IF EXISTS (
+					SELECT 1 FROM scripts.bash_log_default WHERE created_at >= '%[2]s' AND created_at < '%[3]s'
+				) THEN
+					ALTER TABLE scripts.bash_log DETACH PARTITION scripts.bash_log_default;
+
+					CREATE TABLE IF NOT EXISTS %[1]s PARTITION OF scripts.bash_log
+					FOR VALUES FROM ('%[2]s') TO ('%[3]s');
+
+					WITH moved_bash_log AS (
+						DELETE FROM
+							scripts.bash_log_default
+						WHERE
+							created_at >= '%[2]s' AND created_at < '%[3]s'
+						RETURNING id, bash_id, run_id, body, is_error, created_at
+					)
+					INSERT INTO %[1]s
+						(id, bash_id, run_id, body, is_error, created_at)
+					SELECT
+						id, bash_id, run_id, body, is_error, created_at
+					FROM
+						moved_bash_log;
+
+					ALTER TABLE scripts.bash_log ATTACH PARTITION scripts.bash_log_default DEFAULT;
+					RETURN;
+				END IF;
+			END IF;
+
+			CREATE TABLE IF NOT EXISTS %[1]s PARTITION OF scripts.bash_log
+			FOR VALUES FROM ('%[2]s') TO ('%[3]s');
+		END
+		$$
+	`,
+		pgx.Identifier{"scripts", monthRange.Name}.Sanitize(),
+		from,
+		to,
+	)
+
+	if _, err := p.db.Exec(ctx, stmt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating bash log partition %s Error: %s, Detail: %s, Where: %s",
+					monthRange.Name,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating bash log partition %s Error: %s", monthRange.Name, err))
+		}
+		return err
+	}
+	p.logger.InfoContext(ctx, fmt.Sprintf("Created bash log partition: %s", monthRange.Name))
+
+	return nil
+}
+
+// Remove drops the partition with its logs or detaches it from the bash log table,
+// the detached partition stays as a standalone table for archiving.
+func (p PgBashLogPartitionRepository) Remove(ctx context.Context, name string, detach bool) error {
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start removing bash log partition: %s, detach: %t", name, detach))
+	identifier := pgx.Identifier{"scripts", name}.Sanitize()
+	stmt := fmt.Sprintf("DROP TABLE IF EXISTS %s", identifier)
+	if detach {
+		stmt = fmt.Sprintf("ALTER TABLE scripts.bash_log DETACH PARTITION %s", identifier)
+	}
+
+	if _, err := p.db.Exec(ctx, stmt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing bash log partition %s Error: %s, Detail: %s, Where: %s",
+					name,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing bash log partition %s Error: %s", name, err))
+		}
+		return err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing bash log partition: %s, detach: %t", name, detach))
+
+	return nil
+}
+
+func GetPgBashLogPartitionRepository() IBashLogPartitionRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgBashLogPartitionRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
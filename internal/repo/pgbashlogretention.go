@@ -0,0 +1,466 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/logging"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	uuid "github.com/satori/go.uuid"
+)
+
+// bashLogPrunableQuery selects the ids of the bash logs outside the retention of their scripts,
+// the limits of the script override the global ones passed as $1, $2 and $3 and the zero limits are skipped.
+// A log is prunable when it is older than the max age, is not among the max rows newest logs of the script
+// or belongs to a run which is not among the keep runs newest runs of the script. The logs without a run,
+// such as the errors of the scripts which failed to start, are pruned only by the max age and the max rows.
+const bashLogPrunableQuery = `
+	WITH policy AS (
+		SELECT
+			b.id AS bash_id,
+			NULLIF(COALESCE(r.max_age_seconds, $1), 0) AS max_age_seconds,
+			NULLIF(COALESCE(r.max_rows, $2), 0) AS max_rows,
+			NULLIF(COALESCE(r.keep_runs, $3), 0) AS keep_runs
+		FROM
+			scripts.bash AS b
+			LEFT JOIN scripts.bash_log_retention AS r ON r.bash_id = b.id
+	),
+	run AS (
+		SELECT
+			id, row_number() OVER (PARTITION BY bash_id ORDER BY created_at DESC, id DESC) AS run_rank
+		FROM
+			scripts.bash_run
+	),
+	log AS (
+		SELECT
+			l.id,
+			l.created_at,
+			row_number() OVER (PARTITION BY l.bash_id ORDER BY l.created_at DESC, l.id DESC) AS row_rank,
+			run.run_rank,
+			p.max_age_seconds,
+			p.max_rows,
+			p.keep_runs
+		FROM
+			scripts.bash_log AS l
+			JOIN policy AS p ON p.bash_id = l.bash_id
+			LEFT JOIN run ON run.id = l.run_id
+		WHERE
+			p.max_age_seconds IS NOT NULL OR p.max_rows IS NOT NULL OR p.keep_runs IS NOT NULL
+	)
+	SELECT
+		id
+	FROM
+		log
+	WHERE
+		created_at < (now() AT TIME ZONE 'UTC') - max_age_seconds * interval '1 second'
+		OR row_rank > max_rows
+		OR run_rank > keep_runs
+`
+
+// bashLogPruneCutoffQuery selects the cutoffs of the scripts with the retention, the limits are resolved
+// as in bashLogPrunableQuery. The max age cutoff is the creation time before which the logs are prunable,
+// the max rows cutoff is the oldest of the max rows newest logs and the keep runs cutoff is the oldest
+// of the keep runs newest runs, the logs and runs before them by (created_at, id) are prunable.
+const bashLogPruneCutoffQuery = `
+	WITH policy AS (
+		SELECT
+			b.id AS bash_id,
+			NULLIF(COALESCE(r.max_age_seconds, $1), 0) AS max_age_seconds,
+			NULLIF(COALESCE(r.max_rows, $2), 0) AS max_rows,
+			NULLIF(COALESCE(r.keep_runs, $3), 0) AS keep_runs
+		FROM
+			scripts.bash AS b
+			LEFT JOIN scripts.bash_log_retention AS r ON r.bash_id = b.id
+	)
+	SELECT
+		p.bash_id,
+		(now() AT TIME ZONE 'UTC') - p.max_age_seconds * interval '1 second' AS max_age_created_at,
+		l.created_at AS max_rows_created_at,
+		l.id AS max_rows_id,
+		r.created_at AS keep_runs_created_at,
+		r.id AS keep_runs_id
+	FROM
+		policy AS p
+		LEFT JOIN LATERAL (
+			SELECT
+				created_at, id
+			FROM
+				scripts.bash_log
+			WHERE
+				bash_id = p.bash_id AND p.max_rows IS NOT NULL
+			ORDER BY created_at DESC, id DESC
+			OFFSET p.max_rows - 1
+			LIMIT 1
+		) AS l ON true
+		LEFT JOIN LATERAL (
+			SELECT
+				created_at, id
+			FROM
+				scripts.bash_run
+			WHERE
+				bash_id = p.bash_id AND p.keep_runs IS NOT NULL
+			ORDER BY created_at DESC, id DESC
+			OFFSET p.keep_runs - 1
+			LIMIT 1
+		) AS r ON true
+	WHERE
+		p.max_age_seconds IS NOT NULL OR l.id IS NOT NULL OR r.id IS NOT NULL
+`
+
+// bashLogPruneRangeStmt removes a batch of the logs of the script $1 before ($2, $3) by (created_at, id).
+const bashLogPruneRangeStmt = `
+	DELETE FROM
+	    scripts.bash_log
+	WHERE
+		(id, created_at) IN (
+			SELECT
+				id, created_at
+			FROM
+				scripts.bash_log
+			WHERE
+				bash_id = $1 AND (created_at, id) < ($2, $3)
+			ORDER BY created_at, id
+			LIMIT $4
+		)
+`
+
+// bashLogPruneRunStmt removes a batch of the logs of the script $1 which belong to the runs before ($2, $3)
+// by (created_at, id).
+const bashLogPruneRunStmt = `
+	DELETE FROM
+	    scripts.bash_log
+	WHERE
+		(id, created_at) IN (
+			SELECT
+				id, created_at
+			FROM
+				scripts.bash_log
+			WHERE
+				bash_id = $1 AND run_id IN (
+					SELECT
+						id
+					FROM
+						scripts.bash_run
+					WHERE
+						bash_id = $1 AND (created_at, id) < ($2, $3)
+				)
+			LIMIT $4
+		)
+`
+
+// bashLogPruneCutoff is the row of bashLogPruneCutoffQuery, the nil cutoffs are not limited.
+type bashLogPruneCutoff struct {
+	BashId            uuid.UUID
+	MaxAgeCreatedAt   *time.Time
+	MaxRowsCreatedAt  *time.Time
+	MaxRowsId         *uuid.UUID
+	KeepRunsCreatedAt *time.Time
+	KeepRunsId        *uuid.UUID
+}
+
+// getRangeCutoff returns the newest of the max age and max rows cutoffs by (created_at, id),
+// the max age cutoff is paired with the nil id since no log is before it at the same creation time.
+func (c *bashLogPruneCutoff) getRangeCutoff() (*time.Time, uuid.UUID) {
+	if c.MaxRowsCreatedAt == nil {
+		return c.MaxAgeCreatedAt, uuid.Nil
+	}
+	if c.MaxAgeCreatedAt != nil && c.MaxAgeCreatedAt.After(*c.MaxRowsCreatedAt) {
+		return c.MaxAgeCreatedAt, uuid.Nil
+	}
+	return c.MaxRowsCreatedAt, *c.MaxRowsId
+}
+
+type PgBashLogRetentionRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgBashLogRetentionRepository) GetOneByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	bashLogRetention := &model.BashLogRetention{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash log retention by bash id: %v", bashId))
+	q := `
+		SELECT
+			bash_id, max_age_seconds, max_rows, keep_runs, updated_at
+		FROM
+		    scripts.bash_log_retention
+		WHERE
+			bash_id = $1
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashLogRetention, q, bashId); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash log retention by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log retention by bash id: %v Error: %s", bashId, err))
+		}
+		return bashLogRetention, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash log retention by bash id: %v", bashId))
+
+	return bashLogRetention, nil
+}
+
+func (p PgBashLogRetentionRepository) Set(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error) {
+	bashLogRetention := &model.BashLogRetention{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start setting bash log retention by bash id: %v", dto.BashId))
+	stmt := `
+		INSERT INTO scripts.bash_log_retention
+			(bash_id, max_age_seconds, max_rows, keep_runs)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (bash_id) DO UPDATE SET
+			max_age_seconds = EXCLUDED.max_age_seconds,
+			max_rows = EXCLUDED.max_rows,
+			keep_runs = EXCLUDED.keep_runs,
+			updated_at = now()
+		RETURNING bash_id, max_age_seconds, max_rows, keep_runs, updated_at
+	`
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		bashLogRetention,
+		stmt,
+		dto.BashId,
+		dto.MaxAgeSeconds,
+		dto.MaxRows,
+		dto.KeepRuns,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Setting bash log retention by bash id: %v Error: %s, Detail: %s, Where: %s",
+					dto.BashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Setting bash log retention by bash id: %v Error: %s", dto.BashId, err))
+		}
+		return bashLogRetention, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish setting bash log retention by bash id: %v", dto.BashId))
+
+	return bashLogRetention, nil
+}
+
+func (p PgBashLogRetentionRepository) RemoveByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	bashLogRetention := &model.BashLogRetention{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start removing bash log retention by bash id: %v", bashId))
+	stmt := `
+		DELETE FROM
+		    scripts.bash_log_retention
+		WHERE
+			bash_id = $1
+		RETURNING bash_id, max_age_seconds, max_rows, keep_runs, updated_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashLogRetention, stmt, bashId); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing bash log retention by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing bash log retention by bash id: %v Error: %s", bashId, err))
+		}
+		return bashLogRetention, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing bash log retention by bash id: %v", bashId))
+
+	return bashLogRetention, nil
+}
+
+func (p PgBashLogRetentionRepository) CountPrunable(ctx context.Context, policy dto.BashLogRetentionPolicy) (int64, error) {
+	var prunableCount int64
+
+	p.logger.DebugContext(ctx, "Start counting prunable bash logs")
+	q := fmt.Sprintf("SELECT COUNT(*) AS total FROM (%s) AS q1", bashLogPrunableQuery)
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		&prunableCount,
+		q,
+		policy.MaxAgeSeconds,
+		policy.MaxRows,
+		policy.KeepRuns,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Counting prunable bash logs Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Counting prunable bash logs Error: %s", err))
+		}
+		return 0, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish counting prunable bash logs, prunable: %d", prunableCount))
+
+	return prunableCount, nil
+}
+
+// Prune removes the prunable bash logs by batches of batchSize rows, every batch is a separate statement,
+// so the rows are locked only for a short time. The cutoffs of every script are computed once before the removal
+// and the batches remove the logs before them, a script is pruned until its batch is not full, the pruning stops
+// when the context is done. The non-positive batch size removes the prunable logs of a script by one statement.
+func (p PgBashLogRetentionRepository) Prune(ctx context.Context, policy dto.BashLogRetentionPolicy, batchSize int) (int64, error) {
+	var removedCount int64
+	var cutoffs []*bashLogPruneCutoff
+
+	p.logger.DebugContext(ctx, "Start pruning bash logs")
+	if err := pgxscan.Select(
+		ctx,
+		p.db,
+		&cutoffs,
+		bashLogPruneCutoffQuery,
+		policy.MaxAgeSeconds,
+		policy.MaxRows,
+		policy.KeepRuns,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash log prune cutoffs Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash log prune cutoffs Error: %s", err))
+		}
+		return 0, err
+	}
+
+	var limit any
+	if batchSize > 0 {
+		limit = batchSize
+	}
+
+	var err error
+	for _, cutoff := range cutoffs {
+		if createdAt, id := cutoff.getRangeCutoff(); createdAt != nil {
+			removedCount, err = p.pruneBatches(ctx, removedCount, bashLogPruneRangeStmt, cutoff.BashId, createdAt, id, limit)
+			if err != nil {
+				return removedCount, err
+			}
+		}
+		if cutoff.KeepRunsCreatedAt != nil {
+			removedCount, err = p.pruneBatches(
+				ctx,
+				removedCount,
+				bashLogPruneRunStmt,
+				cutoff.BashId,
+				cutoff.KeepRunsCreatedAt,
+				cutoff.KeepRunsId,
+				limit,
+			)
+			if err != nil {
+				return removedCount, err
+			}
+		}
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish pruning bash logs, removed: %d", removedCount))
+
+	return removedCount, nil
+}
+
+// pruneBatches executes the prune statement until its batch is not full, the batch size is the last argument
+// and the nil one removes all logs by one statement. The removed count is added to the passed one.
+func (p PgBashLogRetentionRepository) pruneBatches(
+	ctx context.Context,
+	removedCount int64,
+	stmt string,
+	args ...any,
+) (int64, error) {
+	limit := args[len(args)-1]
+
+	for {
+		if err := ctx.Err(); err != nil {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Pruning bash logs Error: %s, removed: %d", err, removedCount))
+			return removedCount, err
+		}
+
+		tag, err := p.db.Exec(ctx, stmt, args...)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				p.logger.ErrorContext(
+					ctx,
+					fmt.Sprintf(
+						"Pruning bash logs Error: %s, Detail: %s, Where: %s, removed: %d",
+						pgErr.Message,
+						pgErr.Detail,
+						pgErr.Where,
+						removedCount,
+					),
+				)
+			} else {
+				p.logger.ErrorContext(ctx, fmt.Sprintf("Pruning bash logs Error: %s, removed: %d", err, removedCount))
+			}
+			return removedCount, err
+		}
+
+		removedCount += tag.RowsAffected()
+		if limit == nil || tag.RowsAffected() < int64(limit.(int)) {
+			return removedCount, nil
+		}
+		p.logger.InfoContext(ctx, fmt.Sprintf("Pruning bash logs, removed: %d", removedCount))
+	}
+}
+
+func GetPgBashLogRetentionRepository() IBashLogRetentionRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgBashLogRetentionRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
@@ -0,0 +1,244 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PgBashRunRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgBashRunRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.BashRun, error) {
+	bashRun := &model.BashRun{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash run by id: %v", id))
+	q := `
+		SELECT
+			id, bash_id, status, request_id, created_at, finished_at, expires_at, redaction_count
+		FROM
+		    scripts.bash_run
+		WHERE
+			id = $1
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashRun, q, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash run by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash run by id: %v Error: %s", id, err))
+		}
+		return bashRun, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash run by id: %v", id))
+
+	return bashRun, nil
+}
+
+func (p PgBashRunRepository) GetPaginationPageByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.BashRunLimitOffsetPage, error) {
+	var bashRunPaginationPage alias.BashRunLimitOffsetPage
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash run pagination page by bash id: %v", bashId))
+	q := `
+		SELECT
+			id, bash_id, status, request_id, created_at, finished_at, expires_at, redaction_count
+		FROM
+		    scripts.bash_run
+		WHERE
+		    bash_id = $1
+	`
+
+	bashRunPaginationPage, err := pagination.Paginate[*model.BashRun](
+		ctx,
+		p.db,
+		q,
+		paginationParams,
+		bashId,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash run pagination page by bash id: %v Error: %s, Detail: %s, Where: %s",
+					bashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash run pagination page by bash id: %v Error: %s", bashId, err))
+		}
+		return bashRunPaginationPage, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash run pagination page by bash id: %v", bashId))
+
+	return bashRunPaginationPage, nil
+}
+
+func (p PgBashRunRepository) Create(
+	ctx context.Context,
+	dto dto.CreateBashRun,
+) (*model.BashRun, error) {
+	bashRun := &model.BashRun{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating bash run by bash id: %v", dto.BashId))
+	stmt := `
+		INSERT INTO scripts.bash_run
+			(id, bash_id, status, request_id, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING id, bash_id, status, request_id, created_at, finished_at, expires_at, redaction_count
+	`
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		bashRun,
+		stmt,
+		dto.Id,
+		dto.BashId,
+		model.BashRunStatusRunning,
+		dto.RequestId,
+		dto.ExpiresAt,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating bash run by bash id: %v Error: %s, Detail: %s, Where: %s",
+					dto.BashId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating bash run by bash id: %v Error: %s", dto.BashId, err))
+		}
+		return bashRun, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating bash run by bash id: %v", dto.BashId))
+
+	return bashRun, nil
+}
+
+func (p PgBashRunRepository) FinishById(
+	ctx context.Context,
+	id uuid.UUID,
+	status string,
+	redactionCount int64,
+) (*model.BashRun, error) {
+	bashRun := &model.BashRun{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start finishing bash run by id: %v with status: %s", id, status))
+	stmt := `
+		UPDATE
+		    scripts.bash_run
+		SET
+			status = $2, finished_at = now(), redaction_count = $3
+		WHERE
+			id = $1
+		RETURNING id, bash_id, status, request_id, created_at, finished_at, expires_at, redaction_count
+	`
+
+	if err := pgxscan.Get(ctx, p.db, bashRun, stmt, id, status, redactionCount); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Finishing bash run by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Finishing bash run by id: %v Error: %s", id, err))
+		}
+		return bashRun, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish finishing bash run by id: %v with status: %s", id, status))
+
+	return bashRun, nil
+}
+
+func (p PgBashRunRepository) RemoveExpired(ctx context.Context) (int64, error) {
+	p.logger.DebugContext(ctx, "Start removing expired bash runs")
+	stmt := `
+		DELETE FROM
+		    scripts.bash_run
+		WHERE
+			expires_at IS NOT NULL AND expires_at < now()
+	`
+
+	tag, err := p.db.Exec(ctx, stmt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing expired bash runs Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing expired bash runs Error: %s", err))
+		}
+		return 0, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing expired bash runs, removed: %d", tag.RowsAffected()))
+
+	return tag.RowsAffected(), nil
+}
+
+func GetPgBashRunRepository() IBashRunRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgBashRunRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
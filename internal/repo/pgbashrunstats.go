@@ -0,0 +1,331 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/stats"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// bashRunStatsColumns aggregates the bash runs aliased as r, the runs are counted by id,
+// so the rows of the left join without runs are skipped. The durations of the running runs are null
+// and are skipped by the percentiles as well.
+var bashRunStatsColumns = fmt.Sprintf(`
+	COUNT(r.id) AS total,
+	COUNT(r.id) FILTER (WHERE r.status = '%[1]s') AS success,
+	COUNT(r.id) FILTER (WHERE r.status = '%[2]s') AS failure,
+	COUNT(r.id) FILTER (WHERE r.status = '%[3]s') AS timeout,
+	COUNT(r.id) FILTER (WHERE r.status = '%[4]s') AS interrupted,
+	COUNT(r.id) FILTER (WHERE r.status = '%[5]s') AS running,
+	COUNT(r.id) FILTER (WHERE r.status = '%[1]s')::DOUBLE PRECISION
+		/ NULLIF(COUNT(r.id) FILTER (WHERE r.status <> '%[5]s'), 0) AS success_rate,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM r.finished_at - r.created_at)::DOUBLE PRECISION) AS duration_p50,
+	percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM r.finished_at - r.created_at)::DOUBLE PRECISION) AS duration_p95,
+	MAX(EXTRACT(EPOCH FROM r.finished_at - r.created_at))::DOUBLE PRECISION AS duration_max,
+	MAX(r.finished_at) FILTER (WHERE r.status = '%[1]s') AS last_success_at,
+	MAX(r.finished_at) FILTER (WHERE r.status NOT IN ('%[1]s', '%[5]s')) AS last_failure_at
+`,
+	model.BashRunStatusSuccess,
+	model.BashRunStatusFailure,
+	model.BashRunStatusTimeout,
+	model.BashRunStatusInterrupted,
+	model.BashRunStatusRunning,
+)
+
+// bashRunStatsRollupColumns are the stats columns of the rollup in the order of bashRunStatsColumns.
+const bashRunStatsRollupColumns = `
+	total, success, failure, timeout, interrupted, running,
+	success_rate, duration_p50, duration_p95, duration_max, last_success_at, last_failure_at
+`
+
+type PgBashRunStatsRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+// getWindowArgs returns the names and the seconds of the windows passed to unnest.
+func getWindowArgs(windows []stats.Window) ([]string, []int64) {
+	names := make([]string, 0, len(windows))
+	seconds := make([]int64, 0, len(windows))
+	for _, window := range windows {
+		names = append(names, window.Name)
+		seconds = append(seconds, window.Seconds())
+	}
+	return names, seconds
+}
+
+func (p PgBashRunStatsRepository) GetWindowListByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	windows []stats.Window,
+) ([]*model.BashRunWindowStats, error) {
+	var bashRunWindowStatsList []*model.BashRunWindowStats
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash run stats by bash id: %v", bashId))
+	q := fmt.Sprintf(`
+		SELECT
+			w.window_name AS "window",
+			now() - w.window_seconds * interval '1 second' AS since,
+			%s
+		FROM
+			unnest($2::VARCHAR[], $3::BIGINT[]) AS w(window_name, window_seconds)
+			LEFT JOIN scripts.bash_run AS r
+				ON r.bash_id = $1 AND r.created_at >= now() - w.window_seconds * interval '1 second'
+		GROUP BY
+			w.window_name, w.window_seconds
+		ORDER BY
+			w.window_seconds
+	`, bashRunStatsColumns)
+
+	names, seconds := getWindowArgs(windows)
+	if err := pgxscan.Select(ctx, p.db, &bashRunWindowStatsList, q, bashId, names, seconds); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash run stats by bash id Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash run stats by bash id Error: %s", err))
+		}
+		return nil, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash run stats by bash id: %v", bashId))
+
+	return bashRunWindowStatsList, nil
+}
+
+func (p PgBashRunStatsRepository) GetTotal(ctx context.Context, window stats.Window) (*model.BashRunWindowStats, error) {
+	bashRunWindowStats := &model.BashRunWindowStats{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting total bash run stats of window: %s", window.Name))
+	q := fmt.Sprintf(`
+		SELECT
+			$1::VARCHAR AS "window",
+			now() - $2::BIGINT * interval '1 second' AS since,
+			%s
+		FROM
+			scripts.bash_run AS r
+		WHERE
+			r.bash_id IS NOT NULL
+			AND r.created_at >= now() - $2::BIGINT * interval '1 second'
+	`, bashRunStatsColumns)
+
+	if err := pgxscan.Get(ctx, p.db, bashRunWindowStats, q, window.Name, window.Seconds()); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting total bash run stats Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting total bash run stats Error: %s", err))
+		}
+		return nil, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting total bash run stats of window: %s", window.Name))
+
+	return bashRunWindowStats, nil
+}
+
+func (p PgBashRunStatsRepository) GetScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error) {
+	var bashRunScriptStatsList []*model.BashRunScriptStats
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting bash run stats of scripts of window: %s", window.Name))
+	q := fmt.Sprintf(`
+		SELECT
+			b.id AS bash_id,
+			b.title,
+			%s
+		FROM
+			scripts.bash_run AS r
+			JOIN scripts.bash AS b ON b.id = r.bash_id
+		WHERE
+			r.created_at >= now() - $1::BIGINT * interval '1 second'
+		GROUP BY
+			b.id, b.title
+		ORDER BY
+			COUNT(r.id) FILTER (WHERE r.status NOT IN ('%s', '%s')) DESC, b.title, b.id
+	`, bashRunStatsColumns, model.BashRunStatusSuccess, model.BashRunStatusRunning)
+
+	if err := pgxscan.Select(ctx, p.db, &bashRunScriptStatsList, q, window.Seconds()); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting bash run stats of scripts Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting bash run stats of scripts Error: %s", err))
+		}
+		return nil, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting bash run stats of scripts of window: %s", window.Name))
+
+	return bashRunScriptStatsList, nil
+}
+
+func (p PgBashRunStatsRepository) GetRolledUpTotal(ctx context.Context, window stats.Window) (*model.BashRunStatsRollup, error) {
+	bashRunStatsRollup := &model.BashRunStatsRollup{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting rolled up total bash run stats of window: %s", window.Name))
+	q := fmt.Sprintf(`
+		SELECT
+			window_name AS "window", since, %s, rolled_up_at
+		FROM
+		    scripts.bash_run_stats_rollup
+		WHERE
+			window_name = $1 AND bash_id IS NULL
+	`, bashRunStatsRollupColumns)
+
+	if err := pgxscan.Get(ctx, p.db, bashRunStatsRollup, q, window.Name); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting rolled up total bash run stats Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting rolled up total bash run stats Error: %s", err))
+		}
+		return nil, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting rolled up total bash run stats of window: %s", window.Name))
+
+	return bashRunStatsRollup, nil
+}
+
+func (p PgBashRunStatsRepository) GetRolledUpScriptList(
+	ctx context.Context,
+	window stats.Window,
+) ([]*model.BashRunScriptStats, error) {
+	var bashRunScriptStatsList []*model.BashRunScriptStats
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting rolled up bash run stats of scripts of window: %s", window.Name))
+	q := fmt.Sprintf(`
+		SELECT
+			b.id AS bash_id, b.title, %s
+		FROM
+		    scripts.bash_run_stats_rollup AS s
+			JOIN scripts.bash AS b ON b.id = s.bash_id
+		WHERE
+			s.window_name = $1
+		ORDER BY
+			s.failure + s.timeout + s.interrupted DESC, b.title, b.id
+	`, bashRunStatsRollupColumns)
+
+	if err := pgxscan.Select(ctx, p.db, &bashRunScriptStatsList, q, window.Name); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting rolled up bash run stats of scripts Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting rolled up bash run stats of scripts Error: %s", err))
+		}
+		return nil, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting rolled up bash run stats of scripts of window: %s", window.Name))
+
+	return bashRunScriptStatsList, nil
+}
+
+// Rollup replaces the rollup by the stats of every window, the totals of the windows are kept
+// as the rows without bash id. The replacement is a single statement, so the readers see
+// either the previous rollup or the new one.
+func (p PgBashRunStatsRepository) Rollup(ctx context.Context, windows []stats.Window) (int64, error) {
+	p.logger.DebugContext(ctx, "Start rolling up bash run stats")
+	stmt := fmt.Sprintf(`
+		WITH removed AS (
+			DELETE FROM scripts.bash_run_stats_rollup
+		)
+		INSERT INTO scripts.bash_run_stats_rollup
+			(window_name, bash_id, since, %s)
+		SELECT
+			w.window_name,
+			r.bash_id,
+			now() - w.window_seconds * interval '1 second',
+			%s
+		FROM
+			unnest($1::VARCHAR[], $2::BIGINT[]) AS w(window_name, window_seconds)
+			LEFT JOIN scripts.bash_run AS r
+				ON r.bash_id IS NOT NULL AND r.created_at >= now() - w.window_seconds * interval '1 second'
+		GROUP BY
+			GROUPING SETS ((w.window_name, w.window_seconds, r.bash_id), (w.window_name, w.window_seconds))
+		HAVING
+			GROUPING(r.bash_id) = 1 OR r.bash_id IS NOT NULL
+	`, bashRunStatsRollupColumns, bashRunStatsColumns)
+
+	names, seconds := getWindowArgs(windows)
+	tag, err := p.db.Exec(ctx, stmt, names, seconds)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Rolling up bash run stats Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Rolling up bash run stats Error: %s", err))
+		}
+		return 0, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish rolling up bash run stats, rows: %d", tag.RowsAffected()))
+
+	return tag.RowsAffected(), nil
+}
+
+func GetPgBashRunStatsRepository() IBashRunStatsRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgBashRunStatsRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/logging"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+type PgHealthRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgHealthRepository) Ping(ctx context.Context) error {
+	p.logger.DebugContext(ctx, "Start pinging database")
+	if err := p.db.Ping(ctx); err != nil {
+		p.logger.ErrorContext(ctx, fmt.Sprintf("Pinging database Error: %s", err))
+		return err
+	}
+	p.logger.DebugContext(ctx, "Finish pinging database")
+
+	return nil
+}
+
+func (p PgHealthRepository) GetMigrationStatus(ctx context.Context) (*model.MigrationStatus, error) {
+	migrationStatus := &model.MigrationStatus{}
+
+	p.logger.DebugContext(ctx, "Start getting migration status")
+	latestVersion, err := db.GetLatestMigrationVersion()
+	if err != nil {
+		p.logger.ErrorContext(ctx, fmt.Sprintf("Getting latest migration version Error: %s", err))
+		return migrationStatus, err
+	}
+	migrationStatus.LatestVersion = latestVersion
+
+	sqlDB := stdlib.OpenDBFromPool(p.db)
+	defer func(sqlDB *sql.DB) {
+		if err := sqlDB.Close(); err != nil {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Close migration db Error: %s", err))
+		}
+	}(sqlDB)
+
+	currentVersion, err := goose.GetDBVersionContext(ctx, sqlDB)
+	if err != nil {
+		p.logger.ErrorContext(ctx, fmt.Sprintf("Getting current migration version Error: %s", err))
+		return migrationStatus, err
+	}
+	migrationStatus.CurrentVersion = currentVersion
+	p.logger.DebugContext(ctx, "Finish getting migration status")
+
+	return migrationStatus, nil
+}
+
+func GetPgHealthRepository() IHealthRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgHealthRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
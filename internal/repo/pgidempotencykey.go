@@ -0,0 +1,246 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/logging"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PgIdempotencyKeyRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgIdempotencyKeyRepository) GetOneByKey(
+	ctx context.Context,
+	principalId string,
+	key string,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey := &model.IdempotencyKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting idempotency key: %s", key))
+	q := `
+		SELECT
+			principal_id, key, fingerprint, response, created_at, expires_at
+		FROM
+		    scripts.idempotency_key
+		WHERE
+			principal_id = $1 AND key = $2 AND expires_at >= now()
+	`
+
+	if err := pgxscan.Get(ctx, p.db, idempotencyKey, q, principalId, key); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting idempotency key: %s Error: %s, Detail: %s, Where: %s",
+					key,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting idempotency key: %s Error: %s", key, err))
+		}
+		return idempotencyKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting idempotency key: %s", key))
+
+	return idempotencyKey, nil
+}
+
+// Create reserves the key of the principal, an expired key with the same value is replaced,
+// while a key that is still alive is left as is and leads to the no rows error.
+func (p PgIdempotencyKeyRepository) Create(
+	ctx context.Context,
+	dto dto.CreateIdempotencyKey,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey := &model.IdempotencyKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating idempotency key: %s", dto.Key))
+	stmt := `
+		INSERT INTO scripts.idempotency_key
+			(principal_id, key, fingerprint, expires_at)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (principal_id, key) DO UPDATE
+		SET
+			fingerprint = EXCLUDED.fingerprint,
+			response = NULL,
+			created_at = now(),
+			expires_at = EXCLUDED.expires_at
+		WHERE
+			scripts.idempotency_key.expires_at < now()
+		RETURNING principal_id, key, fingerprint, response, created_at, expires_at
+	`
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		idempotencyKey,
+		stmt,
+		dto.PrincipalId,
+		dto.Key,
+		dto.Fingerprint,
+		dto.ExpiresAt,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating idempotency key: %s Error: %s, Detail: %s, Where: %s",
+					dto.Key,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating idempotency key: %s Error: %s", dto.Key, err))
+		}
+		return idempotencyKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating idempotency key: %s", dto.Key))
+
+	return idempotencyKey, nil
+}
+
+func (p PgIdempotencyKeyRepository) SetResponseByKey(
+	ctx context.Context,
+	principalId string,
+	key string,
+	response json.RawMessage,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey := &model.IdempotencyKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start setting response of idempotency key: %s", key))
+	stmt := `
+		UPDATE
+		    scripts.idempotency_key
+		SET
+			response = $3
+		WHERE
+			principal_id = $1 AND key = $2
+		RETURNING principal_id, key, fingerprint, response, created_at, expires_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, idempotencyKey, stmt, principalId, key, response); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Setting response of idempotency key: %s Error: %s, Detail: %s, Where: %s",
+					key,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Setting response of idempotency key: %s Error: %s", key, err))
+		}
+		return idempotencyKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish setting response of idempotency key: %s", key))
+
+	return idempotencyKey, nil
+}
+
+func (p PgIdempotencyKeyRepository) RemoveByKey(
+	ctx context.Context,
+	principalId string,
+	key string,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey := &model.IdempotencyKey{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start removing idempotency key: %s", key))
+	stmt := `
+		DELETE FROM
+		    scripts.idempotency_key
+		WHERE
+			principal_id = $1 AND key = $2
+		RETURNING principal_id, key, fingerprint, response, created_at, expires_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, idempotencyKey, stmt, principalId, key); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing idempotency key: %s Error: %s, Detail: %s, Where: %s",
+					key,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing idempotency key: %s Error: %s", key, err))
+		}
+		return idempotencyKey, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing idempotency key: %s", key))
+
+	return idempotencyKey, nil
+}
+
+func (p PgIdempotencyKeyRepository) RemoveExpired(ctx context.Context) (int64, error) {
+	p.logger.DebugContext(ctx, "Start removing expired idempotency keys")
+	stmt := `
+		DELETE FROM
+		    scripts.idempotency_key
+		WHERE
+			expires_at < now()
+	`
+
+	tag, err := p.db.Exec(ctx, stmt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing expired idempotency keys Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing expired idempotency keys Error: %s", err))
+		}
+		return 0, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing expired idempotency keys, removed: %d", tag.RowsAffected()))
+
+	return tag.RowsAffected(), nil
+}
+
+func GetPgIdempotencyKeyRepository() IIdempotencyKeyRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgIdempotencyKeyRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
@@ -0,0 +1,253 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PgWebhookRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgWebhookRepository) GetOneById(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook := &model.Webhook{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start getting webhook by id: %v", id))
+	q := `
+		SELECT
+			id, bash_id, url, secret, events, created_at
+		FROM
+		    scripts.webhook
+		WHERE
+			id = $1
+	`
+
+	if err := pgxscan.Get(ctx, p.db, webhook, q, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting webhook by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting webhook by id: %v Error: %s", id, err))
+		}
+		return webhook, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish getting webhook by id: %v", id))
+
+	return webhook, nil
+}
+
+func (p PgWebhookRepository) GetPaginationPage(
+	ctx context.Context,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.WebhookLimitOffsetPage, error) {
+	var webhookPaginationPage alias.WebhookLimitOffsetPage
+
+	p.logger.DebugContext(ctx, "Start getting webhook pagination page")
+	q := `
+		SELECT
+			id, bash_id, url, secret, events, created_at
+		FROM
+		    scripts.webhook
+	`
+
+	webhookPaginationPage, err := pagination.Paginate[*model.Webhook](
+		ctx,
+		p.db,
+		q,
+		paginationParams,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting webhook pagination page Error: %s, Detail: %s, Where: %s",
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Getting webhook pagination page Error: %s", err))
+		}
+		return webhookPaginationPage, err
+	}
+	p.logger.DebugContext(ctx, "Finish getting webhook pagination page")
+
+	return webhookPaginationPage, nil
+}
+
+func (p PgWebhookRepository) GetListByBashIdAndEvent(
+	ctx context.Context,
+	bashId uuid.UUID,
+	event string,
+) ([]*model.Webhook, error) {
+	webhooks := make([]*model.Webhook, 0)
+
+	p.logger.DebugContext(
+		ctx,
+		fmt.Sprintf("Start getting webhook list by bash id: %v and event: %s", bashId, event),
+	)
+	q := `
+		SELECT
+			id, bash_id, url, secret, events, created_at
+		FROM
+		    scripts.webhook
+		WHERE
+			(bash_id IS NULL OR bash_id = $1) AND $2 = ANY(events)
+	`
+
+	if err := pgxscan.Select(ctx, p.db, &webhooks, q, bashId, event); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting webhook list by bash id: %v and event: %s Error: %s, Detail: %s, Where: %s",
+					bashId,
+					event,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting webhook list by bash id: %v and event: %s Error: %s",
+					bashId,
+					event,
+					err,
+				),
+			)
+		}
+		return webhooks, err
+	}
+	p.logger.DebugContext(
+		ctx,
+		fmt.Sprintf("Finish getting webhook list by bash id: %v and event: %s", bashId, event),
+	)
+
+	return webhooks, nil
+}
+
+func (p PgWebhookRepository) Create(
+	ctx context.Context,
+	dto dto.CreateWebhook,
+) (*model.Webhook, error) {
+	webhook := &model.Webhook{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating webhook with url: %s", dto.Url))
+	stmt := `
+		INSERT INTO scripts.webhook
+			(bash_id, url, secret, events)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id, bash_id, url, secret, events, created_at
+	`
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		webhook,
+		stmt,
+		dto.BashId,
+		dto.Url,
+		dto.Secret,
+		dto.Events,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating webhook with url: %s Error: %s, Detail: %s, Where: %s",
+					dto.Url,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Creating webhook with url: %s Error: %s", dto.Url, err))
+		}
+		return webhook, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating webhook with url: %s", dto.Url))
+
+	return webhook, nil
+}
+
+func (p PgWebhookRepository) RemoveById(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook := &model.Webhook{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start removing webhook by id: %v", id))
+	stmt := `
+		DELETE FROM
+		    scripts.webhook
+		WHERE
+			id = $1
+		RETURNING id, bash_id, url, secret, events, created_at
+	`
+
+	if err := pgxscan.Get(ctx, p.db, webhook, stmt, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Removing webhook by id: %v Error: %s, Detail: %s, Where: %s",
+					id,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(ctx, fmt.Sprintf("Removing webhook by id: %v Error: %s", id, err))
+		}
+		return webhook, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish removing webhook by id: %v", id))
+
+	return webhook, nil
+}
+
+func GetPgWebhookRepository() IWebhookRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgWebhookRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
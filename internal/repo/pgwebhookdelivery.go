@@ -0,0 +1,156 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pg-sh-scripts/internal/db"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PgWebhookDeliveryRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func (p PgWebhookDeliveryRepository) GetPaginationPageByWebhookId(
+	ctx context.Context,
+	webhookId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.WebhookDeliveryLimitOffsetPage, error) {
+	var webhookDeliveryPaginationPage alias.WebhookDeliveryLimitOffsetPage
+
+	p.logger.DebugContext(
+		ctx,
+		fmt.Sprintf("Start getting webhook delivery pagination page by webhook id: %v", webhookId),
+	)
+	q := `
+		SELECT
+			id, webhook_id, event, payload, attempt, status_code, error, is_success, created_at
+		FROM
+		    scripts.webhook_delivery
+		WHERE
+		    webhook_id = $1
+	`
+
+	webhookDeliveryPaginationPage, err := pagination.Paginate[*model.WebhookDelivery](
+		ctx,
+		p.db,
+		q,
+		paginationParams,
+		webhookId,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting webhook delivery pagination page by webhook id: %v Error: %s, Detail: %s, Where: %s",
+					webhookId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Getting webhook delivery pagination page by webhook id: %v Error: %s",
+					webhookId,
+					err,
+				),
+			)
+		}
+		return webhookDeliveryPaginationPage, err
+	}
+	p.logger.DebugContext(
+		ctx,
+		fmt.Sprintf("Finish getting webhook delivery pagination page by webhook id: %v", webhookId),
+	)
+
+	return webhookDeliveryPaginationPage, nil
+}
+
+func (p PgWebhookDeliveryRepository) Create(
+	ctx context.Context,
+	dto dto.CreateWebhookDelivery,
+) (*model.WebhookDelivery, error) {
+	webhookDelivery := &model.WebhookDelivery{}
+
+	p.logger.DebugContext(ctx, fmt.Sprintf("Start creating webhook delivery by webhook id: %v", dto.WebhookId))
+	stmt := `
+		INSERT INTO scripts.webhook_delivery
+			(webhook_id, event, payload, attempt, status_code, error, is_success)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, webhook_id, event, payload, attempt, status_code, error, is_success, created_at
+	`
+
+	if err := pgxscan.Get(
+		ctx,
+		p.db,
+		webhookDelivery,
+		stmt,
+		dto.WebhookId,
+		dto.Event,
+		dto.Payload,
+		dto.Attempt,
+		dto.StatusCode,
+		dto.Error,
+		dto.IsSuccess,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating webhook delivery by webhook id: %v Error: %s, Detail: %s, Where: %s",
+					dto.WebhookId,
+					pgErr.Message,
+					pgErr.Detail,
+					pgErr.Where,
+				),
+			)
+		} else {
+			p.logger.ErrorContext(
+				ctx,
+				fmt.Sprintf(
+					"Creating webhook delivery by webhook id: %v Error: %s",
+					dto.WebhookId,
+					err,
+				),
+			)
+		}
+		return webhookDelivery, err
+	}
+	p.logger.DebugContext(ctx, fmt.Sprintf("Finish creating webhook delivery by webhook id: %v", dto.WebhookId))
+
+	return webhookDelivery, nil
+}
+
+func GetPgWebhookDeliveryRepository() IWebhookDeliveryRepository {
+	logger := log.GetLogger()
+	pg, err := db.GetPgClient()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Getting postgres client Error: %s", err))
+		panic(err)
+	}
+	return &PgWebhookDeliveryRepository{
+		db:     pg.GetDB(),
+		logger: logger,
+	}
+}
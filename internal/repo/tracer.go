@@ -0,0 +1,5 @@
+package repo
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("pg-sh-scripts/internal/repo")
@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IWebhookRepository interface {
+	GetOneById(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+	GetPaginationPage(
+		ctx context.Context,
+		paginationParams pagination.LimitOffsetParams,
+	) (alias.WebhookLimitOffsetPage, error)
+	GetListByBashIdAndEvent(
+		ctx context.Context,
+		bashId uuid.UUID,
+		event string,
+	) ([]*model.Webhook, error)
+	Create(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error)
+	RemoveById(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+}
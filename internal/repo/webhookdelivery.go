@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IWebhookDeliveryRepository interface {
+	GetPaginationPageByWebhookId(
+		ctx context.Context,
+		webhookId uuid.UUID,
+		paginationParams pagination.LimitOffsetParams,
+	) (alias.WebhookDeliveryLimitOffsetPage, error)
+	Create(ctx context.Context, dto dto.CreateWebhookDelivery) (*model.WebhookDelivery, error)
+}
@@ -0,0 +1,8 @@
+package schema
+
+import "pg-sh-scripts/internal/model"
+
+type ApiKeyWithSecret struct {
+	*model.ApiKey
+	Key string `json:"key" example:"psk_0a1b2c3d_5f1e..."`
+}
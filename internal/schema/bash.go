@@ -0,0 +1,8 @@
+package schema
+
+import uuid "github.com/satori/go.uuid"
+
+type ExecBashList struct {
+	Message string      `json:"message" example:"OK"`
+	RunIds  []uuid.UUID `json:"runIds"  swaggertype:"array,string" example:"7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90"`
+}
@@ -0,0 +1,6 @@
+package schema
+
+type BashLogPrune struct {
+	DryRun bool  `json:"dryRun" example:"true"`
+	Count  int64 `json:"count"  example:"1520"`
+}
@@ -0,0 +1,23 @@
+package schema
+
+const (
+	HealthStatusUp   = "up"
+	HealthStatusDown = "down"
+)
+
+type (
+	HealthCheck struct {
+		Status string `json:"status"           example:"up"`
+		Detail string `json:"detail,omitempty" example:"2 of 10 scripts are running"`
+	}
+
+	Health struct {
+		Status string                  `json:"status"           example:"up"`
+		Checks map[string]*HealthCheck `json:"checks,omitempty"`
+	}
+)
+
+// IsUp reports whether all the checks are up.
+func (h *Health) IsUp() bool {
+	return h.Status == HealthStatusUp
+}
@@ -1,11 +1,17 @@
 package schema
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"pg-sh-scripts/pkg/validation"
+)
 
 type HTTPError struct {
 	HTTPCode    int    `json:"httpCode"`
 	ServiceCode int    `json:"serviceCode"`
 	Detail      string `json:"detail"`
+	// Errors lists the invalid fields of the request, it is set only for validation errors.
+	Errors []*validation.FieldError `json:"errors,omitempty"`
 }
 
 func (e *HTTPError) Error() string {
@@ -16,3 +22,16 @@ func (e *HTTPError) Error() string {
 		e.Detail,
 	)
 }
+
+// WithFieldErrors returns a copy of the http error with the invalid fields,
+// so the shared errors of the config are never modified.
+func WithFieldErrors(err error, fieldErrors []*validation.FieldError) error {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		return err
+	}
+
+	httpErrCopy := *httpErr
+	httpErrCopy.Errors = fieldErrors
+	return &httpErrCopy
+}
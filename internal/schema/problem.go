@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"net/http"
+	"pg-sh-scripts/pkg/validation"
+	"strconv"
+)
+
+// ProblemTypeBaseUri is the prefix of the problem type, the service code of the error is appended to it.
+const ProblemTypeBaseUri = "urn:pg-sh-scripts:problem:"
+
+// Problem is the RFC 7807 document of the http error with the service code and the invalid fields as extensions.
+type Problem struct {
+	Type        string                   `json:"type"             example:"urn:pg-sh-scripts:problem:207"`
+	Title       string                   `json:"title"            example:"Not Found"`
+	Status      int                      `json:"status"           example:"404"`
+	Detail      string                   `json:"detail"           example:"The specified bash script does not exists"`
+	Instance    string                   `json:"instance"         example:"/api/v1/bash/59628b82-356c-4745-bc81-187015cde387"`
+	ServiceCode int                      `json:"serviceCode"      example:"207"`
+	Errors      []*validation.FieldError `json:"errors,omitempty"`
+}
+
+func GetProblem(httpErr *HTTPError, instance string) *Problem {
+	return &Problem{
+		Type:        ProblemTypeBaseUri + strconv.Itoa(httpErr.ServiceCode),
+		Title:       http.StatusText(httpErr.HTTPCode),
+		Status:      httpErr.HTTPCode,
+		Detail:      httpErr.Detail,
+		Instance:    instance,
+		ServiceCode: httpErr.ServiceCode,
+		Errors:      httpErr.Errors,
+	}
+}
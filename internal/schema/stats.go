@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"pg-sh-scripts/internal/model"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type BashStats struct {
+	BashId  uuid.UUID                   `json:"bashId"  swaggertype:"primitive,string" example:"59628b82-356c-4745-bc81-187015cde387"`
+	Windows []*model.BashRunWindowStats `json:"windows"`
+}
+
+// StatsOverview is the total stats of all scripts within the window and the stats of every script run
+// within it ordered by the unsuccessful runs, rolledUpAt is null when the stats are aggregated on request.
+type StatsOverview struct {
+	model.BashRunWindowStats
+	RolledUpAt *time.Time                  `json:"rolledUpAt" example:"2024-04-14T15:45:00.000000+00:00"`
+	Scripts    []*model.BashRunScriptStats `json:"scripts"`
+}
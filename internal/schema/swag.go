@@ -4,16 +4,65 @@ import "pg-sh-scripts/internal/model"
 
 type (
 	BashPaginationPage struct {
-		Items  []model.Bash `json:"items"`
-		Limit  int          `json:"limit"`
-		Offset int          `json:"offset"`
-		Total  int          `json:"total"`
+		Items     []model.Bash `json:"items"`
+		Limit     int          `json:"limit"`
+		Offset    int          `json:"offset"`
+		Total     int          `json:"total"`
+		TotalMode string       `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool         `json:"hasMore"`
 	}
 
 	BashLogPaginationPage struct {
-		Items  []model.BashLog `json:"items"`
-		Limit  int             `json:"limit"`
-		Offset int             `json:"offset"`
-		Total  int             `json:"total"`
+		Items     []model.BashLog `json:"items"`
+		Limit     int             `json:"limit"`
+		Offset    int             `json:"offset"`
+		Total     int             `json:"total"`
+		TotalMode string          `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool            `json:"hasMore"`
+	}
+
+	BashRunPaginationPage struct {
+		Items     []model.BashRun `json:"items"`
+		Limit     int             `json:"limit"`
+		Offset    int             `json:"offset"`
+		Total     int             `json:"total"`
+		TotalMode string          `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool            `json:"hasMore"`
+	}
+
+	WebhookPaginationPage struct {
+		Items     []model.Webhook `json:"items"`
+		Limit     int             `json:"limit"`
+		Offset    int             `json:"offset"`
+		Total     int             `json:"total"`
+		TotalMode string          `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool            `json:"hasMore"`
+	}
+
+	WebhookDeliveryPaginationPage struct {
+		Items     []model.WebhookDelivery `json:"items"`
+		Limit     int                     `json:"limit"`
+		Offset    int                     `json:"offset"`
+		Total     int                     `json:"total"`
+		TotalMode string                  `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool                    `json:"hasMore"`
+	}
+
+	ApiKeyPaginationPage struct {
+		Items     []model.ApiKey `json:"items"`
+		Limit     int            `json:"limit"`
+		Offset    int            `json:"offset"`
+		Total     int            `json:"total"`
+		TotalMode string         `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool           `json:"hasMore"`
+	}
+
+	AuditEventPaginationPage struct {
+		Items     []model.AuditEvent `json:"items"`
+		Limit     int                `json:"limit"`
+		Offset    int                `json:"offset"`
+		Total     int                `json:"total"`
+		TotalMode string             `json:"totalMode" enums:"exact,estimate,none"`
+		HasMore   bool               `json:"hasMore"`
 	}
 )
@@ -0,0 +1,18 @@
+package schema
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookPayload struct {
+	Event      string     `json:"event"                example:"run.failure"`
+	BashId     *uuid.UUID `json:"bashId,omitempty"     example:"59628b82-356c-4745-bc81-187015cde387" swaggertype:"primitive,string"`
+	RunId      uuid.UUID  `json:"runId"                example:"7d5b0a4e-1f0c-4b8e-9a51-2c7e4f6a8b90" swaggertype:"primitive,string"`
+	StartedAt  time.Time  `json:"startedAt"            example:"2024-04-14T15:50:21.907561+00:00"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty" example:"2024-04-14T15:50:22.907561+00:00"`
+	DurationMs int64      `json:"durationMs,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	OutputTail []string   `json:"outputTail,omitempty"`
+}
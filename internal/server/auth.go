@@ -0,0 +1,22 @@
+package server
+
+import (
+	"errors"
+	"pg-sh-scripts/internal/config"
+)
+
+// validateAuth returns an error if auth is enabled without the admin key,
+// the server does not start rather than run without a way to create the first api keys.
+// The tokens of sso are not accepted without the issuer and audience to check them against.
+func validateAuth(cfg *config.Config) error {
+	if !cfg.Auth.Enabled {
+		return nil
+	}
+	if cfg.Auth.AdminKey == "" {
+		return errors.New("auth is enabled, but AUTH_ADMIN_KEY is empty")
+	}
+	if cfg.Jwt.Enabled && (cfg.Jwt.Issuer == "" || cfg.Jwt.Audience == "") {
+		return errors.New("jwt is enabled, but JWT_ISSUER or JWT_AUDIENCE is empty")
+	}
+	return nil
+}
@@ -1,18 +1,41 @@
 package server
 
 import (
+	"pg-sh-scripts/internal/api"
 	v1 "pg-sh-scripts/internal/api/v1"
 	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 )
 
 func setV1Handlers(r *gin.Engine, cfg *config.Config) {
-	rg := r.Group(cfg.Api.Prefix)
+	rg := r.Group(cfg.Api.Prefix, api.AuditDenied(usecase.GetAuditUseCase()), getAuthMiddleware(cfg))
 
 	bashV1Handler := v1.GetBashHandler()
 	bashV1Handler.Register(rg)
 
 	bashLogV1Handler := v1.GetBashLogHandler()
 	bashLogV1Handler.Register(rg)
+
+	bashRunV1Handler := v1.GetBashRunHandler()
+	bashRunV1Handler.Register(rg)
+
+	bashAclV1Handler := v1.GetBashAclHandler()
+	bashAclV1Handler.Register(rg)
+
+	bashLogRetentionV1Handler := v1.GetBashLogRetentionHandler()
+	bashLogRetentionV1Handler.Register(rg)
+
+	bashRunStatsV1Handler := v1.GetBashRunStatsHandler()
+	bashRunStatsV1Handler.Register(rg)
+
+	webhookV1Handler := v1.GetWebhookHandler()
+	webhookV1Handler.Register(rg)
+
+	apiKeyV1Handler := v1.GetApiKeyHandler()
+	apiKeyV1Handler.Register(rg)
+
+	auditV1Handler := v1.GetAuditHandler()
+	auditV1Handler.Register(rg)
 }
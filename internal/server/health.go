@@ -0,0 +1,26 @@
+package server
+
+import (
+	"net/http"
+	"pg-sh-scripts/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setHealth exposes the liveness and readiness probes outside of the api prefix,
+// so container healthchecks do not need an api key.
+func setHealth(r *gin.Engine) {
+	healthUseCase := usecase.GetHealthUseCase()
+
+	r.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, healthUseCase.GetLiveness(c.Request.Context()))
+	})
+	r.GET("/readyz", func(c *gin.Context) {
+		health := healthUseCase.GetReadiness(c.Request.Context())
+		if !health.IsUp() {
+			c.JSON(http.StatusServiceUnavailable, health)
+			return
+		}
+		c.JSON(http.StatusOK, health)
+	})
+}
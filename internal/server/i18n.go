@@ -0,0 +1,10 @@
+package server
+
+import (
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/pkg/i18n"
+)
+
+func getErrorCatalog(cfg *config.Config) (*i18n.Catalog, error) {
+	return i18n.NewCatalog(cfg.I18n.DefaultLanguage, config.GetErrorMessages())
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"pg-sh-scripts/internal/common"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/log"
+	"time"
+)
+
+// setJWKSRefresher loads the key set of the token issuer and reloads it periodically
+// until the server shutdown, so rotated keys are picked up without a restart.
+func (s *Server) setJWKSRefresher(cfg *config.Config) {
+	if !cfg.Auth.Enabled || !cfg.Jwt.Enabled {
+		return
+	}
+
+	logger := log.GetLogger()
+	provider := common.GetJWKSProvider()
+
+	refresh := func(ctx context.Context) {
+		if err := provider.Refresh(ctx); err != nil {
+			logger.Error(fmt.Sprintf("Refresh jwks from %s error: %v", cfg.Jwt.JWKSSource, err))
+			return
+		}
+		logger.Debug(fmt.Sprintf("Refreshed jwks from %s", cfg.Jwt.JWKSSource))
+	}
+
+	refresh(s.pruneCtx)
+
+	if cfg.Jwt.RefreshIntervalSeconds <= 0 {
+		return
+	}
+
+	s.pruners.Add(1)
+	go func() {
+		defer s.pruners.Done()
+
+		ticker := time.NewTicker(cfg.Jwt.RefreshIntervalSeconds)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.pruneCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			refresh(s.pruneCtx)
+		}
+	}()
+}
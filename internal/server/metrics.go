@@ -0,0 +1,26 @@
+package server
+
+import (
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/metrics"
+	"pg-sh-scripts/pkg/client/postgres"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// setMetrics exposes the prometheus metrics outside of the api prefix,
+// so they can be scraped without an api key.
+func setMetrics(r *gin.Engine, cfg *config.Config, pool *pgxpool.Pool) error {
+	if !cfg.Metrics.Enabled {
+		return nil
+	}
+
+	m := metrics.GetMetrics()
+	if err := m.Register(postgres.NewPoolStatCollector(pool, metrics.Namespace)); err != nil {
+		return err
+	}
+
+	r.GET(cfg.Metrics.Path, gin.WrapH(m.Handler()))
+	return nil
+}
@@ -3,15 +3,129 @@ package server
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"pg-sh-scripts/internal/api"
 	"pg-sh-scripts/internal/config"
 	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/metrics"
+	"pg-sh-scripts/internal/model"
 	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/usecase"
+	"pg-sh-scripts/pkg/i18n"
+	"pg-sh-scripts/pkg/logging"
+	"pg-sh-scripts/pkg/oidc"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const (
+	requestIdMaxLength = 128
+	unmatchedRoute     = "unmatched"
+	tracerName         = "pg-sh-scripts/internal/server"
+)
+
+var requestIdRegexp = regexp.MustCompile(`^[A-Za-z0-9._:-]+$`)
+
+// getRequestIdMiddleware accepts the X-Request-ID header of the client or generates a new id,
+// the id is returned in the response header and logged with every record of the request.
+func getRequestIdMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId := c.GetHeader(api.RequestIdHeader)
+		if len(requestId) > requestIdMaxLength || !requestIdRegexp.MatchString(requestId) {
+			requestId = uuid.NewV4().String()
+		}
+
+		api.SetRequestId(c, requestId)
+		c.Header(api.RequestIdHeader, requestId)
+		c.Next()
+	}
+}
+
+// getLanguageMiddleware chooses the language of the error details by the Accept-Language header.
+func getLanguageMiddleware(catalog *i18n.Catalog) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		api.SetLanguage(c, catalog)
+		c.Next()
+	}
+}
+
+// getMetricsMiddleware observes the status and latency of every request by its route pattern,
+// requests which do not match any route are counted together.
+func getMetricsMiddleware() gin.HandlerFunc {
+	m := metrics.GetMetrics()
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		m.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// getTracingMiddleware continues the W3C trace context of the client or starts a new trace,
+// the span is named by the route pattern once the route is matched.
+func getTracingMiddleware() gin.HandlerFunc {
+	tracer := otel.Tracer(tracerName)
+
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := tracer.Start(
+			ctx,
+			c.Request.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethod(c.Request.Method),
+				attribute.String(logging.RequestIdKey, api.GetRequestId(c)),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		status := c.Writer.Status()
+
+		span.SetName(c.Request.Method + " " + route)
+		span.SetAttributes(semconv.HTTPRoute(route), semconv.HTTPStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
 func getLogMiddleware() gin.HandlerFunc {
-	return gin.Logger()
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		return fmt.Sprintf(
+			"[GIN] %v | %3d | %13v | %15s | %-7s %#v | %s=%s\n%s",
+			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			param.StatusCode,
+			param.Latency,
+			param.ClientIP,
+			param.Method,
+			param.Path,
+			logging.RequestIdKey,
+			param.Keys[logging.RequestIdKey],
+			param.ErrorMessage,
+		)
+	})
 }
 
 func getRecoveryMiddleware() gin.HandlerFunc {
@@ -21,18 +135,88 @@ func getRecoveryMiddleware() gin.HandlerFunc {
 				var httpErr *schema.HTTPError
 
 				logger := log.GetLogger()
-				logger.Error(fmt.Sprintf("Unknown error: %v", err))
+				logger.ErrorContext(ctx.Request.Context(), fmt.Sprintf("Unknown error: %v", err))
 
 				httpErrors := config.GetHTTPErrors()
 				errors.As(httpErrors.Internal, &httpErr)
 
-				ctx.JSON(httpErr.HTTPCode, httpErr)
+				api.RenderError(ctx, httpErr)
 			}
 		}()
 		ctx.Next()
 	}
 }
 
-func setServeMiddleware(r *gin.Engine) {
-	r.Use(getLogMiddleware(), getRecoveryMiddleware())
+const bearerScheme = "Bearer "
+
+// getAuthMiddleware validates the Authorization: Bearer api key, or the token of the issuer
+// when jwt is enabled, and stores the principal in the request context.
+// When auth is disabled every request acts as admin.
+func getAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
+	if !cfg.Auth.Enabled {
+		return func(c *gin.Context) {
+			api.SetPrincipal(c, &model.Principal{Name: "anonymous", Roles: []string{model.RoleAdmin}})
+			c.Next()
+		}
+	}
+
+	apiKeyUseCase := usecase.GetApiKeyUseCase()
+	var tokenUseCase usecase.ITokenUseCase
+	if cfg.Jwt.Enabled {
+		tokenUseCase = usecase.GetTokenUseCase()
+	}
+	httpErrors := config.GetHTTPErrors()
+
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if len(header) < len(bearerScheme) || !strings.EqualFold(header[:len(bearerScheme)], bearerScheme) {
+			api.AbortWithError(c, httpErrors.ApiKeyUnauthorized)
+			return
+		}
+		credentials := strings.TrimSpace(header[len(bearerScheme):])
+
+		if tokenUseCase != nil && oidc.IsToken(credentials) {
+			principal, err := tokenUseCase.Authenticate(c.Request.Context(), credentials)
+			if err != nil {
+				api.AbortWithError(c, err)
+				return
+			}
+
+			api.SetPrincipal(c, principal)
+			c.Next()
+			return
+		}
+
+		apiKey, err := apiKeyUseCase.Authenticate(c.Request.Context(), credentials)
+		if err != nil {
+			api.AbortWithError(c, err)
+			return
+		}
+
+		api.SetPrincipal(c, model.GetApiKeyPrincipal(apiKey))
+		c.Next()
+	}
+}
+
+// getHandlerMiddleware tracks the running handlers,
+// so the database is not closed on shutdown while they still use it.
+func (s *Server) getHandlerMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s.handlers.Add(1)
+		defer s.handlers.Done()
+
+		c.Next()
+	}
+}
+
+func (s *Server) setServeMiddleware(r *gin.Engine, catalog *i18n.Catalog) {
+	r.Use(
+		s.getHandlerMiddleware(),
+		getRequestIdMiddleware(),
+		getLanguageMiddleware(catalog),
+		getTracingMiddleware(),
+		getMetricsMiddleware(),
+		getLogMiddleware(),
+		getRecoveryMiddleware(),
+	)
 }
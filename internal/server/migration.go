@@ -3,6 +3,7 @@ package server
 import (
 	"database/sql"
 	"fmt"
+	"pg-sh-scripts/internal/db"
 	"pg-sh-scripts/internal/log"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -10,25 +11,22 @@ import (
 	"github.com/pressly/goose/v3"
 )
 
-const (
-	migrationDir       = "migration"
-	pgMigrationDialect = "postgres"
-)
+const pgMigrationDialect = "postgres"
 
 func setMigration(pool *pgxpool.Pool) error {
 	if err := goose.SetDialect(pgMigrationDialect); err != nil {
 		return err
 	}
 
-	db := stdlib.OpenDBFromPool(pool)
-	defer func(db *sql.DB) {
-		if err := db.Close(); err != nil {
+	sqlDB := stdlib.OpenDBFromPool(pool)
+	defer func(sqlDB *sql.DB) {
+		if err := sqlDB.Close(); err != nil {
 			logger := log.GetLogger()
 			logger.Error(fmt.Sprintf("Close migration db error: %v", err))
 		}
-	}(db)
+	}(sqlDB)
 
-	if err := goose.Up(db, migrationDir); err != nil {
+	if err := goose.Up(sqlDB, db.MigrationDir); err != nil {
 		return err
 	}
 
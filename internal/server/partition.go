@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/service"
+)
+
+// setPartitionMaintenance creates the upcoming monthly bash log partitions and removes the expired ones
+// on the start and then periodically until the server shutdown, the logs of a month without
+// a partition are kept by the default partition until the partition of the month is created.
+func (s *Server) setPartitionMaintenance(cfg *config.Config) {
+	if cfg.Partition.MaintenanceIntervalSeconds <= 0 {
+		return
+	}
+
+	logger := log.GetLogger()
+	bashLogPartitionService := service.GetBashLogPartitionService()
+
+	maintain := func(ctx context.Context) (int64, error) {
+		return bashLogPartitionService.Maintain(
+			ctx,
+			cfg.Partition.PremakeMonths,
+			cfg.Partition.RetentionMonths,
+			cfg.Partition.Detach,
+		)
+	}
+
+	removedCount, err := maintain(s.pruneCtx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Prune bash log partitions error: %v", err))
+	}
+	if removedCount > 0 {
+		logger.Info(fmt.Sprintf("Pruned bash log partitions: %d", removedCount))
+	}
+
+	s.runPruner("bash log partitions", cfg.Partition.MaintenanceIntervalSeconds, maintain)
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/service"
+	"time"
+)
+
+// runPruner periodically calls the prune function until the server shutdown.
+func (s *Server) runPruner(name string, pruneInterval time.Duration, prune func(ctx context.Context) (int64, error)) {
+	if pruneInterval <= 0 {
+		return
+	}
+
+	s.pruners.Add(1)
+	go func() {
+		defer s.pruners.Done()
+
+		logger := log.GetLogger()
+
+		ticker := time.NewTicker(pruneInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.pruneCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			removedCount, err := prune(s.pruneCtx)
+			if err != nil {
+				logger.Error(fmt.Sprintf("Prune %s error: %v", name, err))
+			}
+			if removedCount > 0 {
+				logger.Info(fmt.Sprintf("Pruned %s: %d", name, removedCount))
+			}
+		}
+	}()
+}
+
+// setPruners removes expired inline bash runs, their logs are removed by the cascade constraint,
+// expired idempotency keys and bash logs outside the retention.
+func (s *Server) setPruners(cfg *config.Config) {
+	s.runPruner(
+		"inline bash runs",
+		cfg.Inline.PruneIntervalSeconds,
+		service.GetBashRunService().RemoveExpired,
+	)
+	s.runPruner(
+		"idempotency keys",
+		cfg.Idempotency.PruneIntervalSeconds,
+		service.GetIdempotencyKeyService().RemoveExpired,
+	)
+
+	bashLogRetentionPolicy := dto.GetBashLogRetentionPolicy(cfg.Retention)
+	bashLogRetentionService := service.GetBashLogRetentionService()
+	s.runPruner(
+		"bash logs",
+		cfg.Retention.PruneIntervalSeconds,
+		func(ctx context.Context) (int64, error) {
+			return bashLogRetentionService.Prune(ctx, bashLogRetentionPolicy, cfg.Retention.BatchSize)
+		},
+	)
+}
@@ -1,10 +1,17 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"pg-sh-scripts/internal/common"
 	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/log"
+	"sync"
 
 	"github.com/gin-gonic/gin"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type (
@@ -13,7 +20,14 @@ type (
 		Shutdown() error
 	}
 
-	Server struct{}
+	Server struct {
+		httpServer     *http.Server
+		pruneCtx       context.Context
+		pruneCancel    context.CancelFunc
+		pruners        sync.WaitGroup
+		handlers       sync.WaitGroup
+		tracerProvider *sdktrace.TracerProvider
+	}
 )
 
 func getServer() *gin.Engine {
@@ -41,16 +55,38 @@ func setServerProxies(r *gin.Engine, cfg *config.Config) error {
 	return nil
 }
 
-func runServer(r *gin.Engine, cfg *config.Config) error {
-	if err := r.Run(":" + cfg.Server.Port); err != nil {
+func runServer(httpServer *http.Server) error {
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("cannot run main router: %w", err)
 	}
 	return nil
 }
 
+// waitGroup blocks until the wait group is done or the context is done.
+func waitGroup(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (s *Server) Run() error {
 	cfg := config.GetConfig()
 
+	if err := validateAuth(cfg); err != nil {
+		return err
+	}
+	if err := s.setTracing(cfg); err != nil {
+		return err
+	}
 	pgClient, err := setPgConn()
 	if err != nil {
 		return err
@@ -58,33 +94,98 @@ func (s *Server) Run() error {
 	if err := setMigration(pgClient.GetDB()); err != nil {
 		return err
 	}
+	s.setPruners(cfg)
+	s.setPartitionMaintenance(cfg)
+	s.setStatsRollup(cfg)
+	s.setJWKSRefresher(cfg)
 
 	setServerMode(cfg)
 
+	catalog, err := getErrorCatalog(cfg)
+	if err != nil {
+		return err
+	}
+
 	r := getServer()
 
-	setServeMiddleware(r)
+	s.setServeMiddleware(r, catalog)
 	if err := setServerProxies(r, cfg); err != nil {
 		return err
 	}
 
 	setSwagger(r)
+	setHealth(r)
+	if err := setMetrics(r, cfg, pgClient.GetDB()); err != nil {
+		return err
+	}
 	setV1Handlers(r, cfg)
 
-	if err := runServer(r, cfg); err != nil {
+	s.httpServer.Addr = ":" + cfg.Server.Port
+	s.httpServer.Handler = r
+
+	if err := runServer(s.httpServer); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// Shutdown stops accepting requests and waits for the running bash scripts
+// up to the shutdown timeout, the rest of them are interrupted and waited
+// up to the interrupt timeout together with their handlers,
+// so their runs are marked before the database is closed. The webhook deliveries
+// are waited up to their own timeout after that, since the finished runs notify them.
 func (s *Server) Shutdown() error {
+	cfg := config.GetConfig()
+	logger := log.GetLogger()
+	executionGroup := common.GetExecutionGroup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeoutSeconds)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		logger.Error(fmt.Sprintf("Shutdown main router error: %v", err))
+
+		interruptCtx, interruptCancel := context.WithTimeout(context.Background(), cfg.Server.InterruptTimeoutSeconds)
+		defer interruptCancel()
+
+		executionGroup.Interrupt()
+		if err := executionGroup.Wait(interruptCtx); err != nil {
+			logger.Error(fmt.Sprintf("Wait interrupted bash scripts error: %v", err))
+		}
+		if err := s.httpServer.Close(); err != nil {
+			logger.Error(fmt.Sprintf("Close main router error: %v", err))
+		}
+		if err := waitGroup(interruptCtx, &s.handlers); err != nil {
+			logger.Error(fmt.Sprintf("Wait handlers error: %v", err))
+		}
+	}
+
+	s.pruneCancel()
+	s.pruners.Wait()
+
+	deliveryCtx, deliveryCancel := context.WithTimeout(context.Background(), cfg.Webhook.ShutdownTimeoutSeconds)
+	defer deliveryCancel()
+
+	if err := common.GetWebhookDeliveryGroup().Close(deliveryCtx); err != nil {
+		logger.Error(fmt.Sprintf("Wait webhook deliveries error: %v", err))
+	}
+
 	if err := closePgConn(); err != nil {
 		return err
 	}
+	if err := s.shutdownTracing(cfg); err != nil {
+		logger.Error(fmt.Sprintf("Shutdown tracing error: %v", err))
+	}
 	return nil
 }
 
 func GetServer() IServer {
-	return &Server{}
+	pruneCtx, pruneCancel := context.WithCancel(context.Background())
+
+	return &Server{
+		httpServer:  &http.Server{},
+		pruneCtx:    pruneCtx,
+		pruneCancel: pruneCancel,
+	}
 }
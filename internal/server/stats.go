@@ -0,0 +1,49 @@
+package server
+
+import (
+	"fmt"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/log"
+	"pg-sh-scripts/internal/service"
+	"time"
+)
+
+// setStatsRollup rolls up the bash run stats of the configured windows on the start
+// and then periodically until the server shutdown, the stats overview is read from the rollup.
+func (s *Server) setStatsRollup(cfg *config.Config) {
+	if cfg.Stats.RollupIntervalSeconds <= 0 {
+		return
+	}
+
+	logger := log.GetLogger()
+	bashRunStatsService := service.GetBashRunStatsService()
+
+	rollup := func() {
+		rowCount, err := bashRunStatsService.Rollup(s.pruneCtx, cfg.Stats.Windows)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Roll up bash run stats error: %v", err))
+			return
+		}
+		logger.Debug(fmt.Sprintf("Rolled up bash run stats: %d", rowCount))
+	}
+
+	rollup()
+
+	s.pruners.Add(1)
+	go func() {
+		defer s.pruners.Done()
+
+		ticker := time.NewTicker(cfg.Stats.RollupIntervalSeconds)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.pruneCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			rollup()
+		}
+	}()
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/pkg/tracing"
+
+	"go.opentelemetry.io/otel"
+)
+
+// setTracing registers the global tracer provider before the postgres pool is created,
+// so the spans of queries are exported as well. With tracing disabled the spans are no-op.
+func (s *Server) setTracing(cfg *config.Config) error {
+	otel.SetTextMapPropagator(tracing.GetPropagator())
+
+	if !cfg.Tracing.Enabled {
+		return nil
+	}
+
+	tracerProvider, err := tracing.NewTracerProvider(context.Background(), tracing.Config{
+		ServiceName: cfg.Tracing.ServiceName,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return err
+	}
+
+	otel.SetTracerProvider(tracerProvider)
+	s.tracerProvider = tracerProvider
+	return nil
+}
+
+// shutdownTracing exports the buffered spans, it has its own timeout
+// since the shutdown timeout may be already spent on the running scripts.
+func (s *Server) shutdownTracing(cfg *config.Config) error {
+	if s.tracerProvider == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Tracing.ShutdownTimeoutSeconds)
+	defer cancel()
+
+	return s.tracerProvider.Shutdown(ctx)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./apikey.go  -destination=./mock/apikey.go
+
+type (
+	IApiKeyService interface {
+		GetOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+		GetOneByPrefix(ctx context.Context, prefix string) (*model.ApiKey, error)
+		GetPaginationPage(
+			ctx context.Context,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.ApiKeyLimitOffsetPage, error)
+		Create(ctx context.Context, dto dto.SaveApiKey) (*model.ApiKey, error)
+		RevokeById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+		ExpireById(ctx context.Context, id uuid.UUID, dto dto.ExpireApiKey) (*model.ApiKey, error)
+		UpdateLastUsedAtById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+	}
+
+	ApiKeyService struct {
+		repository repo.IApiKeyRepository
+	}
+)
+
+func (s *ApiKeyService) GetOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	apiKey, err := s.repository.GetOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (s *ApiKeyService) GetOneByPrefix(ctx context.Context, prefix string) (*model.ApiKey, error) {
+	apiKey, err := s.repository.GetOneByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (s *ApiKeyService) GetPaginationPage(
+	ctx context.Context,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.ApiKeyLimitOffsetPage, error) {
+	apiKeyPaginationPage, err := s.repository.GetPaginationPage(ctx, paginationParams)
+	if err != nil {
+		return apiKeyPaginationPage, err
+	}
+	return apiKeyPaginationPage, nil
+}
+
+func (s *ApiKeyService) Create(ctx context.Context, dto dto.SaveApiKey) (*model.ApiKey, error) {
+	apiKey, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (s *ApiKeyService) RevokeById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	apiKey, err := s.repository.RevokeById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (s *ApiKeyService) ExpireById(
+	ctx context.Context,
+	id uuid.UUID,
+	dto dto.ExpireApiKey,
+) (*model.ApiKey, error) {
+	apiKey, err := s.repository.ExpireById(ctx, id, dto)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (s *ApiKeyService) UpdateLastUsedAtById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	apiKey, err := s.repository.UpdateLastUsedAtById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func GetApiKeyService() IApiKeyService {
+	return &ApiKeyService{
+		repository: repo.GetPgApiKeyRepository(),
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+)
+
+//go:generate mockgen -source=./audit.go  -destination=./mock/audit.go
+
+type (
+	IAuditService interface {
+		GetPaginationPage(
+			ctx context.Context,
+			filter dto.AuditEventFilter,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.AuditEventLimitOffsetPage, error)
+		Export(ctx context.Context, filter dto.AuditEventFilter, fn func(auditEvent *model.AuditEvent) error) error
+		Create(ctx context.Context, dto dto.CreateAuditEvent) (*model.AuditEvent, error)
+	}
+
+	AuditService struct {
+		repository repo.IAuditRepository
+	}
+)
+
+func (s *AuditService) GetPaginationPage(
+	ctx context.Context,
+	filter dto.AuditEventFilter,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.AuditEventLimitOffsetPage, error) {
+	auditEventPaginationPage, err := s.repository.GetPaginationPage(
+		ctx,
+		filter,
+		paginationParams,
+	)
+	if err != nil {
+		return auditEventPaginationPage, err
+	}
+	return auditEventPaginationPage, nil
+}
+
+func (s *AuditService) Export(
+	ctx context.Context,
+	filter dto.AuditEventFilter,
+	fn func(auditEvent *model.AuditEvent) error,
+) error {
+	if err := s.repository.Export(ctx, filter, fn); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AuditService) Create(ctx context.Context, dto dto.CreateAuditEvent) (*model.AuditEvent, error) {
+	auditEvent, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return auditEvent, nil
+}
+
+func GetAuditService() IAuditService {
+	return &AuditService{
+		repository: repo.GetPgAuditRepository(),
+	}
+}
@@ -18,8 +18,14 @@ type (
 		GetOneById(ctx context.Context, id uuid.UUID) (*model.Bash, error)
 		GetPaginationPage(
 			ctx context.Context,
+			filter dto.BashFilter,
 			paginationParams pagination.LimitOffsetParams,
 		) (alias.BashLimitOffsetPage, error)
+		GetKeysetPage(
+			ctx context.Context,
+			filter dto.BashFilter,
+			paginationParams pagination.KeysetParams,
+		) (alias.BashKeysetPage, error)
 		Create(ctx context.Context, dto dto.CreateBash) (*model.Bash, error)
 		RemoveById(ctx context.Context, id uuid.UUID) (*model.Bash, error)
 	}
@@ -30,6 +36,9 @@ type (
 )
 
 func (s *BashService) GetOneById(ctx context.Context, id uuid.UUID) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "BashService.GetOneById")
+	defer span.End()
+
 	bash, err := s.repository.GetOneById(ctx, id)
 	if err != nil {
 		return nil, err
@@ -39,16 +48,38 @@ func (s *BashService) GetOneById(ctx context.Context, id uuid.UUID) (*model.Bash
 
 func (s *BashService) GetPaginationPage(
 	ctx context.Context,
+	filter dto.BashFilter,
 	paginationParams pagination.LimitOffsetParams,
 ) (alias.BashLimitOffsetPage, error) {
-	bashPaginationPage, err := s.repository.GetPaginationPage(ctx, paginationParams)
+	ctx, span := tracer.Start(ctx, "BashService.GetPaginationPage")
+	defer span.End()
+
+	bashPaginationPage, err := s.repository.GetPaginationPage(ctx, filter, paginationParams)
 	if err != nil {
 		return bashPaginationPage, err
 	}
 	return bashPaginationPage, nil
 }
 
+func (s *BashService) GetKeysetPage(
+	ctx context.Context,
+	filter dto.BashFilter,
+	paginationParams pagination.KeysetParams,
+) (alias.BashKeysetPage, error) {
+	ctx, span := tracer.Start(ctx, "BashService.GetKeysetPage")
+	defer span.End()
+
+	bashKeysetPage, err := s.repository.GetKeysetPage(ctx, filter, paginationParams)
+	if err != nil {
+		return bashKeysetPage, err
+	}
+	return bashKeysetPage, nil
+}
+
 func (s *BashService) Create(ctx context.Context, dto dto.CreateBash) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "BashService.Create")
+	defer span.End()
+
 	bash, err := s.repository.Create(ctx, dto)
 	if err != nil {
 		return nil, err
@@ -57,6 +88,9 @@ func (s *BashService) Create(ctx context.Context, dto dto.CreateBash) (*model.Ba
 }
 
 func (s *BashService) RemoveById(ctx context.Context, id uuid.UUID) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "BashService.RemoveById")
+	defer span.End()
+
 	bash, err := s.repository.RemoveById(ctx, id)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashacl.go  -destination=./mock/bashacl.go
+
+type (
+	IBashAclService interface {
+		GetOneById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error)
+		GetListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error)
+		Create(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error)
+		RemoveById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error)
+	}
+
+	BashAclService struct {
+		repository repo.IBashAclRepository
+	}
+)
+
+func (s *BashAclService) GetOneById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error) {
+	bashAcl, err := s.repository.GetOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return bashAcl, nil
+}
+
+func (s *BashAclService) GetListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error) {
+	bashAclList, err := s.repository.GetListByBashId(ctx, bashId)
+	if err != nil {
+		return nil, err
+	}
+	return bashAclList, nil
+}
+
+func (s *BashAclService) Create(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error) {
+	bashAcl, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return bashAcl, nil
+}
+
+func (s *BashAclService) RemoveById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error) {
+	bashAcl, err := s.repository.RemoveById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return bashAcl, nil
+}
+
+func GetBashAclService() IBashAclService {
+	return &BashAclService{
+		repository: repo.GetPgBashAclRepository(),
+	}
+}
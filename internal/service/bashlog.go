@@ -18,8 +18,31 @@ type (
 		GetPaginationPageByBashId(
 			ctx context.Context,
 			bashId uuid.UUID,
+			filter dto.BashLogFilter,
 			paginationParams pagination.LimitOffsetParams,
 		) (alias.BashLogLimitOffsetPage, error)
+		GetPaginationPageByRunId(
+			ctx context.Context,
+			runId uuid.UUID,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.BashLogLimitOffsetPage, error)
+		GetKeysetPageByBashId(
+			ctx context.Context,
+			bashId uuid.UUID,
+			filter dto.BashLogFilter,
+			paginationParams pagination.KeysetParams,
+		) (alias.BashLogKeysetPage, error)
+		GetKeysetPageByRunId(
+			ctx context.Context,
+			runId uuid.UUID,
+			paginationParams pagination.KeysetParams,
+		) (alias.BashLogKeysetPage, error)
+		ExportByBashId(
+			ctx context.Context,
+			bashId uuid.UUID,
+			filter dto.BashLogFilter,
+			fn func(bashLog *model.BashLog) error,
+		) error
 		Create(ctx context.Context, dto dto.CreateBashLog) (*model.BashLog, error)
 	}
 
@@ -31,11 +54,29 @@ type (
 func (s *BashLogService) GetPaginationPageByBashId(
 	ctx context.Context,
 	bashId uuid.UUID,
+	filter dto.BashLogFilter,
 	paginationParams pagination.LimitOffsetParams,
 ) (alias.BashLogLimitOffsetPage, error) {
 	bashLogPaginationPage, err := s.repository.GetPaginationPageByBashId(
 		ctx,
 		bashId,
+		filter,
+		paginationParams,
+	)
+	if err != nil {
+		return bashLogPaginationPage, err
+	}
+	return bashLogPaginationPage, nil
+}
+
+func (s *BashLogService) GetPaginationPageByRunId(
+	ctx context.Context,
+	runId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.BashLogLimitOffsetPage, error) {
+	bashLogPaginationPage, err := s.repository.GetPaginationPageByRunId(
+		ctx,
+		runId,
 		paginationParams,
 	)
 	if err != nil {
@@ -44,6 +85,52 @@ func (s *BashLogService) GetPaginationPageByBashId(
 	return bashLogPaginationPage, nil
 }
 
+func (s *BashLogService) GetKeysetPageByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	filter dto.BashLogFilter,
+	paginationParams pagination.KeysetParams,
+) (alias.BashLogKeysetPage, error) {
+	bashLogKeysetPage, err := s.repository.GetKeysetPageByBashId(
+		ctx,
+		bashId,
+		filter,
+		paginationParams,
+	)
+	if err != nil {
+		return bashLogKeysetPage, err
+	}
+	return bashLogKeysetPage, nil
+}
+
+func (s *BashLogService) GetKeysetPageByRunId(
+	ctx context.Context,
+	runId uuid.UUID,
+	paginationParams pagination.KeysetParams,
+) (alias.BashLogKeysetPage, error) {
+	bashLogKeysetPage, err := s.repository.GetKeysetPageByRunId(
+		ctx,
+		runId,
+		paginationParams,
+	)
+	if err != nil {
+		return bashLogKeysetPage, err
+	}
+	return bashLogKeysetPage, nil
+}
+
+func (s *BashLogService) ExportByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	filter dto.BashLogFilter,
+	fn func(bashLog *model.BashLog) error,
+) error {
+	if err := s.repository.ExportByBashId(ctx, bashId, filter, fn); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *BashLogService) Create(
 	ctx context.Context,
 	dto dto.CreateBashLog,
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/pkg/sql/partition"
+	"slices"
+	"time"
+)
+
+//go:generate mockgen -source=./bashlogpartition.go  -destination=./mock/bashlogpartition.go
+
+const bashLogTable = "bash_log"
+
+type (
+	IBashLogPartitionService interface {
+		Maintain(ctx context.Context, premakeMonths int, retentionMonths int, detach bool) (int64, error)
+	}
+
+	BashLogPartitionService struct {
+		repository repo.IBashLogPartitionRepository
+	}
+)
+
+// Maintain creates the missing partitions of the current month and the next premakeMonths months
+// and removes the partitions older than retentionMonths months, it returns the count of removed partitions.
+// The months are UTC like the creation time of the logs, a failed partition does not stop the others
+// and the errors are returned together.
+func (s *BashLogPartitionService) Maintain(
+	ctx context.Context,
+	premakeMonths int,
+	retentionMonths int,
+	detach bool,
+) (int64, error) {
+	names, err := s.repository.GetNameList(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	now := time.Now().UTC()
+	for _, monthRange := range partition.GetPremakeRanges(bashLogTable, now, premakeMonths) {
+		if slices.Contains(names, monthRange.Name) {
+			continue
+		}
+		if err := s.repository.Create(ctx, monthRange); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	var removedCount int64
+	for _, monthRange := range partition.GetExpiredRanges(bashLogTable, names, now, retentionMonths) {
+		if err := s.repository.Remove(ctx, monthRange.Name, detach); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		removedCount++
+	}
+	return removedCount, errors.Join(errs...)
+}
+
+func GetBashLogPartitionService() IBashLogPartitionService {
+	return &BashLogPartitionService{
+		repository: repo.GetPgBashLogPartitionRepository(),
+	}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashlogretention.go  -destination=./mock/bashlogretention.go
+
+type (
+	IBashLogRetentionService interface {
+		GetOneByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error)
+		Set(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error)
+		RemoveByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error)
+		CountPrunable(ctx context.Context, policy dto.BashLogRetentionPolicy) (int64, error)
+		Prune(ctx context.Context, policy dto.BashLogRetentionPolicy, batchSize int) (int64, error)
+	}
+
+	BashLogRetentionService struct {
+		repository repo.IBashLogRetentionRepository
+	}
+)
+
+func (s *BashLogRetentionService) GetOneByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	bashLogRetention, err := s.repository.GetOneByBashId(ctx, bashId)
+	if err != nil {
+		return nil, err
+	}
+	return bashLogRetention, nil
+}
+
+func (s *BashLogRetentionService) Set(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error) {
+	bashLogRetention, err := s.repository.Set(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return bashLogRetention, nil
+}
+
+func (s *BashLogRetentionService) RemoveByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	bashLogRetention, err := s.repository.RemoveByBashId(ctx, bashId)
+	if err != nil {
+		return nil, err
+	}
+	return bashLogRetention, nil
+}
+
+func (s *BashLogRetentionService) CountPrunable(ctx context.Context, policy dto.BashLogRetentionPolicy) (int64, error) {
+	prunableCount, err := s.repository.CountPrunable(ctx, policy)
+	if err != nil {
+		return 0, err
+	}
+	return prunableCount, nil
+}
+
+func (s *BashLogRetentionService) Prune(
+	ctx context.Context,
+	policy dto.BashLogRetentionPolicy,
+	batchSize int,
+) (int64, error) {
+	removedCount, err := s.repository.Prune(ctx, policy, batchSize)
+	if err != nil {
+		return removedCount, err
+	}
+	return removedCount, nil
+}
+
+func GetBashLogRetentionService() IBashLogRetentionService {
+	return &BashLogRetentionService{
+		repository: repo.GetPgBashLogRetentionRepository(),
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashrun.go  -destination=./mock/bashrun.go
+
+type (
+	IBashRunService interface {
+		GetOneById(ctx context.Context, id uuid.UUID) (*model.BashRun, error)
+		GetPaginationPageByBashId(
+			ctx context.Context,
+			bashId uuid.UUID,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.BashRunLimitOffsetPage, error)
+		Create(ctx context.Context, dto dto.CreateBashRun) (*model.BashRun, error)
+		FinishById(ctx context.Context, id uuid.UUID, status string, redactionCount int64) (*model.BashRun, error)
+		RemoveExpired(ctx context.Context) (int64, error)
+	}
+
+	BashRunService struct {
+		repository repo.IBashRunRepository
+	}
+)
+
+func (s *BashRunService) GetOneById(ctx context.Context, id uuid.UUID) (*model.BashRun, error) {
+	bashRun, err := s.repository.GetOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return bashRun, nil
+}
+
+func (s *BashRunService) GetPaginationPageByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.BashRunLimitOffsetPage, error) {
+	bashRunPaginationPage, err := s.repository.GetPaginationPageByBashId(
+		ctx,
+		bashId,
+		paginationParams,
+	)
+	if err != nil {
+		return bashRunPaginationPage, err
+	}
+	return bashRunPaginationPage, nil
+}
+
+func (s *BashRunService) Create(ctx context.Context, dto dto.CreateBashRun) (*model.BashRun, error) {
+	bashRun, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return bashRun, nil
+}
+
+func (s *BashRunService) FinishById(
+	ctx context.Context,
+	id uuid.UUID,
+	status string,
+	redactionCount int64,
+) (*model.BashRun, error) {
+	bashRun, err := s.repository.FinishById(ctx, id, status, redactionCount)
+	if err != nil {
+		return nil, err
+	}
+	return bashRun, nil
+}
+
+func (s *BashRunService) RemoveExpired(ctx context.Context) (int64, error) {
+	removedCount, err := s.repository.RemoveExpired(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return removedCount, nil
+}
+
+func GetBashRunService() IBashRunService {
+	return &BashRunService{
+		repository: repo.GetPgBashRunRepository(),
+	}
+}
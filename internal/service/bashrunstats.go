@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/pkg/stats"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashrunstats.go  -destination=./mock/bashrunstats.go
+
+type (
+	IBashRunStatsService interface {
+		GetWindowListByBashId(ctx context.Context, bashId uuid.UUID, windows []stats.Window) ([]*model.BashRunWindowStats, error)
+		GetTotal(ctx context.Context, window stats.Window) (*model.BashRunWindowStats, error)
+		GetScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error)
+		GetRolledUpTotal(ctx context.Context, window stats.Window) (*model.BashRunStatsRollup, error)
+		GetRolledUpScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error)
+		Rollup(ctx context.Context, windows []stats.Window) (int64, error)
+	}
+
+	BashRunStatsService struct {
+		repository repo.IBashRunStatsRepository
+	}
+)
+
+func (s *BashRunStatsService) GetWindowListByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	windows []stats.Window,
+) ([]*model.BashRunWindowStats, error) {
+	bashRunWindowStatsList, err := s.repository.GetWindowListByBashId(ctx, bashId, windows)
+	if err != nil {
+		return nil, err
+	}
+	return bashRunWindowStatsList, nil
+}
+
+func (s *BashRunStatsService) GetTotal(ctx context.Context, window stats.Window) (*model.BashRunWindowStats, error) {
+	bashRunWindowStats, err := s.repository.GetTotal(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	return bashRunWindowStats, nil
+}
+
+func (s *BashRunStatsService) GetScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error) {
+	bashRunScriptStatsList, err := s.repository.GetScriptList(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	return bashRunScriptStatsList, nil
+}
+
+func (s *BashRunStatsService) GetRolledUpTotal(ctx context.Context, window stats.Window) (*model.BashRunStatsRollup, error) {
+	bashRunStatsRollup, err := s.repository.GetRolledUpTotal(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	return bashRunStatsRollup, nil
+}
+
+func (s *BashRunStatsService) GetRolledUpScriptList(
+	ctx context.Context,
+	window stats.Window,
+) ([]*model.BashRunScriptStats, error) {
+	bashRunScriptStatsList, err := s.repository.GetRolledUpScriptList(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	return bashRunScriptStatsList, nil
+}
+
+func (s *BashRunStatsService) Rollup(ctx context.Context, windows []stats.Window) (int64, error) {
+	rowCount, err := s.repository.Rollup(ctx, windows)
+	if err != nil {
+		return 0, err
+	}
+	return rowCount, nil
+}
+
+func GetBashRunStatsService() IBashRunStatsService {
+	return &BashRunStatsService{
+		repository: repo.GetPgBashRunStatsRepository(),
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+)
+
+//go:generate mockgen -source=./health.go  -destination=./mock/health.go
+
+type (
+	IHealthService interface {
+		Ping(ctx context.Context) error
+		GetMigrationStatus(ctx context.Context) (*model.MigrationStatus, error)
+	}
+
+	HealthService struct {
+		repository repo.IHealthRepository
+	}
+)
+
+func (s *HealthService) Ping(ctx context.Context) error {
+	return s.repository.Ping(ctx)
+}
+
+func (s *HealthService) GetMigrationStatus(ctx context.Context) (*model.MigrationStatus, error) {
+	migrationStatus, err := s.repository.GetMigrationStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return migrationStatus, nil
+}
+
+func GetHealthService() IHealthService {
+	return &HealthService{
+		repository: repo.GetPgHealthRepository(),
+	}
+}
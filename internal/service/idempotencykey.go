@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+)
+
+//go:generate mockgen -source=./idempotencykey.go  -destination=./mock/idempotencykey.go
+
+type (
+	IIdempotencyKeyService interface {
+		GetOneByKey(ctx context.Context, principalId string, key string) (*model.IdempotencyKey, error)
+		Create(ctx context.Context, dto dto.CreateIdempotencyKey) (*model.IdempotencyKey, error)
+		SetResponseByKey(
+			ctx context.Context,
+			principalId string,
+			key string,
+			response json.RawMessage,
+		) (*model.IdempotencyKey, error)
+		RemoveByKey(ctx context.Context, principalId string, key string) (*model.IdempotencyKey, error)
+		RemoveExpired(ctx context.Context) (int64, error)
+	}
+
+	IdempotencyKeyService struct {
+		repository repo.IIdempotencyKeyRepository
+	}
+)
+
+func (s *IdempotencyKeyService) GetOneByKey(
+	ctx context.Context,
+	principalId string,
+	key string,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey, err := s.repository.GetOneByKey(ctx, principalId, key)
+	if err != nil {
+		return nil, err
+	}
+	return idempotencyKey, nil
+}
+
+func (s *IdempotencyKeyService) Create(
+	ctx context.Context,
+	dto dto.CreateIdempotencyKey,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return idempotencyKey, nil
+}
+
+func (s *IdempotencyKeyService) SetResponseByKey(
+	ctx context.Context,
+	principalId string,
+	key string,
+	response json.RawMessage,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey, err := s.repository.SetResponseByKey(ctx, principalId, key, response)
+	if err != nil {
+		return nil, err
+	}
+	return idempotencyKey, nil
+}
+
+func (s *IdempotencyKeyService) RemoveByKey(
+	ctx context.Context,
+	principalId string,
+	key string,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey, err := s.repository.RemoveByKey(ctx, principalId, key)
+	if err != nil {
+		return nil, err
+	}
+	return idempotencyKey, nil
+}
+
+func (s *IdempotencyKeyService) RemoveExpired(ctx context.Context) (int64, error) {
+	removedCount, err := s.repository.RemoveExpired(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return removedCount, nil
+}
+
+func GetIdempotencyKeyService() IIdempotencyKeyService {
+	return &IdempotencyKeyService{
+		repository: repo.GetPgIdempotencyKeyRepository(),
+	}
+}
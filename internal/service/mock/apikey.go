@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apikey.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIApiKeyService is a mock of IApiKeyService interface.
+type MockIApiKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyServiceMockRecorder
+}
+
+// MockIApiKeyServiceMockRecorder is the mock recorder for MockIApiKeyService.
+type MockIApiKeyServiceMockRecorder struct {
+	mock *MockIApiKeyService
+}
+
+// NewMockIApiKeyService creates a new mock instance.
+func NewMockIApiKeyService(ctrl *gomock.Controller) *MockIApiKeyService {
+	mock := &MockIApiKeyService{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKeyService) EXPECT() *MockIApiKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIApiKeyService) Create(ctx context.Context, dto dto.SaveApiKey) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIApiKeyServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIApiKeyService)(nil).Create), ctx, dto)
+}
+
+// ExpireById mocks base method.
+func (m *MockIApiKeyService) ExpireById(ctx context.Context, id uuid.UUID, dto dto.ExpireApiKey) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireById", ctx, id, dto)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireById indicates an expected call of ExpireById.
+func (mr *MockIApiKeyServiceMockRecorder) ExpireById(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireById", reflect.TypeOf((*MockIApiKeyService)(nil).ExpireById), ctx, id, dto)
+}
+
+// GetOneById mocks base method.
+func (m *MockIApiKeyService) GetOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneById", ctx, id)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneById indicates an expected call of GetOneById.
+func (mr *MockIApiKeyServiceMockRecorder) GetOneById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneById", reflect.TypeOf((*MockIApiKeyService)(nil).GetOneById), ctx, id)
+}
+
+// GetOneByPrefix mocks base method.
+func (m *MockIApiKeyService) GetOneByPrefix(ctx context.Context, prefix string) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByPrefix", ctx, prefix)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByPrefix indicates an expected call of GetOneByPrefix.
+func (mr *MockIApiKeyServiceMockRecorder) GetOneByPrefix(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByPrefix", reflect.TypeOf((*MockIApiKeyService)(nil).GetOneByPrefix), ctx, prefix)
+}
+
+// GetPaginationPage mocks base method.
+func (m *MockIApiKeyService) GetPaginationPage(ctx context.Context, paginationParams pagination.LimitOffsetParams) (alias.ApiKeyLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginationPage", ctx, paginationParams)
+	ret0, _ := ret[0].(alias.ApiKeyLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginationPage indicates an expected call of GetPaginationPage.
+func (mr *MockIApiKeyServiceMockRecorder) GetPaginationPage(ctx, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPage", reflect.TypeOf((*MockIApiKeyService)(nil).GetPaginationPage), ctx, paginationParams)
+}
+
+// RevokeById mocks base method.
+func (m *MockIApiKeyService) RevokeById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeById", ctx, id)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeById indicates an expected call of RevokeById.
+func (mr *MockIApiKeyServiceMockRecorder) RevokeById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeById", reflect.TypeOf((*MockIApiKeyService)(nil).RevokeById), ctx, id)
+}
+
+// UpdateLastUsedAtById mocks base method.
+func (m *MockIApiKeyService) UpdateLastUsedAtById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsedAtById", ctx, id)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastUsedAtById indicates an expected call of UpdateLastUsedAtById.
+func (mr *MockIApiKeyServiceMockRecorder) UpdateLastUsedAtById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsedAtById", reflect.TypeOf((*MockIApiKeyService)(nil).UpdateLastUsedAtById), ctx, id)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./audit.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIAuditService is a mock of IAuditService interface.
+type MockIAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditServiceMockRecorder
+}
+
+// MockIAuditServiceMockRecorder is the mock recorder for MockIAuditService.
+type MockIAuditServiceMockRecorder struct {
+	mock *MockIAuditService
+}
+
+// NewMockIAuditService creates a new mock instance.
+func NewMockIAuditService(ctrl *gomock.Controller) *MockIAuditService {
+	mock := &MockIAuditService{ctrl: ctrl}
+	mock.recorder = &MockIAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuditService) EXPECT() *MockIAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIAuditService) Create(ctx context.Context, dto dto.CreateAuditEvent) (*model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIAuditServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIAuditService)(nil).Create), ctx, dto)
+}
+
+// Export mocks base method.
+func (m *MockIAuditService) Export(ctx context.Context, filter dto.AuditEventFilter, fn func(*model.AuditEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockIAuditServiceMockRecorder) Export(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockIAuditService)(nil).Export), ctx, filter, fn)
+}
+
+// GetPaginationPage mocks base method.
+func (m *MockIAuditService) GetPaginationPage(ctx context.Context, filter dto.AuditEventFilter, paginationParams pagination.LimitOffsetParams) (alias.AuditEventLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginationPage", ctx, filter, paginationParams)
+	ret0, _ := ret[0].(alias.AuditEventLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginationPage indicates an expected call of GetPaginationPage.
+func (mr *MockIAuditServiceMockRecorder) GetPaginationPage(ctx, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPage", reflect.TypeOf((*MockIAuditService)(nil).GetPaginationPage), ctx, filter, paginationParams)
+}
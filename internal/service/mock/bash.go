@@ -54,6 +54,21 @@ func (mr *MockIBashServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIBashService)(nil).Create), ctx, dto)
 }
 
+// GetKeysetPage mocks base method.
+func (m *MockIBashService) GetKeysetPage(ctx context.Context, filter dto.BashFilter, paginationParams pagination.KeysetParams) (alias.BashKeysetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysetPage", ctx, filter, paginationParams)
+	ret0, _ := ret[0].(alias.BashKeysetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysetPage indicates an expected call of GetKeysetPage.
+func (mr *MockIBashServiceMockRecorder) GetKeysetPage(ctx, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysetPage", reflect.TypeOf((*MockIBashService)(nil).GetKeysetPage), ctx, filter, paginationParams)
+}
+
 // GetOneById mocks base method.
 func (m *MockIBashService) GetOneById(ctx context.Context, id uuid.UUID) (*model.Bash, error) {
 	m.ctrl.T.Helper()
@@ -70,18 +85,18 @@ func (mr *MockIBashServiceMockRecorder) GetOneById(ctx, id interface{}) *gomock.
 }
 
 // GetPaginationPage mocks base method.
-func (m *MockIBashService) GetPaginationPage(ctx context.Context, paginationParams pagination.LimitOffsetParams) (alias.BashLimitOffsetPage, error) {
+func (m *MockIBashService) GetPaginationPage(ctx context.Context, filter dto.BashFilter, paginationParams pagination.LimitOffsetParams) (alias.BashLimitOffsetPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaginationPage", ctx, paginationParams)
+	ret := m.ctrl.Call(m, "GetPaginationPage", ctx, filter, paginationParams)
 	ret0, _ := ret[0].(alias.BashLimitOffsetPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPaginationPage indicates an expected call of GetPaginationPage.
-func (mr *MockIBashServiceMockRecorder) GetPaginationPage(ctx, paginationParams interface{}) *gomock.Call {
+func (mr *MockIBashServiceMockRecorder) GetPaginationPage(ctx, filter, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPage", reflect.TypeOf((*MockIBashService)(nil).GetPaginationPage), ctx, paginationParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPage", reflect.TypeOf((*MockIBashService)(nil).GetPaginationPage), ctx, filter, paginationParams)
 }
 
 // RemoveById mocks base method.
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashacl.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashAclService is a mock of IBashAclService interface.
+type MockIBashAclService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashAclServiceMockRecorder
+}
+
+// MockIBashAclServiceMockRecorder is the mock recorder for MockIBashAclService.
+type MockIBashAclServiceMockRecorder struct {
+	mock *MockIBashAclService
+}
+
+// NewMockIBashAclService creates a new mock instance.
+func NewMockIBashAclService(ctrl *gomock.Controller) *MockIBashAclService {
+	mock := &MockIBashAclService{ctrl: ctrl}
+	mock.recorder = &MockIBashAclServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashAclService) EXPECT() *MockIBashAclServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIBashAclService) Create(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIBashAclServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIBashAclService)(nil).Create), ctx, dto)
+}
+
+// GetListByBashId mocks base method.
+func (m *MockIBashAclService) GetListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByBashId", ctx, bashId)
+	ret0, _ := ret[0].([]*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByBashId indicates an expected call of GetListByBashId.
+func (mr *MockIBashAclServiceMockRecorder) GetListByBashId(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByBashId", reflect.TypeOf((*MockIBashAclService)(nil).GetListByBashId), ctx, bashId)
+}
+
+// GetOneById mocks base method.
+func (m *MockIBashAclService) GetOneById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneById", ctx, id)
+	ret0, _ := ret[0].(*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneById indicates an expected call of GetOneById.
+func (mr *MockIBashAclServiceMockRecorder) GetOneById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneById", reflect.TypeOf((*MockIBashAclService)(nil).GetOneById), ctx, id)
+}
+
+// RemoveById mocks base method.
+func (m *MockIBashAclService) RemoveById(ctx context.Context, id uuid.UUID) (*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveById", ctx, id)
+	ret0, _ := ret[0].(*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveById indicates an expected call of RemoveById.
+func (mr *MockIBashAclServiceMockRecorder) RemoveById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveById", reflect.TypeOf((*MockIBashAclService)(nil).RemoveById), ctx, id)
+}
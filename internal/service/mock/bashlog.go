@@ -54,17 +54,76 @@ func (mr *MockIBashLogServiceMockRecorder) Create(ctx, dto interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIBashLogService)(nil).Create), ctx, dto)
 }
 
+// ExportByBashId mocks base method.
+func (m *MockIBashLogService) ExportByBashId(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, fn func(*model.BashLog) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByBashId", ctx, bashId, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByBashId indicates an expected call of ExportByBashId.
+func (mr *MockIBashLogServiceMockRecorder) ExportByBashId(ctx, bashId, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByBashId", reflect.TypeOf((*MockIBashLogService)(nil).ExportByBashId), ctx, bashId, filter, fn)
+}
+
+// GetKeysetPageByBashId mocks base method.
+func (m *MockIBashLogService) GetKeysetPageByBashId(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.KeysetParams) (alias.BashLogKeysetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysetPageByBashId", ctx, bashId, filter, paginationParams)
+	ret0, _ := ret[0].(alias.BashLogKeysetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysetPageByBashId indicates an expected call of GetKeysetPageByBashId.
+func (mr *MockIBashLogServiceMockRecorder) GetKeysetPageByBashId(ctx, bashId, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysetPageByBashId", reflect.TypeOf((*MockIBashLogService)(nil).GetKeysetPageByBashId), ctx, bashId, filter, paginationParams)
+}
+
+// GetKeysetPageByRunId mocks base method.
+func (m *MockIBashLogService) GetKeysetPageByRunId(ctx context.Context, runId uuid.UUID, paginationParams pagination.KeysetParams) (alias.BashLogKeysetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysetPageByRunId", ctx, runId, paginationParams)
+	ret0, _ := ret[0].(alias.BashLogKeysetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysetPageByRunId indicates an expected call of GetKeysetPageByRunId.
+func (mr *MockIBashLogServiceMockRecorder) GetKeysetPageByRunId(ctx, runId, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysetPageByRunId", reflect.TypeOf((*MockIBashLogService)(nil).GetKeysetPageByRunId), ctx, runId, paginationParams)
+}
+
 // GetPaginationPageByBashId mocks base method.
-func (m *MockIBashLogService) GetPaginationPageByBashId(ctx context.Context, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.BashLogLimitOffsetPage, error) {
+func (m *MockIBashLogService) GetPaginationPageByBashId(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) (alias.BashLogLimitOffsetPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaginationPageByBashId", ctx, bashId, paginationParams)
+	ret := m.ctrl.Call(m, "GetPaginationPageByBashId", ctx, bashId, filter, paginationParams)
 	ret0, _ := ret[0].(alias.BashLogLimitOffsetPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPaginationPageByBashId indicates an expected call of GetPaginationPageByBashId.
-func (mr *MockIBashLogServiceMockRecorder) GetPaginationPageByBashId(ctx, bashId, paginationParams interface{}) *gomock.Call {
+func (mr *MockIBashLogServiceMockRecorder) GetPaginationPageByBashId(ctx, bashId, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPageByBashId", reflect.TypeOf((*MockIBashLogService)(nil).GetPaginationPageByBashId), ctx, bashId, filter, paginationParams)
+}
+
+// GetPaginationPageByRunId mocks base method.
+func (m *MockIBashLogService) GetPaginationPageByRunId(ctx context.Context, runId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.BashLogLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginationPageByRunId", ctx, runId, paginationParams)
+	ret0, _ := ret[0].(alias.BashLogLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginationPageByRunId indicates an expected call of GetPaginationPageByRunId.
+func (mr *MockIBashLogServiceMockRecorder) GetPaginationPageByRunId(ctx, runId, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPageByBashId", reflect.TypeOf((*MockIBashLogService)(nil).GetPaginationPageByBashId), ctx, bashId, paginationParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPageByRunId", reflect.TypeOf((*MockIBashLogService)(nil).GetPaginationPageByRunId), ctx, runId, paginationParams)
 }
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashlogpartition.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIBashLogPartitionService is a mock of IBashLogPartitionService interface.
+type MockIBashLogPartitionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashLogPartitionServiceMockRecorder
+}
+
+// MockIBashLogPartitionServiceMockRecorder is the mock recorder for MockIBashLogPartitionService.
+type MockIBashLogPartitionServiceMockRecorder struct {
+	mock *MockIBashLogPartitionService
+}
+
+// NewMockIBashLogPartitionService creates a new mock instance.
+func NewMockIBashLogPartitionService(ctrl *gomock.Controller) *MockIBashLogPartitionService {
+	mock := &MockIBashLogPartitionService{ctrl: ctrl}
+	mock.recorder = &MockIBashLogPartitionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashLogPartitionService) EXPECT() *MockIBashLogPartitionServiceMockRecorder {
+	return m.recorder
+}
+
+// Maintain mocks base method.
+func (m *MockIBashLogPartitionService) Maintain(ctx context.Context, premakeMonths, retentionMonths int, detach bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Maintain", ctx, premakeMonths, retentionMonths, detach)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Maintain indicates an expected call of Maintain.
+func (mr *MockIBashLogPartitionServiceMockRecorder) Maintain(ctx, premakeMonths, retentionMonths, detach interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Maintain", reflect.TypeOf((*MockIBashLogPartitionService)(nil).Maintain), ctx, premakeMonths, retentionMonths, detach)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashlogretention.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashLogRetentionService is a mock of IBashLogRetentionService interface.
+type MockIBashLogRetentionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashLogRetentionServiceMockRecorder
+}
+
+// MockIBashLogRetentionServiceMockRecorder is the mock recorder for MockIBashLogRetentionService.
+type MockIBashLogRetentionServiceMockRecorder struct {
+	mock *MockIBashLogRetentionService
+}
+
+// NewMockIBashLogRetentionService creates a new mock instance.
+func NewMockIBashLogRetentionService(ctrl *gomock.Controller) *MockIBashLogRetentionService {
+	mock := &MockIBashLogRetentionService{ctrl: ctrl}
+	mock.recorder = &MockIBashLogRetentionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashLogRetentionService) EXPECT() *MockIBashLogRetentionServiceMockRecorder {
+	return m.recorder
+}
+
+// CountPrunable mocks base method.
+func (m *MockIBashLogRetentionService) CountPrunable(ctx context.Context, policy dto.BashLogRetentionPolicy) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPrunable", ctx, policy)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPrunable indicates an expected call of CountPrunable.
+func (mr *MockIBashLogRetentionServiceMockRecorder) CountPrunable(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPrunable", reflect.TypeOf((*MockIBashLogRetentionService)(nil).CountPrunable), ctx, policy)
+}
+
+// GetOneByBashId mocks base method.
+func (m *MockIBashLogRetentionService) GetOneByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByBashId", ctx, bashId)
+	ret0, _ := ret[0].(*model.BashLogRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByBashId indicates an expected call of GetOneByBashId.
+func (mr *MockIBashLogRetentionServiceMockRecorder) GetOneByBashId(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByBashId", reflect.TypeOf((*MockIBashLogRetentionService)(nil).GetOneByBashId), ctx, bashId)
+}
+
+// Prune mocks base method.
+func (m *MockIBashLogRetentionService) Prune(ctx context.Context, policy dto.BashLogRetentionPolicy, batchSize int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", ctx, policy, batchSize)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockIBashLogRetentionServiceMockRecorder) Prune(ctx, policy, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockIBashLogRetentionService)(nil).Prune), ctx, policy, batchSize)
+}
+
+// RemoveByBashId mocks base method.
+func (m *MockIBashLogRetentionService) RemoveByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveByBashId", ctx, bashId)
+	ret0, _ := ret[0].(*model.BashLogRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveByBashId indicates an expected call of RemoveByBashId.
+func (mr *MockIBashLogRetentionServiceMockRecorder) RemoveByBashId(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveByBashId", reflect.TypeOf((*MockIBashLogRetentionService)(nil).RemoveByBashId), ctx, bashId)
+}
+
+// Set mocks base method.
+func (m *MockIBashLogRetentionService) Set(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, dto)
+	ret0, _ := ret[0].(*model.BashLogRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockIBashLogRetentionServiceMockRecorder) Set(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockIBashLogRetentionService)(nil).Set), ctx, dto)
+}
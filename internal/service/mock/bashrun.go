@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashrun.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashRunService is a mock of IBashRunService interface.
+type MockIBashRunService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashRunServiceMockRecorder
+}
+
+// MockIBashRunServiceMockRecorder is the mock recorder for MockIBashRunService.
+type MockIBashRunServiceMockRecorder struct {
+	mock *MockIBashRunService
+}
+
+// NewMockIBashRunService creates a new mock instance.
+func NewMockIBashRunService(ctrl *gomock.Controller) *MockIBashRunService {
+	mock := &MockIBashRunService{ctrl: ctrl}
+	mock.recorder = &MockIBashRunServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashRunService) EXPECT() *MockIBashRunServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIBashRunService) Create(ctx context.Context, dto dto.CreateBashRun) (*model.BashRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.BashRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIBashRunServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIBashRunService)(nil).Create), ctx, dto)
+}
+
+// FinishById mocks base method.
+func (m *MockIBashRunService) FinishById(ctx context.Context, id uuid.UUID, status string, redactionCount int64) (*model.BashRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishById", ctx, id, status, redactionCount)
+	ret0, _ := ret[0].(*model.BashRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishById indicates an expected call of FinishById.
+func (mr *MockIBashRunServiceMockRecorder) FinishById(ctx, id, status, redactionCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishById", reflect.TypeOf((*MockIBashRunService)(nil).FinishById), ctx, id, status, redactionCount)
+}
+
+// GetOneById mocks base method.
+func (m *MockIBashRunService) GetOneById(ctx context.Context, id uuid.UUID) (*model.BashRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneById", ctx, id)
+	ret0, _ := ret[0].(*model.BashRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneById indicates an expected call of GetOneById.
+func (mr *MockIBashRunServiceMockRecorder) GetOneById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneById", reflect.TypeOf((*MockIBashRunService)(nil).GetOneById), ctx, id)
+}
+
+// GetPaginationPageByBashId mocks base method.
+func (m *MockIBashRunService) GetPaginationPageByBashId(ctx context.Context, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.BashRunLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginationPageByBashId", ctx, bashId, paginationParams)
+	ret0, _ := ret[0].(alias.BashRunLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginationPageByBashId indicates an expected call of GetPaginationPageByBashId.
+func (mr *MockIBashRunServiceMockRecorder) GetPaginationPageByBashId(ctx, bashId, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPageByBashId", reflect.TypeOf((*MockIBashRunService)(nil).GetPaginationPageByBashId), ctx, bashId, paginationParams)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockIBashRunService) RemoveExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockIBashRunServiceMockRecorder) RemoveExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockIBashRunService)(nil).RemoveExpired), ctx)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashrunstats.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	model "pg-sh-scripts/internal/model"
+	stats "pg-sh-scripts/pkg/stats"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashRunStatsService is a mock of IBashRunStatsService interface.
+type MockIBashRunStatsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashRunStatsServiceMockRecorder
+}
+
+// MockIBashRunStatsServiceMockRecorder is the mock recorder for MockIBashRunStatsService.
+type MockIBashRunStatsServiceMockRecorder struct {
+	mock *MockIBashRunStatsService
+}
+
+// NewMockIBashRunStatsService creates a new mock instance.
+func NewMockIBashRunStatsService(ctrl *gomock.Controller) *MockIBashRunStatsService {
+	mock := &MockIBashRunStatsService{ctrl: ctrl}
+	mock.recorder = &MockIBashRunStatsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashRunStatsService) EXPECT() *MockIBashRunStatsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetRolledUpScriptList mocks base method.
+func (m *MockIBashRunStatsService) GetRolledUpScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolledUpScriptList", ctx, window)
+	ret0, _ := ret[0].([]*model.BashRunScriptStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolledUpScriptList indicates an expected call of GetRolledUpScriptList.
+func (mr *MockIBashRunStatsServiceMockRecorder) GetRolledUpScriptList(ctx, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolledUpScriptList", reflect.TypeOf((*MockIBashRunStatsService)(nil).GetRolledUpScriptList), ctx, window)
+}
+
+// GetRolledUpTotal mocks base method.
+func (m *MockIBashRunStatsService) GetRolledUpTotal(ctx context.Context, window stats.Window) (*model.BashRunStatsRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolledUpTotal", ctx, window)
+	ret0, _ := ret[0].(*model.BashRunStatsRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolledUpTotal indicates an expected call of GetRolledUpTotal.
+func (mr *MockIBashRunStatsServiceMockRecorder) GetRolledUpTotal(ctx, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolledUpTotal", reflect.TypeOf((*MockIBashRunStatsService)(nil).GetRolledUpTotal), ctx, window)
+}
+
+// GetScriptList mocks base method.
+func (m *MockIBashRunStatsService) GetScriptList(ctx context.Context, window stats.Window) ([]*model.BashRunScriptStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScriptList", ctx, window)
+	ret0, _ := ret[0].([]*model.BashRunScriptStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScriptList indicates an expected call of GetScriptList.
+func (mr *MockIBashRunStatsServiceMockRecorder) GetScriptList(ctx, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScriptList", reflect.TypeOf((*MockIBashRunStatsService)(nil).GetScriptList), ctx, window)
+}
+
+// GetTotal mocks base method.
+func (m *MockIBashRunStatsService) GetTotal(ctx context.Context, window stats.Window) (*model.BashRunWindowStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotal", ctx, window)
+	ret0, _ := ret[0].(*model.BashRunWindowStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotal indicates an expected call of GetTotal.
+func (mr *MockIBashRunStatsServiceMockRecorder) GetTotal(ctx, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotal", reflect.TypeOf((*MockIBashRunStatsService)(nil).GetTotal), ctx, window)
+}
+
+// GetWindowListByBashId mocks base method.
+func (m *MockIBashRunStatsService) GetWindowListByBashId(ctx context.Context, bashId uuid.UUID, windows []stats.Window) ([]*model.BashRunWindowStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWindowListByBashId", ctx, bashId, windows)
+	ret0, _ := ret[0].([]*model.BashRunWindowStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWindowListByBashId indicates an expected call of GetWindowListByBashId.
+func (mr *MockIBashRunStatsServiceMockRecorder) GetWindowListByBashId(ctx, bashId, windows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWindowListByBashId", reflect.TypeOf((*MockIBashRunStatsService)(nil).GetWindowListByBashId), ctx, bashId, windows)
+}
+
+// Rollup mocks base method.
+func (m *MockIBashRunStatsService) Rollup(ctx context.Context, windows []stats.Window) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", ctx, windows)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollup indicates an expected call of Rollup.
+func (mr *MockIBashRunStatsServiceMockRecorder) Rollup(ctx, windows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockIBashRunStatsService)(nil).Rollup), ctx, windows)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./health.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	model "pg-sh-scripts/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIHealthService is a mock of IHealthService interface.
+type MockIHealthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthServiceMockRecorder
+}
+
+// MockIHealthServiceMockRecorder is the mock recorder for MockIHealthService.
+type MockIHealthServiceMockRecorder struct {
+	mock *MockIHealthService
+}
+
+// NewMockIHealthService creates a new mock instance.
+func NewMockIHealthService(ctrl *gomock.Controller) *MockIHealthService {
+	mock := &MockIHealthService{ctrl: ctrl}
+	mock.recorder = &MockIHealthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthService) EXPECT() *MockIHealthServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMigrationStatus mocks base method.
+func (m *MockIHealthService) GetMigrationStatus(ctx context.Context) (*model.MigrationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationStatus", ctx)
+	ret0, _ := ret[0].(*model.MigrationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationStatus indicates an expected call of GetMigrationStatus.
+func (mr *MockIHealthServiceMockRecorder) GetMigrationStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationStatus", reflect.TypeOf((*MockIHealthService)(nil).GetMigrationStatus), ctx)
+}
+
+// Ping mocks base method.
+func (m *MockIHealthService) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockIHealthServiceMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockIHealthService)(nil).Ping), ctx)
+}
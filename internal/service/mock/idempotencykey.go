@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./idempotencykey.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	json "encoding/json"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIIdempotencyKeyService is a mock of IIdempotencyKeyService interface.
+type MockIIdempotencyKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIdempotencyKeyServiceMockRecorder
+}
+
+// MockIIdempotencyKeyServiceMockRecorder is the mock recorder for MockIIdempotencyKeyService.
+type MockIIdempotencyKeyServiceMockRecorder struct {
+	mock *MockIIdempotencyKeyService
+}
+
+// NewMockIIdempotencyKeyService creates a new mock instance.
+func NewMockIIdempotencyKeyService(ctrl *gomock.Controller) *MockIIdempotencyKeyService {
+	mock := &MockIIdempotencyKeyService{ctrl: ctrl}
+	mock.recorder = &MockIIdempotencyKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIdempotencyKeyService) EXPECT() *MockIIdempotencyKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIIdempotencyKeyService) Create(ctx context.Context, dto dto.CreateIdempotencyKey) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIIdempotencyKeyServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIIdempotencyKeyService)(nil).Create), ctx, dto)
+}
+
+// GetOneByKey mocks base method.
+func (m *MockIIdempotencyKeyService) GetOneByKey(ctx context.Context, principalId, key string) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByKey", ctx, principalId, key)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByKey indicates an expected call of GetOneByKey.
+func (mr *MockIIdempotencyKeyServiceMockRecorder) GetOneByKey(ctx, principalId, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByKey", reflect.TypeOf((*MockIIdempotencyKeyService)(nil).GetOneByKey), ctx, principalId, key)
+}
+
+// RemoveByKey mocks base method.
+func (m *MockIIdempotencyKeyService) RemoveByKey(ctx context.Context, principalId, key string) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveByKey", ctx, principalId, key)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveByKey indicates an expected call of RemoveByKey.
+func (mr *MockIIdempotencyKeyServiceMockRecorder) RemoveByKey(ctx, principalId, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveByKey", reflect.TypeOf((*MockIIdempotencyKeyService)(nil).RemoveByKey), ctx, principalId, key)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockIIdempotencyKeyService) RemoveExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockIIdempotencyKeyServiceMockRecorder) RemoveExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockIIdempotencyKeyService)(nil).RemoveExpired), ctx)
+}
+
+// SetResponseByKey mocks base method.
+func (m *MockIIdempotencyKeyService) SetResponseByKey(ctx context.Context, principalId, key string, response json.RawMessage) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResponseByKey", ctx, principalId, key, response)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetResponseByKey indicates an expected call of SetResponseByKey.
+func (mr *MockIIdempotencyKeyServiceMockRecorder) SetResponseByKey(ctx, principalId, key, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResponseByKey", reflect.TypeOf((*MockIIdempotencyKeyService)(nil).SetResponseByKey), ctx, principalId, key, response)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./webhook.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIWebhookService is a mock of IWebhookService interface.
+type MockIWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookServiceMockRecorder
+}
+
+// MockIWebhookServiceMockRecorder is the mock recorder for MockIWebhookService.
+type MockIWebhookServiceMockRecorder struct {
+	mock *MockIWebhookService
+}
+
+// NewMockIWebhookService creates a new mock instance.
+func NewMockIWebhookService(ctrl *gomock.Controller) *MockIWebhookService {
+	mock := &MockIWebhookService{ctrl: ctrl}
+	mock.recorder = &MockIWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookService) EXPECT() *MockIWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIWebhookService) Create(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIWebhookServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIWebhookService)(nil).Create), ctx, dto)
+}
+
+// GetListByBashIdAndEvent mocks base method.
+func (m *MockIWebhookService) GetListByBashIdAndEvent(ctx context.Context, bashId uuid.UUID, event string) ([]*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByBashIdAndEvent", ctx, bashId, event)
+	ret0, _ := ret[0].([]*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByBashIdAndEvent indicates an expected call of GetListByBashIdAndEvent.
+func (mr *MockIWebhookServiceMockRecorder) GetListByBashIdAndEvent(ctx, bashId, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByBashIdAndEvent", reflect.TypeOf((*MockIWebhookService)(nil).GetListByBashIdAndEvent), ctx, bashId, event)
+}
+
+// GetOneById mocks base method.
+func (m *MockIWebhookService) GetOneById(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneById", ctx, id)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneById indicates an expected call of GetOneById.
+func (mr *MockIWebhookServiceMockRecorder) GetOneById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneById", reflect.TypeOf((*MockIWebhookService)(nil).GetOneById), ctx, id)
+}
+
+// GetPaginationPage mocks base method.
+func (m *MockIWebhookService) GetPaginationPage(ctx context.Context, paginationParams pagination.LimitOffsetParams) (alias.WebhookLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginationPage", ctx, paginationParams)
+	ret0, _ := ret[0].(alias.WebhookLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginationPage indicates an expected call of GetPaginationPage.
+func (mr *MockIWebhookServiceMockRecorder) GetPaginationPage(ctx, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPage", reflect.TypeOf((*MockIWebhookService)(nil).GetPaginationPage), ctx, paginationParams)
+}
+
+// RemoveById mocks base method.
+func (m *MockIWebhookService) RemoveById(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveById", ctx, id)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveById indicates an expected call of RemoveById.
+func (mr *MockIWebhookServiceMockRecorder) RemoveById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveById", reflect.TypeOf((*MockIWebhookService)(nil).RemoveById), ctx, id)
+}
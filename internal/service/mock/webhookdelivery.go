@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./webhookdelivery.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIWebhookDeliveryService is a mock of IWebhookDeliveryService interface.
+type MockIWebhookDeliveryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookDeliveryServiceMockRecorder
+}
+
+// MockIWebhookDeliveryServiceMockRecorder is the mock recorder for MockIWebhookDeliveryService.
+type MockIWebhookDeliveryServiceMockRecorder struct {
+	mock *MockIWebhookDeliveryService
+}
+
+// NewMockIWebhookDeliveryService creates a new mock instance.
+func NewMockIWebhookDeliveryService(ctrl *gomock.Controller) *MockIWebhookDeliveryService {
+	mock := &MockIWebhookDeliveryService{ctrl: ctrl}
+	mock.recorder = &MockIWebhookDeliveryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookDeliveryService) EXPECT() *MockIWebhookDeliveryServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIWebhookDeliveryService) Create(ctx context.Context, dto dto.CreateWebhookDelivery) (*model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dto)
+	ret0, _ := ret[0].(*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIWebhookDeliveryServiceMockRecorder) Create(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIWebhookDeliveryService)(nil).Create), ctx, dto)
+}
+
+// GetPaginationPageByWebhookId mocks base method.
+func (m *MockIWebhookDeliveryService) GetPaginationPageByWebhookId(ctx context.Context, webhookId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.WebhookDeliveryLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginationPageByWebhookId", ctx, webhookId, paginationParams)
+	ret0, _ := ret[0].(alias.WebhookDeliveryLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginationPageByWebhookId indicates an expected call of GetPaginationPageByWebhookId.
+func (mr *MockIWebhookDeliveryServiceMockRecorder) GetPaginationPageByWebhookId(ctx, webhookId, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginationPageByWebhookId", reflect.TypeOf((*MockIWebhookDeliveryService)(nil).GetPaginationPageByWebhookId), ctx, webhookId, paginationParams)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./webhook.go  -destination=./mock/webhook.go
+
+type (
+	IWebhookService interface {
+		GetOneById(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+		GetPaginationPage(
+			ctx context.Context,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.WebhookLimitOffsetPage, error)
+		GetListByBashIdAndEvent(
+			ctx context.Context,
+			bashId uuid.UUID,
+			event string,
+		) ([]*model.Webhook, error)
+		Create(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error)
+		RemoveById(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+	}
+
+	WebhookService struct {
+		repository repo.IWebhookRepository
+	}
+)
+
+func (s *WebhookService) GetOneById(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook, err := s.repository.GetOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (s *WebhookService) GetPaginationPage(
+	ctx context.Context,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.WebhookLimitOffsetPage, error) {
+	webhookPaginationPage, err := s.repository.GetPaginationPage(ctx, paginationParams)
+	if err != nil {
+		return webhookPaginationPage, err
+	}
+	return webhookPaginationPage, nil
+}
+
+func (s *WebhookService) GetListByBashIdAndEvent(
+	ctx context.Context,
+	bashId uuid.UUID,
+	event string,
+) ([]*model.Webhook, error) {
+	webhooks, err := s.repository.GetListByBashIdAndEvent(ctx, bashId, event)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (s *WebhookService) Create(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error) {
+	webhook, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (s *WebhookService) RemoveById(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook, err := s.repository.RemoveById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func GetWebhookService() IWebhookService {
+	return &WebhookService{
+		repository: repo.GetPgWebhookRepository(),
+	}
+}
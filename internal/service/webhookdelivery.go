@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/repo"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./webhookdelivery.go  -destination=./mock/webhookdelivery.go
+
+type (
+	IWebhookDeliveryService interface {
+		GetPaginationPageByWebhookId(
+			ctx context.Context,
+			webhookId uuid.UUID,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.WebhookDeliveryLimitOffsetPage, error)
+		Create(ctx context.Context, dto dto.CreateWebhookDelivery) (*model.WebhookDelivery, error)
+	}
+
+	WebhookDeliveryService struct {
+		repository repo.IWebhookDeliveryRepository
+	}
+)
+
+func (s *WebhookDeliveryService) GetPaginationPageByWebhookId(
+	ctx context.Context,
+	webhookId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.WebhookDeliveryLimitOffsetPage, error) {
+	webhookDeliveryPaginationPage, err := s.repository.GetPaginationPageByWebhookId(
+		ctx,
+		webhookId,
+		paginationParams,
+	)
+	if err != nil {
+		return webhookDeliveryPaginationPage, err
+	}
+	return webhookDeliveryPaginationPage, nil
+}
+
+func (s *WebhookDeliveryService) Create(
+	ctx context.Context,
+	dto dto.CreateWebhookDelivery,
+) (*model.WebhookDelivery, error) {
+	webhookDelivery, err := s.repository.Create(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	return webhookDelivery, nil
+}
+
+func GetWebhookDeliveryService() IWebhookDeliveryService {
+	return &WebhookDeliveryService{
+		repository: repo.GetPgWebhookDeliveryRepository(),
+	}
+}
@@ -0,0 +1,8 @@
+package alias
+
+import (
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/sql/pagination"
+)
+
+type ApiKeyLimitOffsetPage = pagination.LimitOffsetPage[*model.ApiKey]
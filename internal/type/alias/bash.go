@@ -8,4 +8,5 @@ import (
 type (
 	BashTitle           = string
 	BashLimitOffsetPage = pagination.LimitOffsetPage[*model.Bash]
+	BashKeysetPage      = pagination.KeysetPage[*model.Bash]
 )
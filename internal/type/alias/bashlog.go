@@ -5,4 +5,7 @@ import (
 	"pg-sh-scripts/pkg/sql/pagination"
 )
 
-type BashLogLimitOffsetPage = pagination.LimitOffsetPage[*model.BashLog]
+type (
+	BashLogLimitOffsetPage = pagination.LimitOffsetPage[*model.BashLog]
+	BashLogKeysetPage      = pagination.KeysetPage[*model.BashLog]
+)
@@ -0,0 +1,11 @@
+package alias
+
+import (
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/sql/pagination"
+)
+
+type (
+	WebhookLimitOffsetPage         = pagination.LimitOffsetPage[*model.Webhook]
+	WebhookDeliveryLimitOffsetPage = pagination.LimitOffsetPage[*model.WebhookDelivery]
+)
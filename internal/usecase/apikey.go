@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/apikey"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"slices"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./apikey.go  -destination=./mock/apikey.go
+
+const (
+	adminApiKeyName = "admin"
+	// lastUsedAtUpdateInterval is the min interval between the updates of the usage time of an api key,
+	// so not every request writes it.
+	lastUsedAtUpdateInterval = time.Minute
+)
+
+type (
+	IApiKeyUseCase interface {
+		GetApiKeyPaginationPage(ctx context.Context, paginationParams pagination.LimitOffsetParams) (alias.ApiKeyLimitOffsetPage, error)
+		CreateApiKey(ctx context.Context, dto dto.CreateApiKey) (*model.ApiKey, string, error)
+		RevokeApiKeyById(ctx context.Context, apiKeyId uuid.UUID) (*model.ApiKey, error)
+		ExpireApiKeyById(ctx context.Context, apiKeyId uuid.UUID, dto dto.ExpireApiKey) (*model.ApiKey, error)
+		Authenticate(ctx context.Context, token string) (*model.ApiKey, error)
+	}
+
+	ApiKeyUseCase struct {
+		service    service.IApiKeyService
+		adminKey   string
+		httpErrors *config.HTTPErrors
+	}
+)
+
+func (u *ApiKeyUseCase) GetApiKeyPaginationPage(
+	ctx context.Context,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.ApiKeyLimitOffsetPage, error) {
+	apiKeyPaginationPage, err := u.service.GetPaginationPage(ctx, paginationParams)
+	if err != nil {
+		return apiKeyPaginationPage, u.httpErrors.ApiKeyGetPaginationPage
+	}
+	return apiKeyPaginationPage, nil
+}
+
+// CreateApiKey stores the new api key and returns it along with the plaintext value,
+// which can not be restored later.
+func (u *ApiKeyUseCase) CreateApiKey(ctx context.Context, createDTO dto.CreateApiKey) (*model.ApiKey, string, error) {
+	if strings.TrimSpace(createDTO.Name) == "" {
+		return nil, "", u.httpErrors.ApiKeyName
+	}
+	if !slices.Contains(model.Roles, createDTO.Role) {
+		return nil, "", u.httpErrors.ApiKeyRole
+	}
+	if createDTO.ExpiresAt != nil && !createDTO.ExpiresAt.After(time.Now()) {
+		return nil, "", u.httpErrors.ApiKeyExpiresAt
+	}
+
+	key, err := apikey.Generate()
+	if err != nil {
+		return nil, "", u.httpErrors.ApiKeyCreate
+	}
+	salt, err := apikey.GenerateSalt()
+	if err != nil {
+		return nil, "", u.httpErrors.ApiKeyCreate
+	}
+
+	apiKey, err := u.service.Create(ctx, dto.SaveApiKey{
+		Name:      createDTO.Name,
+		Prefix:    key.Prefix,
+		Salt:      salt,
+		Hash:      apikey.Hash(salt, key.Secret),
+		Role:      createDTO.Role,
+		ExpiresAt: createDTO.ExpiresAt,
+	})
+	if err != nil {
+		return nil, "", u.httpErrors.ApiKeyCreate
+	}
+
+	return apiKey, key.Value, nil
+}
+
+func (u *ApiKeyUseCase) RevokeApiKeyById(ctx context.Context, apiKeyId uuid.UUID) (*model.ApiKey, error) {
+	_, err := u.service.GetOneById(ctx, apiKeyId)
+	if err != nil {
+		return nil, u.httpErrors.ApiKeyDoesNotExists
+	}
+
+	apiKey, err := u.service.RevokeById(ctx, apiKeyId)
+	if err != nil {
+		return nil, u.httpErrors.ApiKeyRevoke
+	}
+
+	return apiKey, nil
+}
+
+// ExpireApiKeyById sets the expiration time of the api key, the key expires immediately
+// if the time is omitted.
+func (u *ApiKeyUseCase) ExpireApiKeyById(ctx context.Context, apiKeyId uuid.UUID, dto dto.ExpireApiKey) (*model.ApiKey, error) {
+	_, err := u.service.GetOneById(ctx, apiKeyId)
+	if err != nil {
+		return nil, u.httpErrors.ApiKeyDoesNotExists
+	}
+
+	apiKey, err := u.service.ExpireById(ctx, apiKeyId, dto)
+	if err != nil {
+		return nil, u.httpErrors.ApiKeyExpire
+	}
+
+	return apiKey, nil
+}
+
+// Authenticate returns the api key matching the token. The admin key from the config
+// is accepted as is, so that the first keys can be created.
+func (u *ApiKeyUseCase) Authenticate(ctx context.Context, token string) (*model.ApiKey, error) {
+	if token == "" {
+		return nil, u.httpErrors.ApiKeyUnauthorized
+	}
+	if u.adminKey != "" && subtle.ConstantTimeCompare([]byte(token), []byte(u.adminKey)) == 1 {
+		return &model.ApiKey{Name: adminApiKeyName, Role: model.RoleAdmin}, nil
+	}
+
+	key, err := apikey.Parse(token)
+	if err != nil {
+		return nil, u.httpErrors.ApiKeyUnauthorized
+	}
+
+	apiKey, err := u.service.GetOneByPrefix(ctx, key.Prefix)
+	if err != nil {
+		return nil, u.httpErrors.ApiKeyUnauthorized
+	}
+	if !apikey.Verify(apiKey.Salt, key.Secret, apiKey.Hash) {
+		return nil, u.httpErrors.ApiKeyUnauthorized
+	}
+	if apiKey.RevokedAt != nil {
+		return nil, u.httpErrors.ApiKeyRevoked
+	}
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		return nil, u.httpErrors.ApiKeyExpired
+	}
+
+	// The request is not rejected if the usage time could not be saved
+	if apiKey.LastUsedAt == nil || time.Since(*apiKey.LastUsedAt) >= lastUsedAtUpdateInterval {
+		if usedApiKey, err := u.service.UpdateLastUsedAtById(ctx, apiKey.Id); err == nil {
+			apiKey = usedApiKey
+		}
+	}
+
+	return apiKey, nil
+}
+
+func GetApiKeyUseCase() IApiKeyUseCase {
+	return &ApiKeyUseCase{
+		service:    service.GetApiKeyService(),
+		adminKey:   config.GetConfig().Auth.AdminKey,
+		httpErrors: config.GetHTTPErrors(),
+	}
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	"pg-sh-scripts/pkg/apikey"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKeyUseCase_Authenticate(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx   context.Context
+			token string
+		}
+
+		expectedStruct struct {
+			apiKey *model.ApiKey
+			err    error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	adminKey := "bootstrap-admin-key"
+	key, _ := apikey.Generate()
+	salt, _ := apikey.GenerateSalt()
+	past := time.Now().Add(-time.Hour)
+
+	storedApiKey := &model.ApiKey{
+		Id:     uuid.NewV4(),
+		Name:   "deploy",
+		Prefix: key.Prefix,
+		Salt:   salt,
+		Hash:   apikey.Hash(salt, key.Secret),
+	}
+	revokedApiKey := *storedApiKey
+	revokedApiKey.RevokedAt = &past
+	expiredApiKey := *storedApiKey
+	expiredApiKey.ExpiresAt = &past
+	otherSecretApiKey := *storedApiKey
+	otherSecretApiKey.Hash = apikey.Hash(salt, "other")
+	recent := time.Now()
+	recentlyUsedApiKey := *storedApiKey
+	recentlyUsedApiKey.LastUsedAt = &recent
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIApiKeyService, context.Context)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: key.Value,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneByPrefix(ctx, key.Prefix).Return(storedApiKey, nil),
+					ms.EXPECT().UpdateLastUsedAtById(ctx, storedApiKey.Id).Return(storedApiKey, nil),
+				)
+			},
+			expected: expectedStruct{
+				apiKey: storedApiKey,
+				err:    nil,
+			},
+		},
+		{
+			name: "Success without updating recent usage time",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: key.Value,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {
+				ms.EXPECT().GetOneByPrefix(ctx, key.Prefix).Return(&recentlyUsedApiKey, nil)
+			},
+			expected: expectedStruct{
+				apiKey: &recentlyUsedApiKey,
+				err:    nil,
+			},
+		},
+		{
+			name: "Success admin key",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: adminKey,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {},
+			expected: expectedStruct{
+				apiKey: &model.ApiKey{Name: adminApiKeyName, Role: model.RoleAdmin},
+				err:    nil,
+			},
+		},
+		{
+			name: "Invalid format error",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: "token",
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {},
+			expected: expectedStruct{
+				apiKey: nil,
+				err:    httpErrors.ApiKeyUnauthorized,
+			},
+		},
+		{
+			name: "Unknown prefix error",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: key.Value,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {
+				ms.EXPECT().GetOneByPrefix(ctx, key.Prefix).Return(nil, httpErrors.ApiKeyDoesNotExists)
+			},
+			expected: expectedStruct{
+				apiKey: nil,
+				err:    httpErrors.ApiKeyUnauthorized,
+			},
+		},
+		{
+			name: "Invalid secret error",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: key.Value,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {
+				ms.EXPECT().GetOneByPrefix(ctx, key.Prefix).Return(&otherSecretApiKey, nil)
+			},
+			expected: expectedStruct{
+				apiKey: nil,
+				err:    httpErrors.ApiKeyUnauthorized,
+			},
+		},
+		{
+			name: "Revoked error",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: key.Value,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {
+				ms.EXPECT().GetOneByPrefix(ctx, key.Prefix).Return(&revokedApiKey, nil)
+			},
+			expected: expectedStruct{
+				apiKey: nil,
+				err:    httpErrors.ApiKeyRevoked,
+			},
+		},
+		{
+			name: "Expired error",
+			in: inStruct{
+				ctx:   context.Background(),
+				token: key.Value,
+			},
+			mockBehavior: func(ms *mock_service.MockIApiKeyService, ctx context.Context) {
+				ms.EXPECT().GetOneByPrefix(ctx, key.Prefix).Return(&expiredApiKey, nil)
+			},
+			expected: expectedStruct{
+				apiKey: nil,
+				err:    httpErrors.ApiKeyExpired,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockApiKeyService := mock_service.NewMockIApiKeyService(ctrl)
+			testCase.mockBehavior(mockApiKeyService, testCase.in.ctx)
+
+			apiKeyUseCase := ApiKeyUseCase{
+				service:    mockApiKeyService,
+				adminKey:   adminKey,
+				httpErrors: httpErrors,
+			}
+
+			apiKey, err := apiKeyUseCase.Authenticate(testCase.in.ctx, testCase.in.token)
+
+			assert.Equal(t, testCase.expected.apiKey, apiKey)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+)
+
+//go:generate mockgen -source=./audit.go  -destination=./mock/audit.go
+
+type (
+	IAuditUseCase interface {
+		GetAuditEventPaginationPage(
+			ctx context.Context,
+			filter dto.AuditEventFilter,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.AuditEventLimitOffsetPage, error)
+		ExportAuditEventList(ctx context.Context, filter dto.AuditEventFilter, fn func(auditEvent *model.AuditEvent) error) error
+		RecordAuditEvent(ctx context.Context, dto dto.CreateAuditEvent) error
+	}
+
+	AuditUseCase struct {
+		service    service.IAuditService
+		httpErrors *config.HTTPErrors
+	}
+)
+
+func isAuditEventFilterValid(filter dto.AuditEventFilter) bool {
+	if filter.Outcome != nil && *filter.Outcome != model.AuditOutcomeSuccess && *filter.Outcome != model.AuditOutcomeFailure {
+		return false
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return false
+	}
+	return true
+}
+
+func (u *AuditUseCase) GetAuditEventPaginationPage(
+	ctx context.Context,
+	filter dto.AuditEventFilter,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.AuditEventLimitOffsetPage, error) {
+	var auditEventPaginationPage alias.AuditEventLimitOffsetPage
+
+	if !isAuditEventFilterValid(filter) {
+		return auditEventPaginationPage, u.httpErrors.AuditFilter
+	}
+
+	auditEventPaginationPage, err := u.service.GetPaginationPage(ctx, filter, paginationParams)
+	if err != nil {
+		return auditEventPaginationPage, u.httpErrors.AuditGetPaginationPage
+	}
+	return auditEventPaginationPage, nil
+}
+
+// ExportAuditEventList validates the filter before the first event is passed to fn,
+// so a filter error can still be answered with an http error.
+func (u *AuditUseCase) ExportAuditEventList(
+	ctx context.Context,
+	filter dto.AuditEventFilter,
+	fn func(auditEvent *model.AuditEvent) error,
+) error {
+	if !isAuditEventFilterValid(filter) {
+		return u.httpErrors.AuditFilter
+	}
+
+	if err := u.service.Export(ctx, filter, fn); err != nil {
+		return u.httpErrors.AuditExport
+	}
+	return nil
+}
+
+func (u *AuditUseCase) RecordAuditEvent(ctx context.Context, dto dto.CreateAuditEvent) error {
+	if _, err := u.service.Create(ctx, dto); err != nil {
+		return u.httpErrors.AuditCreate
+	}
+	return nil
+}
+
+func GetAuditUseCase() IAuditUseCase {
+	return &AuditUseCase{
+		service:    service.GetAuditService(),
+		httpErrors: config.GetHTTPErrors(),
+	}
+}
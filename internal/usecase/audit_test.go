@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditUseCase_GetAuditEventPaginationPage(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx              context.Context
+			filter           dto.AuditEventFilter
+			paginationParams pagination.LimitOffsetParams
+		}
+
+		expectedStruct struct {
+			page alias.AuditEventLimitOffsetPage
+			err  error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	outcome := model.AuditOutcomeFailure
+	unknownOutcome := "denied"
+	from := time.Date(2024, 4, 14, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	paginationParams := pagination.LimitOffsetParams{Limit: 20, Offset: 0}
+	page := alias.AuditEventLimitOffsetPage{
+		Items: []*model.AuditEvent{{Action: model.AuditActionBashDelete, Outcome: outcome}},
+		Limit: 20,
+		Total: 1,
+	}
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIAuditService, inStruct)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:              context.Background(),
+				filter:           dto.AuditEventFilter{Outcome: &outcome, From: &from, To: &to},
+				paginationParams: paginationParams,
+			},
+			mockBehavior: func(ms *mock_service.MockIAuditService, in inStruct) {
+				ms.EXPECT().GetPaginationPage(in.ctx, in.filter, in.paginationParams).Return(page, nil)
+			},
+			expected: expectedStruct{
+				page: page,
+				err:  nil,
+			},
+		},
+		{
+			name: "Unknown outcome error",
+			in: inStruct{
+				ctx:              context.Background(),
+				filter:           dto.AuditEventFilter{Outcome: &unknownOutcome},
+				paginationParams: paginationParams,
+			},
+			mockBehavior: func(ms *mock_service.MockIAuditService, in inStruct) {},
+			expected: expectedStruct{
+				err: httpErrors.AuditFilter,
+			},
+		},
+		{
+			name: "From after to error",
+			in: inStruct{
+				ctx:              context.Background(),
+				filter:           dto.AuditEventFilter{From: &to, To: &from},
+				paginationParams: paginationParams,
+			},
+			mockBehavior: func(ms *mock_service.MockIAuditService, in inStruct) {},
+			expected: expectedStruct{
+				err: httpErrors.AuditFilter,
+			},
+		},
+		{
+			name: "Service error",
+			in: inStruct{
+				ctx:              context.Background(),
+				paginationParams: paginationParams,
+			},
+			mockBehavior: func(ms *mock_service.MockIAuditService, in inStruct) {
+				ms.EXPECT().GetPaginationPage(in.ctx, in.filter, in.paginationParams).Return(
+					alias.AuditEventLimitOffsetPage{},
+					errors.New("connection refused"),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.AuditGetPaginationPage,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuditService := mock_service.NewMockIAuditService(ctrl)
+			testCase.mockBehavior(mockAuditService, testCase.in)
+
+			auditUseCase := AuditUseCase{
+				service:    mockAuditService,
+				httpErrors: httpErrors,
+			}
+
+			page, err := auditUseCase.GetAuditEventPaginationPage(testCase.in.ctx, testCase.in.filter, testCase.in.paginationParams)
+
+			assert.Equal(t, testCase.expected.page, page)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
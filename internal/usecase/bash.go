@@ -3,47 +3,88 @@ package usecase
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"mime/multipart"
 	"os"
 	"pg-sh-scripts/internal/common"
 	"pg-sh-scripts/internal/config"
 	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/log"
 	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
 	"pg-sh-scripts/internal/service"
 	"pg-sh-scripts/internal/type/alias"
 	"pg-sh-scripts/internal/util"
 	"pg-sh-scripts/pkg/gosha"
+	"pg-sh-scripts/pkg/logging"
 	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/validation"
+	"regexp"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	uuid "github.com/satori/go.uuid"
 )
 
 //go:generate mockgen -source=./bash.go  -destination=./mock/bash.go
 
+const (
+	idempotencyKeyMaxLength = 255
+	// requestIdEnvName is the environment variable with the id of the request which started the script.
+	requestIdEnvName = "REQUEST_ID"
+)
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 type (
 	IBashUseCase interface {
-		GetBashById(bashId uuid.UUID) (*model.Bash, error)
-		GetBashFileBufferById(bashId uuid.UUID) (*bytes.Buffer, alias.BashTitle, error)
+		GetBashById(ctx context.Context, bashId uuid.UUID) (*model.Bash, error)
+		GetBashFileBufferById(ctx context.Context, bashId uuid.UUID) (*bytes.Buffer, alias.BashTitle, error)
 		GetBashPaginationPage(
+			ctx context.Context,
+			filter dto.BashFilter,
 			paginationParams pagination.LimitOffsetParams,
 		) (alias.BashLimitOffsetPage, error)
-		CreateBash(file *multipart.FileHeader) (*model.Bash, error)
-		ExecBashList(isSync bool, dto []dto.ExecBash) error
-		RemoveBashById(bashId uuid.UUID) (*model.Bash, error)
+		GetBashKeysetPage(
+			ctx context.Context,
+			filter dto.BashFilter,
+			paginationParams pagination.KeysetParams,
+		) (alias.BashKeysetPage, error)
+		CreateBash(ctx context.Context, file *multipart.FileHeader) (*model.Bash, error)
+		ExecBashList(
+			ctx context.Context,
+			principal *model.Principal,
+			isSync bool,
+			dto []dto.ExecBash,
+			idempotencyKey string,
+		) ([]uuid.UUID, error)
+		ExecBashInline(ctx context.Context, dto dto.ExecBashInline, file *multipart.FileHeader) (*model.BashRun, error)
+		RemoveBashById(ctx context.Context, bashId uuid.UUID) (*model.Bash, error)
 	}
 
 	BashUseCase struct {
-		service         service.IBashService
-		util            util.IBashUtil
-		goshaHelper     gosha.IHelper
-		customGoshaExec common.ICustomGoshaExec
-		httpErrors      *config.HTTPErrors
+		service               service.IBashService
+		bashRunService        service.IBashRunService
+		bashAclService        service.IBashAclService
+		idempotencyKeyService service.IIdempotencyKeyService
+		util                  util.IBashUtil
+		goshaHelper           gosha.IHelper
+		customGoshaExec       common.ICustomGoshaExec
+		idempotencyKeyTTL     time.Duration
+		logger                *logging.Logger
+		httpErrors            *config.HTTPErrors
 	}
 )
 
-func (u *BashUseCase) GetBashById(bashId uuid.UUID) (*model.Bash, error) {
-	bash, err := u.service.GetOneById(context.Background(), bashId)
+func (u *BashUseCase) GetBashById(ctx context.Context, bashId uuid.UUID) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "BashUseCase.GetBashById")
+	defer span.End()
+
+	bash, err := u.service.GetOneById(ctx, bashId)
 	if err != nil {
 		return nil, u.httpErrors.BashDoesNotExists
 	}
@@ -51,9 +92,13 @@ func (u *BashUseCase) GetBashById(bashId uuid.UUID) (*model.Bash, error) {
 }
 
 func (u *BashUseCase) GetBashFileBufferById(
+	ctx context.Context,
 	bashId uuid.UUID,
 ) (*bytes.Buffer, alias.BashTitle, error) {
-	bash, err := u.service.GetOneById(context.Background(), bashId)
+	ctx, span := tracer.Start(ctx, "BashUseCase.GetBashFileBufferById")
+	defer span.End()
+
+	bash, err := u.service.GetOneById(ctx, bashId)
 	if err != nil {
 		return nil, "", u.httpErrors.BashDoesNotExists
 	}
@@ -61,16 +106,39 @@ func (u *BashUseCase) GetBashFileBufferById(
 }
 
 func (u *BashUseCase) GetBashPaginationPage(
+	ctx context.Context,
+	filter dto.BashFilter,
 	paginationParams pagination.LimitOffsetParams,
 ) (alias.BashLimitOffsetPage, error) {
-	bashPaginationPage, err := u.service.GetPaginationPage(context.Background(), paginationParams)
+	ctx, span := tracer.Start(ctx, "BashUseCase.GetBashPaginationPage")
+	defer span.End()
+
+	bashPaginationPage, err := u.service.GetPaginationPage(ctx, filter, paginationParams)
 	if err != nil {
 		return bashPaginationPage, u.httpErrors.BashGetPaginationPage
 	}
 	return bashPaginationPage, nil
 }
 
-func (u *BashUseCase) CreateBash(file *multipart.FileHeader) (*model.Bash, error) {
+func (u *BashUseCase) GetBashKeysetPage(
+	ctx context.Context,
+	filter dto.BashFilter,
+	paginationParams pagination.KeysetParams,
+) (alias.BashKeysetPage, error) {
+	ctx, span := tracer.Start(ctx, "BashUseCase.GetBashKeysetPage")
+	defer span.End()
+
+	bashKeysetPage, err := u.service.GetKeysetPage(ctx, filter, paginationParams)
+	if err != nil {
+		return bashKeysetPage, u.httpErrors.BashGetPaginationPage
+	}
+	return bashKeysetPage, nil
+}
+
+func (u *BashUseCase) CreateBash(ctx context.Context, file *multipart.FileHeader) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "BashUseCase.CreateBash")
+	defer span.End()
+
 	fileName := file.Filename
 	fileExtension := u.util.GetBashFileExtension(fileName)
 
@@ -92,7 +160,7 @@ func (u *BashUseCase) CreateBash(file *multipart.FileHeader) (*model.Bash, error
 	}
 
 	createBashDTO := dto.CreateBash{Title: fileTitle, Body: fileBody}
-	bash, err := u.service.Create(context.Background(), createBashDTO)
+	bash, err := u.service.Create(ctx, createBashDTO)
 	if err != nil {
 		return nil, u.httpErrors.BashCreate
 	}
@@ -100,56 +168,299 @@ func (u *BashUseCase) CreateBash(file *multipart.FileHeader) (*model.Bash, error
 	return bash, nil
 }
 
-func (u *BashUseCase) ExecBashList(isSync bool, dto []dto.ExecBash) error {
-	execBashCount := len(dto)
-	bashList := make([]*model.Bash, 0, execBashCount)
+// getExecBashListFingerprint returns the hash of the execute request,
+// it is stored with the idempotency key to detect its reuse with a different request.
+func getExecBashListFingerprint(isSync bool, execBashDTOList []dto.ExecBash) (string, error) {
+	body, err := json.Marshal(struct {
+		IsSync  bool           `json:"isSync"`
+		Execute []dto.ExecBash `json:"execute"`
+	}{
+		IsSync:  isSync,
+		Execute: execBashDTOList,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// getRequestIdEnv returns the environment of a script with the request id,
+// so the script can put it into its own logs.
+func getRequestIdEnv(ctx context.Context) []string {
+	requestId := logging.GetRequestId(ctx)
+	if requestId == "" {
+		return nil
+	}
+	return []string{requestIdEnvName + "=" + requestId}
+}
+
+func (u *BashUseCase) ExecBashList(
+	ctx context.Context,
+	principal *model.Principal,
+	isSync bool,
+	execBashDTOList []dto.ExecBash,
+	idempotencyKey string,
+) ([]uuid.UUID, error) {
+	ctx, span := tracer.Start(ctx, "BashUseCase.ExecBashList")
+	defer span.End()
+
+	if fieldErrors := validation.ValidateList(execBashDTOList, dto.ExecBashListValidation); len(fieldErrors) > 0 {
+		return nil, schema.WithFieldErrors(u.httpErrors.Validate, fieldErrors)
+	}
+	if len(idempotencyKey) > idempotencyKeyMaxLength {
+		return nil, u.httpErrors.IdempotencyKey
+	}
+
+	bashList, err := u.getExecBashList(ctx, principal, execBashDTOList)
+	if err != nil {
+		return nil, err
+	}
+
+	if idempotencyKey == "" {
+		return u.execBashList(ctx, isSync, execBashDTOList, bashList)
+	}
+
+	fingerprint, err := getExecBashListFingerprint(isSync, execBashDTOList)
+	if err != nil {
+		return nil, u.httpErrors.Internal
+	}
+
+	principalId := getPrincipalId(principal)
+	createIdempotencyKeyDTO := dto.CreateIdempotencyKey{
+		PrincipalId: principalId,
+		Key:         idempotencyKey,
+		Fingerprint: fingerprint,
+		ExpiresAt:   time.Now().Add(u.idempotencyKeyTTL),
+	}
+	if _, err := u.idempotencyKeyService.Create(ctx, createIdempotencyKeyDTO); err != nil {
+		if isIdempotencyKeyAlive(err) {
+			return u.replayExecBashList(ctx, principalId, idempotencyKey, fingerprint)
+		}
+		return nil, u.httpErrors.IdempotencyKeyCreate
+	}
+
+	runIds, err := u.execBashList(ctx, isSync, execBashDTOList, bashList)
+	if err != nil {
+		_, _ = u.idempotencyKeyService.RemoveByKey(ctx, principalId, idempotencyKey)
+		return nil, err
+	}
+
+	response, err := json.Marshal(runIds)
+	if err == nil {
+		_, err = u.idempotencyKeyService.SetResponseByKey(ctx, principalId, idempotencyKey, response)
+	}
+	if err != nil {
+		// The scripts are already started, so the key is released
+		// instead of being left in progress until it expires.
+		u.logger.ErrorContext(
+			ctx,
+			fmt.Sprintf("Setting response of idempotency key: %s Error: %s", idempotencyKey, err),
+		)
+		_, _ = u.idempotencyKeyService.RemoveByKey(ctx, principalId, idempotencyKey)
+	}
+
+	return runIds, nil
+}
+
+func (u *BashUseCase) replayExecBashList(
+	ctx context.Context,
+	principalId string,
+	idempotencyKey string,
+	fingerprint string,
+) ([]uuid.UUID, error) {
+	storedIdempotencyKey, err := u.idempotencyKeyService.GetOneByKey(ctx, principalId, idempotencyKey)
+	if err != nil {
+		return nil, u.httpErrors.IdempotencyKeyGet
+	}
+	if storedIdempotencyKey.Fingerprint != fingerprint {
+		return nil, u.httpErrors.IdempotencyKeyMismatch
+	}
+	if storedIdempotencyKey.Response == nil {
+		return nil, u.httpErrors.IdempotencyKeyInProgress
+	}
+
+	var runIds []uuid.UUID
+	if err := json.Unmarshal(storedIdempotencyKey.Response, &runIds); err != nil {
+		return nil, u.httpErrors.IdempotencyKeyGet
+	}
+
+	return runIds, nil
+}
+
+// getPrincipalId returns the id the idempotency keys of the principal are scoped by,
+// all requests share the empty id when the authentication is disabled.
+func getPrincipalId(principal *model.Principal) string {
+	if principal == nil {
+		return ""
+	}
+	return principal.Subject
+}
+
+// isIdempotencyKeyAlive reports whether the key was not created because it is still alive,
+// the idempotency key service returns no row instead of replacing such a key.
+func isIdempotencyKeyAlive(err error) bool {
+	return errors.Is(err, pgx.ErrNoRows)
+}
+
+// checkBashAcl returns an error if the acl of the script does not allow the principal to execute it,
+// the acl is not loaded for admin since it is never restricted.
+func (u *BashUseCase) checkBashAcl(ctx context.Context, principal *model.Principal, bashId uuid.UUID) error {
+	if principal != nil && principal.HasRole(model.RoleAdmin) {
+		return nil
+	}
+
+	bashAclList, err := u.bashAclService.GetListByBashId(ctx, bashId)
+	if err != nil {
+		return u.httpErrors.BashAclGetListByBashId
+	}
+	if !isBashAclAllowed(principal, bashAclList) {
+		return u.httpErrors.AccessBashAclDenied
+	}
+
+	return nil
+}
 
-	for _, execBashDTO := range dto {
-		bash, err := u.service.GetOneById(context.Background(), execBashDTO.Id)
+// getExecBashList returns the scripts of the execute request,
+// every script must exist and its acl must allow the principal to execute it.
+func (u *BashUseCase) getExecBashList(
+	ctx context.Context,
+	principal *model.Principal,
+	execBashDTOList []dto.ExecBash,
+) ([]*model.Bash, error) {
+	bashList := make([]*model.Bash, 0, len(execBashDTOList))
+
+	for _, execBashDTO := range execBashDTOList {
+		bash, err := u.service.GetOneById(ctx, execBashDTO.Id)
 		if err != nil {
-			return u.httpErrors.BashDoesNotExists
+			return nil, u.httpErrors.BashDoesNotExists
+		}
+		if err := u.checkBashAcl(ctx, principal, bash.Id); err != nil {
+			return nil, err
 		}
 		bashList = append(bashList, bash)
 	}
 
+	return bashList, nil
+}
+
+func (u *BashUseCase) execBashList(
+	ctx context.Context,
+	isSync bool,
+	execBashDTOList []dto.ExecBash,
+	bashList []*model.Bash,
+) ([]uuid.UUID, error) {
+	execBashCount := len(execBashDTOList)
+
 	tmpFiles := make([]*os.File, 0, execBashCount)
 	commands := make([]gosha.ICmd, 0, execBashCount)
+	runIds := make([]uuid.UUID, 0, execBashCount)
+
+	defer func() {
+		for _, tmpFile := range tmpFiles {
+			_ = u.goshaHelper.RemoveTmpFile(tmpFile)
+		}
+	}()
 
 	for i := 0; i < execBashCount; i++ {
 		bash := bashList[i]
-		execBashDTO := dto[i]
+		execBashDTO := execBashDTOList[i]
 
 		tmpFile, err := u.goshaHelper.GetTmpFile(bash.Body)
 		if err != nil {
-			return u.httpErrors.BashExecute
+			return nil, u.httpErrors.BashExecute
 		}
 		tmpFiles = append(tmpFiles, tmpFile)
 
+		runId := uuid.NewV4()
+		runIds = append(runIds, runId)
+
 		cmd := &gosha.Cmd{
+			Id:      runId.String(),
 			Title:   bash.Id.String(),
 			Path:    tmpFile.Name(),
+			Env:     getRequestIdEnv(ctx),
 			Timeout: execBashDTO.TimeoutSeconds * time.Second,
 		}
 		commands = append(commands, cmd)
 	}
-	defer func() {
-		for _, tmpFile := range tmpFiles {
-			_ = u.goshaHelper.RemoveTmpFile(tmpFile)
+
+	u.customGoshaExec.Run(ctx, isSync, commands)
+
+	return runIds, nil
+}
+
+func (u *BashUseCase) ExecBashInline(
+	ctx context.Context,
+	dto dto.ExecBashInline,
+	file *multipart.FileHeader,
+) (*model.BashRun, error) {
+	ctx, span := tracer.Start(ctx, "BashUseCase.ExecBashInline")
+	defer span.End()
+
+	if fieldErrors := validation.ValidateStruct(dto); len(fieldErrors) > 0 {
+		return nil, schema.WithFieldErrors(u.httpErrors.Validate, fieldErrors)
+	}
+
+	body := dto.Body
+	if file != nil {
+		fileBody, err := u.util.GetBashFileBody(file)
+		if err != nil {
+			return nil, u.httpErrors.BashGetFileBody
+		}
+		body = fileBody
+	}
+	if body == "" {
+		return nil, u.httpErrors.BashExecuteInlineBody
+	}
+
+	env := make([]string, 0, len(dto.Env))
+	for name, value := range dto.Env {
+		if !envNameRegexp.MatchString(name) {
+			return nil, u.httpErrors.BashExecuteInlineEnv
 		}
+		env = append(env, name+"="+value)
+	}
+	env = append(env, getRequestIdEnv(ctx)...)
+
+	tmpFile, err := u.goshaHelper.GetTmpFile(body)
+	if err != nil {
+		return nil, u.httpErrors.BashExecute
+	}
+	defer func() {
+		_ = u.goshaHelper.RemoveTmpFile(tmpFile)
 	}()
 
-	u.customGoshaExec.Run(isSync, commands)
+	runId := uuid.NewV4()
+	cmd := &gosha.Cmd{
+		Id:      runId.String(),
+		Path:    tmpFile.Name(),
+		Args:    dto.Args,
+		Env:     env,
+		Timeout: dto.TimeoutSeconds * time.Second,
+	}
 
-	return nil
+	u.customGoshaExec.Run(ctx, false, []gosha.ICmd{cmd})
+
+	bashRun, err := u.bashRunService.GetOneById(ctx, runId)
+	if err != nil {
+		return nil, u.httpErrors.BashExecute
+	}
+
+	return bashRun, nil
 }
 
-func (u *BashUseCase) RemoveBashById(bashId uuid.UUID) (*model.Bash, error) {
-	_, err := u.service.GetOneById(context.Background(), bashId)
+func (u *BashUseCase) RemoveBashById(ctx context.Context, bashId uuid.UUID) (*model.Bash, error) {
+	ctx, span := tracer.Start(ctx, "BashUseCase.RemoveBashById")
+	defer span.End()
+
+	_, err := u.service.GetOneById(ctx, bashId)
 	if err != nil {
 		return nil, u.httpErrors.BashDoesNotExists
 	}
 
-	bash, err := u.service.RemoveById(context.Background(), bashId)
+	bash, err := u.service.RemoveById(ctx, bashId)
 	if err != nil {
 		return nil, u.httpErrors.BashRemove
 	}
@@ -159,10 +470,15 @@ func (u *BashUseCase) RemoveBashById(bashId uuid.UUID) (*model.Bash, error) {
 
 func GeBashUseCase() IBashUseCase {
 	return &BashUseCase{
-		service:         service.GetBashService(),
-		util:            util.GetBashUtil(),
-		goshaHelper:     gosha.GetHelper(),
-		customGoshaExec: common.GetCustomGoshaExec(),
-		httpErrors:      config.GetHTTPErrors(),
+		service:               service.GetBashService(),
+		bashRunService:        service.GetBashRunService(),
+		bashAclService:        service.GetBashAclService(),
+		idempotencyKeyService: service.GetIdempotencyKeyService(),
+		util:                  util.GetBashUtil(),
+		goshaHelper:           gosha.GetHelper(),
+		customGoshaExec:       common.GetCustomGoshaExec(),
+		idempotencyKeyTTL:     config.GetConfig().Idempotency.TTLSeconds,
+		logger:                log.GetLogger(),
+		httpErrors:            config.GetHTTPErrors(),
 	}
 }
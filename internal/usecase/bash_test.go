@@ -3,6 +3,8 @@ package usecase
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"mime/multipart"
 	"os"
 	"path"
@@ -10,14 +12,20 @@ import (
 	"pg-sh-scripts/internal/config"
 	"pg-sh-scripts/internal/dto"
 	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
 	mock_service "pg-sh-scripts/internal/service/mock"
 	"pg-sh-scripts/internal/type/alias"
 	"pg-sh-scripts/internal/util"
 	mock_util "pg-sh-scripts/internal/util/mock"
 	mock_gosha "pg-sh-scripts/pkg/gosha/mock"
+	"pg-sh-scripts/pkg/logging"
 	"pg-sh-scripts/pkg/sql/pagination"
+	"pg-sh-scripts/pkg/sql/query"
+	"pg-sh-scripts/pkg/validation"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/golang/mock/gomock"
@@ -59,7 +67,7 @@ func TestBashUseCase_GetBashById(t *testing.T) {
 				bashId: uuid.NewV4(),
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
-				m.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil)
+				m.EXPECT().GetOneById(gomock.Any(), bashId).Return(&model.Bash{}, nil)
 			},
 			expected: expectedStruct{
 				bash: &model.Bash{},
@@ -73,7 +81,7 @@ func TestBashUseCase_GetBashById(t *testing.T) {
 				bashId: uuid.NewV4(),
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
-				m.EXPECT().GetOneById(ctx, bashId).Return(nil, httpErrors.BashDoesNotExists)
+				m.EXPECT().GetOneById(gomock.Any(), bashId).Return(nil, httpErrors.BashDoesNotExists)
 			},
 			expected: expectedStruct{
 				bash: nil,
@@ -95,7 +103,7 @@ func TestBashUseCase_GetBashById(t *testing.T) {
 				httpErrors: httpErrors,
 			}
 
-			bash, err := bashUseCase.GetBashById(testCase.in.bashId)
+			bash, err := bashUseCase.GetBashById(testCase.in.ctx, testCase.in.bashId)
 
 			assert.Equal(t, testCase.expected.bash, bash)
 			assert.Equal(t, testCase.expected.err, err)
@@ -134,7 +142,7 @@ func TestBashUseCase_GetBashFileBufferById(t *testing.T) {
 				bashBody: "",
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
-				m.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil)
+				m.EXPECT().GetOneById(gomock.Any(), bashId).Return(&model.Bash{}, nil)
 			},
 			expected: expectedStruct{
 				bashFileBuffer: bytes.NewBufferString(""),
@@ -149,7 +157,7 @@ func TestBashUseCase_GetBashFileBufferById(t *testing.T) {
 				bashId: uuid.NewV4(),
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
-				m.EXPECT().GetOneById(ctx, bashId).Return(nil, httpErrors.BashDoesNotExists)
+				m.EXPECT().GetOneById(gomock.Any(), bashId).Return(nil, httpErrors.BashDoesNotExists)
 			},
 			expected: expectedStruct{
 				bashFileBuffer: nil,
@@ -173,7 +181,7 @@ func TestBashUseCase_GetBashFileBufferById(t *testing.T) {
 				httpErrors: httpErrors,
 			}
 
-			bashFileBuffer, bashTitle, err := bashUseCase.GetBashFileBufferById(testCase.in.bashId)
+			bashFileBuffer, bashTitle, err := bashUseCase.GetBashFileBufferById(testCase.in.ctx, testCase.in.bashId)
 
 			assert.Equal(t, testCase.expected.bashFileBuffer, bashFileBuffer)
 			assert.Equal(t, testCase.expected.bashTitle, bashTitle)
@@ -186,6 +194,7 @@ func TestBashUseCase_GetBashPaginationPage(t *testing.T) {
 	type (
 		inStruct struct {
 			ctx              context.Context
+			filter           dto.BashFilter
 			paginationParams pagination.LimitOffsetParams
 		}
 
@@ -200,7 +209,7 @@ func TestBashUseCase_GetBashPaginationPage(t *testing.T) {
 	testCases := []struct {
 		name         string
 		in           inStruct
-		mockBehavior func(*mock_service.MockIBashService, context.Context, pagination.LimitOffsetParams)
+		mockBehavior func(*mock_service.MockIBashService, context.Context, dto.BashFilter, pagination.LimitOffsetParams)
 		expected     expectedStruct
 	}{
 		{
@@ -209,9 +218,37 @@ func TestBashUseCase_GetBashPaginationPage(t *testing.T) {
 				ctx:              context.Background(),
 				paginationParams: pagination.LimitOffsetParams{},
 			},
-			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, paginationParams pagination.LimitOffsetParams) {
+			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, filter dto.BashFilter, paginationParams pagination.LimitOffsetParams) {
 				m.EXPECT().GetPaginationPage(
-					ctx,
+					gomock.Any(),
+					filter,
+					paginationParams,
+				).Return(
+					alias.BashLimitOffsetPage{},
+					nil,
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.BashLimitOffsetPage{},
+				err:            nil,
+			},
+		},
+		{
+			name: "Success with filter and sort",
+			in: inStruct{
+				ctx: context.Background(),
+				filter: dto.BashFilter{
+					Query: query.Params{
+						Conditions: []query.Condition{{Column: "title", Operator: query.OperatorLike, Value: "%deploy%"}},
+						Orders:     []query.Order{{Column: "created_at", Desc: true}, {Column: "id"}},
+					},
+				},
+				paginationParams: pagination.LimitOffsetParams{Limit: 20},
+			},
+			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, filter dto.BashFilter, paginationParams pagination.LimitOffsetParams) {
+				m.EXPECT().GetPaginationPage(
+					gomock.Any(),
+					filter,
 					paginationParams,
 				).Return(
 					alias.BashLimitOffsetPage{},
@@ -229,9 +266,10 @@ func TestBashUseCase_GetBashPaginationPage(t *testing.T) {
 				ctx:              context.Background(),
 				paginationParams: pagination.LimitOffsetParams{},
 			},
-			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, paginationParams pagination.LimitOffsetParams) {
+			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, filter dto.BashFilter, paginationParams pagination.LimitOffsetParams) {
 				m.EXPECT().GetPaginationPage(
-					ctx,
+					gomock.Any(),
+					filter,
 					paginationParams,
 				).Return(
 					alias.BashLimitOffsetPage{},
@@ -251,7 +289,7 @@ func TestBashUseCase_GetBashPaginationPage(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockBashService := mock_service.NewMockIBashService(ctrl)
-			testCase.mockBehavior(mockBashService, testCase.in.ctx, testCase.in.paginationParams)
+			testCase.mockBehavior(mockBashService, testCase.in.ctx, testCase.in.filter, testCase.in.paginationParams)
 
 			bashUseCase := BashUseCase{
 				service:    mockBashService,
@@ -259,6 +297,8 @@ func TestBashUseCase_GetBashPaginationPage(t *testing.T) {
 			}
 
 			bashLogPaginationPage, err := bashUseCase.GetBashPaginationPage(
+				testCase.in.ctx,
+				testCase.in.filter,
 				testCase.in.paginationParams,
 			)
 
@@ -306,7 +346,7 @@ func TestBashUseCase_CreateBash(t *testing.T) {
 					mu.EXPECT().ValidateBashFileExtension(".sh").Return(true),
 					mu.EXPECT().GetBashFileTitle(file.Filename).Return(dto.Title),
 					mu.EXPECT().GetBashFileBody(file).Return(dto.Body, nil),
-					ms.EXPECT().Create(ctx, dto).Return(&model.Bash{}, nil),
+					ms.EXPECT().Create(gomock.Any(), dto).Return(&model.Bash{}, nil),
 				)
 			},
 			expected: expectedStruct{
@@ -414,7 +454,7 @@ func TestBashUseCase_CreateBash(t *testing.T) {
 					mu.EXPECT().ValidateBashFileExtension(".sh").Return(true),
 					mu.EXPECT().GetBashFileTitle(file.Filename).Return(dto.Title),
 					mu.EXPECT().GetBashFileBody(file).Return(dto.Body, nil),
-					ms.EXPECT().Create(ctx, dto).Return(nil, httpErrors.BashCreate),
+					ms.EXPECT().Create(gomock.Any(), dto).Return(nil, httpErrors.BashCreate),
 				)
 			},
 			expected: expectedStruct{
@@ -445,7 +485,7 @@ func TestBashUseCase_CreateBash(t *testing.T) {
 				httpErrors: httpErrors,
 			}
 
-			bash, err := bashUseCase.CreateBash(testCase.in.file)
+			bash, err := bashUseCase.CreateBash(testCase.in.ctx, testCase.in.file)
 
 			assert.Equal(t, testCase.expected.bash, bash)
 			assert.Equal(t, testCase.expected.err, err)
@@ -456,58 +496,81 @@ func TestBashUseCase_CreateBash(t *testing.T) {
 func TestBashUseCase_ExecBashList(t *testing.T) {
 	type (
 		inStruct struct {
-			ctx    context.Context
-			isSync bool
-			dto    []dto.ExecBash
+			ctx            context.Context
+			principal      *model.Principal
+			isSync         bool
+			dto            []dto.ExecBash
+			idempotencyKey string
 		}
 
 		expectedStruct struct {
-			err error
+			runIdsCount int
+			err         error
 		}
 	)
 
 	httpErrors := config.GetHTTPErrors()
 
+	adminPrincipal := &model.Principal{Subject: uuid.NewV4().String(), Roles: []string{model.RoleAdmin}}
+	operatorPrincipal := &model.Principal{Subject: uuid.NewV4().String(), Roles: []string{model.RoleOperator}}
+	authorPrincipal := &model.Principal{Subject: uuid.NewV4().String(), Roles: []string{model.RoleAuthor}}
+	operatorRole := model.RoleOperator
+	authorRole := model.RoleAuthor
+
+	storedRunIds := []uuid.UUID{uuid.NewV4()}
+	storedResponse, err := json.Marshal(storedRunIds)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	execBashDTOList := []dto.ExecBash{{Id: uuid.NewV4(), TimeoutSeconds: 30}}
+	fingerprint, err := getExecBashListFingerprint(true, execBashDTOList)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
 	testCases := []struct {
 		name         string
 		in           inStruct
-		mockBehavior func(*mock_service.MockIBashService, *mock_gosha.MockIHelper, *mock_common.MockICustomGoshaExec, context.Context, bool, []dto.ExecBash)
+		mockBehavior func(*mock_service.MockIBashService, *mock_service.MockIIdempotencyKeyService, *mock_service.MockIBashAclService, *mock_gosha.MockIHelper, *mock_common.MockICustomGoshaExec, context.Context, bool, []dto.ExecBash, string)
 		expected     expectedStruct
 	}{
 		{
 			name: "Success",
 			in: inStruct{
-				ctx:    context.Background(),
-				isSync: true,
-				dto:    make([]dto.ExecBash, 1),
+				ctx:       context.Background(),
+				principal: adminPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
 			},
-			mockBehavior: func(ms *mock_service.MockIBashService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash) {
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
 				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
 				if err != nil {
 					t.Fatalf("%s Error: %s", t.Name(), err)
 				}
 
 				gomock.InOrder(
-					ms.EXPECT().GetOneById(ctx, dto[0].Id).Return(&model.Bash{}, nil),
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
 					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
-					mc.EXPECT().Run(isSync, gomock.Any()),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
 					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
 				)
 			},
 			expected: expectedStruct{
-				err: nil,
+				runIdsCount: 1,
+				err:         nil,
 			},
 		},
 		{
 			name: "Getting bash does not exists error",
 			in: inStruct{
-				ctx:    context.Background(),
-				isSync: true,
-				dto:    make([]dto.ExecBash, 1),
+				ctx:       context.Background(),
+				principal: adminPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
 			},
-			mockBehavior: func(ms *mock_service.MockIBashService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash) {
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
 				ms.EXPECT().GetOneById(
-					ctx,
+					gomock.Any(),
 					dto[0].Id,
 				).Return(
 					&model.Bash{},
@@ -521,20 +584,431 @@ func TestBashUseCase_ExecBashList(t *testing.T) {
 		{
 			name: "Executing bash error",
 			in: inStruct{
-				ctx:    context.Background(),
-				isSync: true,
-				dto:    make([]dto.ExecBash, 1),
+				ctx:       context.Background(),
+				principal: adminPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(nil, httpErrors.BashExecute),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashExecute,
+			},
+		},
+		{
+			name: "Success allowed by bash acl role",
+			in: inStruct{
+				ctx:       context.Background(),
+				principal: operatorPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					ma.EXPECT().GetListByBashId(gomock.Any(), gomock.Any()).Return(
+						[]*model.BashAcl{{Role: &authorRole}, {Role: &operatorRole}},
+						nil,
+					),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: 1,
+				err:         nil,
+			},
+		},
+		{
+			name: "Success allowed by less privileged bash acl role",
+			in: inStruct{
+				ctx:       context.Background(),
+				principal: authorPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					ma.EXPECT().GetListByBashId(gomock.Any(), gomock.Any()).Return(
+						[]*model.BashAcl{{Role: &operatorRole}},
+						nil,
+					),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: 1,
+				err:         nil,
+			},
+		},
+		{
+			name: "Success allowed by bash acl subject",
+			in: inStruct{
+				ctx:       context.Background(),
+				principal: operatorPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					ma.EXPECT().GetListByBashId(gomock.Any(), gomock.Any()).Return(
+						[]*model.BashAcl{{Subject: &operatorPrincipal.Subject}},
+						nil,
+					),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: 1,
+				err:         nil,
+			},
+		},
+		{
+			name: "Bash acl denied error",
+			in: inStruct{
+				ctx:       context.Background(),
+				principal: operatorPrincipal,
+				isSync:    true,
+				dto:       execBashDTOList,
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					ma.EXPECT().GetListByBashId(gomock.Any(), gomock.Any()).Return(
+						[]*model.BashAcl{{Role: &authorRole}},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.AccessBashAclDenied,
+			},
+		},
+		{
+			name: "Empty list validation error",
+			in: inStruct{
+				ctx:       context.Background(),
+				principal: adminPrincipal,
+				isSync:    true,
+				dto:       []dto.ExecBash{},
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+			},
+			expected: expectedStruct{
+				err: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "$", Reason: "min=1", Value: []dto.ExecBash{}},
+				}),
+			},
+		},
+		{
+			name: "Fields validation error",
+			in: inStruct{
+				ctx:       context.Background(),
+				principal: adminPrincipal,
+				isSync:    true,
+				dto: []dto.ExecBash{
+					execBashDTOList[0],
+					{Id: execBashDTOList[0].Id, TimeoutSeconds: 86401},
+					{TimeoutSeconds: -1},
+				},
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+			},
+			expected: expectedStruct{
+				err: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "$[1].id", Reason: "unique", Value: execBashDTOList[0].Id},
+					{Field: "$[1].timeoutSeconds", Reason: "max=86400", Value: time.Duration(86401)},
+					{Field: "$[2].id", Reason: "required", Value: uuid.Nil},
+					{Field: "$[2].timeoutSeconds", Reason: "min=0", Value: time.Duration(-1)},
+				}),
+			},
+		},
+		{
+			name: "Success with idempotency key",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&model.IdempotencyKey{}, nil),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+					mi.EXPECT().SetResponseByKey(gomock.Any(), adminPrincipal.Subject, key, gomock.Any()).Return(
+						&model.IdempotencyKey{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: 1,
+				err:         nil,
+			},
+		},
+		{
+			name: "Success reusing expired idempotency key",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				// The expired key is replaced by the repository, so it is returned as a new one without the response.
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(
+						&model.IdempotencyKey{
+							PrincipalId: adminPrincipal.Subject,
+							Key:         key,
+							Fingerprint: fingerprint,
+							CreatedAt:   time.Now(),
+							ExpiresAt:   time.Now().Add(time.Hour),
+						},
+						nil,
+					),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+					mi.EXPECT().SetResponseByKey(gomock.Any(), adminPrincipal.Subject, key, gomock.Any()).Return(
+						&model.IdempotencyKey{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: 1,
+				err:         nil,
+			},
+		},
+		{
+			name: "Replaying idempotency key",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows),
+					mi.EXPECT().GetOneByKey(gomock.Any(), adminPrincipal.Subject, key).Return(
+						&model.IdempotencyKey{
+							PrincipalId: adminPrincipal.Subject,
+							Key:         key,
+							Fingerprint: fingerprint,
+							Response:    storedResponse,
+						},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: len(storedRunIds),
+				err:         nil,
+			},
+		},
+		{
+			name: "Replaying idempotency key bash acl denied error",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      operatorPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					ma.EXPECT().GetListByBashId(gomock.Any(), gomock.Any()).Return(
+						[]*model.BashAcl{{Role: &authorRole}},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.AccessBashAclDenied,
+			},
+		},
+		{
+			name: "Idempotency key mismatch error",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows),
+					mi.EXPECT().GetOneByKey(gomock.Any(), adminPrincipal.Subject, key).Return(
+						&model.IdempotencyKey{
+							PrincipalId: adminPrincipal.Subject,
+							Key:         key,
+							Fingerprint: "another",
+							Response:    storedResponse,
+						},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.IdempotencyKeyMismatch,
+			},
+		},
+		{
+			name: "Idempotency key in progress error",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, pgx.ErrNoRows),
+					mi.EXPECT().GetOneByKey(gomock.Any(), adminPrincipal.Subject, key).Return(
+						&model.IdempotencyKey{
+							PrincipalId: adminPrincipal.Subject,
+							Key:         key,
+							Fingerprint: fingerprint,
+						},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.IdempotencyKeyInProgress,
+			},
+		},
+		{
+			name: "Creating idempotency key error",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, context.Canceled),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.IdempotencyKeyCreate,
+			},
+		},
+		{
+			name: "Removing idempotency key after executing bash error",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
 			},
-			mockBehavior: func(ms *mock_service.MockIBashService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash) {
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
 				gomock.InOrder(
-					ms.EXPECT().GetOneById(ctx, dto[0].Id).Return(&model.Bash{}, nil),
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&model.IdempotencyKey{}, nil),
 					mh.EXPECT().GetTmpFile(gomock.Any()).Return(nil, httpErrors.BashExecute),
+					mi.EXPECT().RemoveByKey(gomock.Any(), adminPrincipal.Subject, key).Return(
+						&model.IdempotencyKey{},
+						nil,
+					),
 				)
 			},
 			expected: expectedStruct{
 				err: httpErrors.BashExecute,
 			},
 		},
+		{
+			name: "Removing idempotency key after setting response error",
+			in: inStruct{
+				ctx:            context.Background(),
+				principal:      adminPrincipal,
+				isSync:         true,
+				dto:            execBashDTOList,
+				idempotencyKey: "deploy-1",
+			},
+			mockBehavior: func(ms *mock_service.MockIBashService, mi *mock_service.MockIIdempotencyKeyService, ma *mock_service.MockIBashAclService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, isSync bool, dto []dto.ExecBash, key string) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				gomock.InOrder(
+					ms.EXPECT().GetOneById(gomock.Any(), dto[0].Id).Return(&model.Bash{}, nil),
+					mi.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&model.IdempotencyKey{}, nil),
+					mh.EXPECT().GetTmpFile(gomock.Any()).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), isSync, gomock.Any()),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+					mi.EXPECT().SetResponseByKey(gomock.Any(), adminPrincipal.Subject, key, gomock.Any()).Return(
+						nil,
+						errors.New("conn closed"),
+					),
+					mi.EXPECT().RemoveByKey(gomock.Any(), adminPrincipal.Subject, key).Return(
+						&model.IdempotencyKey{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				runIdsCount: 1,
+				err:         nil,
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -543,26 +1017,186 @@ func TestBashUseCase_ExecBashList(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockBashService := mock_service.NewMockIBashService(ctrl)
+			mockIdempotencyKeyService := mock_service.NewMockIIdempotencyKeyService(ctrl)
+			mockBashAclService := mock_service.NewMockIBashAclService(ctrl)
 			mockGoshaHelper := mock_gosha.NewMockIHelper(ctrl)
 			mockCustomGoshaExec := mock_common.NewMockICustomGoshaExec(ctrl)
 			testCase.mockBehavior(
 				mockBashService,
+				mockIdempotencyKeyService,
+				mockBashAclService,
 				mockGoshaHelper,
 				mockCustomGoshaExec,
 				testCase.in.ctx,
 				testCase.in.isSync,
 				testCase.in.dto,
+				testCase.in.idempotencyKey,
+			)
+
+			bashUseCase := BashUseCase{
+				service:               mockBashService,
+				idempotencyKeyService: mockIdempotencyKeyService,
+				bashAclService:        mockBashAclService,
+				goshaHelper:           mockGoshaHelper,
+				customGoshaExec:       mockCustomGoshaExec,
+				logger:                logging.GetLogger(logging.ProdMode),
+				httpErrors:            httpErrors,
+			}
+
+			runIds, err := bashUseCase.ExecBashList(
+				testCase.in.ctx,
+				testCase.in.principal,
+				testCase.in.isSync,
+				testCase.in.dto,
+				testCase.in.idempotencyKey,
+			)
+
+			assert.Len(t, runIds, testCase.expected.runIdsCount)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestBashUseCase_ExecBashInline(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx context.Context
+			dto dto.ExecBashInline
+		}
+
+		expectedStruct struct {
+			bashRun *model.BashRun
+			err     error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashRunService, *mock_gosha.MockIHelper, *mock_common.MockICustomGoshaExec, context.Context, dto.ExecBashInline)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.ExecBashInline{
+					Body: "echo $GREETING $1",
+					Args: []string{"world"},
+					Env:  map[string]string{"GREETING": "hello"},
+				},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashRunService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, dto dto.ExecBashInline) {
+				f, err := os.OpenFile(path.Join(bashTestDataDir, bashTestFile), os.O_RDONLY, 0666)
+				if err != nil {
+					t.Fatalf("%s Error: %s", t.Name(), err)
+				}
+
+				gomock.InOrder(
+					mh.EXPECT().GetTmpFile(dto.Body).Return(f, nil),
+					mc.EXPECT().Run(gomock.Any(), false, gomock.Any()),
+					mr.EXPECT().GetOneById(gomock.Any(), gomock.Any()).Return(&model.BashRun{}, nil),
+					mh.EXPECT().RemoveTmpFile(gomock.Any()).Return(nil),
+				)
+			},
+			expected: expectedStruct{
+				bashRun: &model.BashRun{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Empty body error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.ExecBashInline{},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashRunService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, dto dto.ExecBashInline) {
+			},
+			expected: expectedStruct{
+				bashRun: nil,
+				err:     httpErrors.BashExecuteInlineBody,
+			},
+		},
+		{
+			name: "Env name error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.ExecBashInline{
+					Body: "echo $1",
+					Env:  map[string]string{"1GREETING": "hello"},
+				},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashRunService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, dto dto.ExecBashInline) {
+			},
+			expected: expectedStruct{
+				bashRun: nil,
+				err:     httpErrors.BashExecuteInlineEnv,
+			},
+		},
+		{
+			name: "Timeout validation error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.ExecBashInline{
+					Body:           "sleep 100000",
+					TimeoutSeconds: 100000,
+				},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashRunService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, dto dto.ExecBashInline) {
+			},
+			expected: expectedStruct{
+				bashRun: nil,
+				err: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "$.timeoutSeconds", Reason: "max=86400", Value: time.Duration(100000)},
+				}),
+			},
+		},
+		{
+			name: "Executing bash error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.ExecBashInline{
+					Body: "echo $1",
+				},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashRunService, mh *mock_gosha.MockIHelper, mc *mock_common.MockICustomGoshaExec, ctx context.Context, dto dto.ExecBashInline) {
+				mh.EXPECT().GetTmpFile(dto.Body).Return(nil, httpErrors.BashExecute)
+			},
+			expected: expectedStruct{
+				bashRun: nil,
+				err:     httpErrors.BashExecute,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashRunService := mock_service.NewMockIBashRunService(ctrl)
+			mockGoshaHelper := mock_gosha.NewMockIHelper(ctrl)
+			mockCustomGoshaExec := mock_common.NewMockICustomGoshaExec(ctrl)
+			testCase.mockBehavior(
+				mockBashRunService,
+				mockGoshaHelper,
+				mockCustomGoshaExec,
+				testCase.in.ctx,
+				testCase.in.dto,
 			)
 
 			bashUseCase := BashUseCase{
-				service:         mockBashService,
+				bashRunService:  mockBashRunService,
 				goshaHelper:     mockGoshaHelper,
 				customGoshaExec: mockCustomGoshaExec,
 				httpErrors:      httpErrors,
 			}
 
-			err := bashUseCase.ExecBashList(testCase.in.isSync, testCase.in.dto)
+			bashRun, err := bashUseCase.ExecBashInline(testCase.in.ctx, testCase.in.dto, nil)
 
+			assert.Equal(t, testCase.expected.bashRun, bashRun)
 			assert.Equal(t, testCase.expected.err, err)
 		})
 	}
@@ -597,8 +1231,8 @@ func TestBashUseCase_RemoveBashById(t *testing.T) {
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
 				gomock.InOrder(
-					m.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
-					m.EXPECT().RemoveById(ctx, bashId).Return(&model.Bash{}, nil),
+					m.EXPECT().GetOneById(gomock.Any(), bashId).Return(&model.Bash{}, nil),
+					m.EXPECT().RemoveById(gomock.Any(), bashId).Return(&model.Bash{}, nil),
 				)
 			},
 			expected: expectedStruct{
@@ -613,7 +1247,7 @@ func TestBashUseCase_RemoveBashById(t *testing.T) {
 				bashId: uuid.NewV4(),
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
-				m.EXPECT().GetOneById(ctx, bashId).Return(nil, httpErrors.BashDoesNotExists)
+				m.EXPECT().GetOneById(gomock.Any(), bashId).Return(nil, httpErrors.BashDoesNotExists)
 			},
 			expected: expectedStruct{
 				bash: nil,
@@ -628,8 +1262,8 @@ func TestBashUseCase_RemoveBashById(t *testing.T) {
 			},
 			mockBehavior: func(m *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
 				gomock.InOrder(
-					m.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
-					m.EXPECT().RemoveById(ctx, bashId).Return(nil, httpErrors.BashRemove),
+					m.EXPECT().GetOneById(gomock.Any(), bashId).Return(&model.Bash{}, nil),
+					m.EXPECT().RemoveById(gomock.Any(), bashId).Return(nil, httpErrors.BashRemove),
 				)
 			},
 			expected: expectedStruct{
@@ -652,7 +1286,7 @@ func TestBashUseCase_RemoveBashById(t *testing.T) {
 				httpErrors: httpErrors,
 			}
 
-			bash, err := bashUseCase.RemoveBashById(testCase.in.bashId)
+			bash, err := bashUseCase.RemoveBashById(testCase.in.ctx, testCase.in.bashId)
 
 			assert.Equal(t, testCase.expected.bash, bash)
 			assert.Equal(t, testCase.expected.err, err)
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/service"
+	"slices"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashacl.go  -destination=./mock/bashacl.go
+
+type (
+	IBashAclUseCase interface {
+		GetBashAclListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error)
+		CreateBashAcl(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error)
+		RemoveBashAclById(ctx context.Context, bashId uuid.UUID, bashAclId uuid.UUID) (*model.BashAcl, error)
+	}
+
+	BashAclUseCase struct {
+		service     service.IBashAclService
+		bashService service.IBashService
+		httpErrors  *config.HTTPErrors
+	}
+)
+
+// isBashAclAllowed reports whether the principal may execute the script with the acl entries,
+// a script without entries is allowed for everyone and admin is never restricted.
+// A role entry allows the role and the more privileged ones.
+func isBashAclAllowed(principal *model.Principal, bashAclList []*model.BashAcl) bool {
+	if len(bashAclList) == 0 {
+		return true
+	}
+	if principal == nil {
+		return false
+	}
+	if principal.HasRole(model.RoleAdmin) {
+		return true
+	}
+	for _, bashAcl := range bashAclList {
+		if bashAcl.Role != nil && principal.HasRoleAtLeast(*bashAcl.Role) {
+			return true
+		}
+		if bashAcl.Subject != nil && principal.Subject != "" && *bashAcl.Subject == principal.Subject {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *BashAclUseCase) GetBashAclListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error) {
+	_, err := u.bashService.GetOneById(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashDoesNotExists
+	}
+
+	bashAclList, err := u.service.GetListByBashId(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashAclGetListByBashId
+	}
+
+	return bashAclList, nil
+}
+
+func (u *BashAclUseCase) CreateBashAcl(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error) {
+	hasRole := dto.Role != nil
+	hasSubject := dto.Subject != nil
+	if hasRole == hasSubject {
+		return nil, u.httpErrors.BashAclRoleOrSubject
+	}
+	if hasRole && !slices.Contains(model.Roles, *dto.Role) {
+		return nil, u.httpErrors.BashAclRoleOrSubject
+	}
+	if hasSubject && strings.TrimSpace(*dto.Subject) == "" {
+		return nil, u.httpErrors.BashAclRoleOrSubject
+	}
+
+	_, err := u.bashService.GetOneById(ctx, dto.BashId)
+	if err != nil {
+		return nil, u.httpErrors.BashDoesNotExists
+	}
+
+	bashAcl, err := u.service.Create(ctx, dto)
+	if err != nil {
+		return nil, u.httpErrors.BashAclCreate
+	}
+
+	return bashAcl, nil
+}
+
+func (u *BashAclUseCase) RemoveBashAclById(ctx context.Context, bashId uuid.UUID, bashAclId uuid.UUID) (*model.BashAcl, error) {
+	bashAcl, err := u.service.GetOneById(ctx, bashAclId)
+	if err != nil || bashAcl.BashId != bashId {
+		return nil, u.httpErrors.BashAclDoesNotExists
+	}
+
+	bashAcl, err = u.service.RemoveById(ctx, bashAclId)
+	if err != nil {
+		return nil, u.httpErrors.BashAclRemove
+	}
+
+	return bashAcl, nil
+}
+
+func GetBashAclUseCase() IBashAclUseCase {
+	return &BashAclUseCase{
+		service:     service.GetBashAclService(),
+		bashService: service.GetBashService(),
+		httpErrors:  config.GetHTTPErrors(),
+	}
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBashAclUseCase_CreateBashAcl(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx context.Context
+			dto dto.CreateBashAcl
+		}
+
+		expectedStruct struct {
+			bashAcl *model.BashAcl
+			err     error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	operatorRole := model.RoleOperator
+	unknownRole := "owner"
+	subject := uuid.NewV4().String()
+	emptySubject := " "
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashAclService, *mock_service.MockIBashService, context.Context, dto.CreateBashAcl)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success role",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateBashAcl{BashId: uuid.NewV4(), Role: &operatorRole},
+			},
+			mockBehavior: func(ma *mock_service.MockIBashAclService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateBashAcl) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, dto.BashId).Return(&model.Bash{}, nil),
+					ma.EXPECT().Create(ctx, dto).Return(&model.BashAcl{}, nil),
+				)
+			},
+			expected: expectedStruct{
+				bashAcl: &model.BashAcl{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Success subject",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateBashAcl{BashId: uuid.NewV4(), Subject: &subject},
+			},
+			mockBehavior: func(ma *mock_service.MockIBashAclService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateBashAcl) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, dto.BashId).Return(&model.Bash{}, nil),
+					ma.EXPECT().Create(ctx, dto).Return(&model.BashAcl{}, nil),
+				)
+			},
+			expected: expectedStruct{
+				bashAcl: &model.BashAcl{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Validation both role and subject error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateBashAcl{BashId: uuid.NewV4(), Role: &operatorRole, Subject: &subject},
+			},
+			mockBehavior: func(ma *mock_service.MockIBashAclService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateBashAcl) {
+			},
+			expected: expectedStruct{
+				bashAcl: nil,
+				err:     httpErrors.BashAclRoleOrSubject,
+			},
+		},
+		{
+			name: "Validation unknown role error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateBashAcl{BashId: uuid.NewV4(), Role: &unknownRole},
+			},
+			mockBehavior: func(ma *mock_service.MockIBashAclService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateBashAcl) {
+			},
+			expected: expectedStruct{
+				bashAcl: nil,
+				err:     httpErrors.BashAclRoleOrSubject,
+			},
+		},
+		{
+			name: "Validation empty subject error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateBashAcl{BashId: uuid.NewV4(), Subject: &emptySubject},
+			},
+			mockBehavior: func(ma *mock_service.MockIBashAclService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateBashAcl) {
+			},
+			expected: expectedStruct{
+				bashAcl: nil,
+				err:     httpErrors.BashAclRoleOrSubject,
+			},
+		},
+		{
+			name: "Getting bash does not exists error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateBashAcl{BashId: uuid.NewV4(), Role: &operatorRole},
+			},
+			mockBehavior: func(ma *mock_service.MockIBashAclService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateBashAcl) {
+				mb.EXPECT().GetOneById(ctx, dto.BashId).Return(nil, httpErrors.BashDoesNotExists)
+			},
+			expected: expectedStruct{
+				bashAcl: nil,
+				err:     httpErrors.BashDoesNotExists,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashAclService := mock_service.NewMockIBashAclService(ctrl)
+			mockBashService := mock_service.NewMockIBashService(ctrl)
+			testCase.mockBehavior(mockBashAclService, mockBashService, testCase.in.ctx, testCase.in.dto)
+
+			bashAclUseCase := BashAclUseCase{
+				service:     mockBashAclService,
+				bashService: mockBashService,
+				httpErrors:  httpErrors,
+			}
+
+			bashAcl, err := bashAclUseCase.CreateBashAcl(testCase.in.ctx, testCase.in.dto)
+
+			assert.Equal(t, testCase.expected.bashAcl, bashAcl)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
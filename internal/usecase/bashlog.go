@@ -2,58 +2,202 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
 	"pg-sh-scripts/internal/service"
 	"pg-sh-scripts/internal/type/alias"
 	"pg-sh-scripts/pkg/sql/pagination"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	uuid "github.com/satori/go.uuid"
 )
 
+// invalidRegularExpressionCode is the postgres error of the regex filter,
+// the filter is only checked by go before the query and postgres accepts a different regex syntax.
+const invalidRegularExpressionCode = "2201B"
+
 //go:generate mockgen -source=./bashlog.go  -destination=./mock/bashlog.go
 
 type (
 	IBashLogUseCase interface {
 		GetBashLogPaginationPageByBashId(
+			ctx context.Context,
 			bashId uuid.UUID,
+			filter dto.BashLogFilter,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.BashLogLimitOffsetPage, error)
+		GetBashLogPaginationPageByRunId(
+			ctx context.Context,
+			runId uuid.UUID,
 			paginationParams pagination.LimitOffsetParams,
 		) (alias.BashLogLimitOffsetPage, error)
+		GetBashLogKeysetPageByBashId(
+			ctx context.Context,
+			bashId uuid.UUID,
+			filter dto.BashLogFilter,
+			paginationParams pagination.KeysetParams,
+		) (alias.BashLogKeysetPage, error)
+		GetBashLogKeysetPageByRunId(
+			ctx context.Context,
+			runId uuid.UUID,
+			paginationParams pagination.KeysetParams,
+		) (alias.BashLogKeysetPage, error)
+		ExportBashLogByBashId(
+			ctx context.Context,
+			bashId uuid.UUID,
+			filter dto.BashLogFilter,
+			fn func(bashLog *model.BashLog) error,
+		) error
 	}
 
 	BashLogUseCase struct {
-		service     service.IBashLogService
-		bashService service.IBashService
-		httpErrors  *config.HTTPErrors
+		service        service.IBashLogService
+		bashService    service.IBashService
+		bashRunService service.IBashRunService
+		httpErrors     *config.HTTPErrors
 	}
 )
 
 func (u *BashLogUseCase) GetBashLogPaginationPageByBashId(
+	ctx context.Context,
 	bashId uuid.UUID,
+	filter dto.BashLogFilter,
 	paginationParams pagination.LimitOffsetParams,
 ) (alias.BashLogLimitOffsetPage, error) {
 	var bashLogPaginationPage alias.BashLogLimitOffsetPage
 
-	_, err := u.bashService.GetOneById(context.Background(), bashId)
+	_, err := u.bashService.GetOneById(ctx, bashId)
 	if err != nil {
 		return bashLogPaginationPage, u.httpErrors.BashDoesNotExists
 	}
 
 	bashLogPaginationPage, err = u.service.GetPaginationPageByBashId(
-		context.Background(),
+		ctx,
 		bashId,
+		filter,
 		paginationParams,
 	)
 	if err != nil {
+		if isInvalidRegularExpression(err) {
+			return bashLogPaginationPage, u.httpErrors.BashLogFilterRegex
+		}
 		return bashLogPaginationPage, u.httpErrors.BashLogGetPaginationPageByBashId
 	}
 
 	return bashLogPaginationPage, nil
 }
 
+func (u *BashLogUseCase) GetBashLogPaginationPageByRunId(
+	ctx context.Context,
+	runId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.BashLogLimitOffsetPage, error) {
+	var bashLogPaginationPage alias.BashLogLimitOffsetPage
+
+	_, err := u.bashRunService.GetOneById(ctx, runId)
+	if err != nil {
+		return bashLogPaginationPage, u.httpErrors.BashRunDoesNotExists
+	}
+
+	bashLogPaginationPage, err = u.service.GetPaginationPageByRunId(
+		ctx,
+		runId,
+		paginationParams,
+	)
+	if err != nil {
+		return bashLogPaginationPage, u.httpErrors.BashLogGetPaginationPageByRunId
+	}
+
+	return bashLogPaginationPage, nil
+}
+
+func (u *BashLogUseCase) GetBashLogKeysetPageByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	filter dto.BashLogFilter,
+	paginationParams pagination.KeysetParams,
+) (alias.BashLogKeysetPage, error) {
+	var bashLogKeysetPage alias.BashLogKeysetPage
+
+	_, err := u.bashService.GetOneById(ctx, bashId)
+	if err != nil {
+		return bashLogKeysetPage, u.httpErrors.BashDoesNotExists
+	}
+
+	bashLogKeysetPage, err = u.service.GetKeysetPageByBashId(
+		ctx,
+		bashId,
+		filter,
+		paginationParams,
+	)
+	if err != nil {
+		if isInvalidRegularExpression(err) {
+			return bashLogKeysetPage, u.httpErrors.BashLogFilterRegex
+		}
+		return bashLogKeysetPage, u.httpErrors.BashLogGetPaginationPageByBashId
+	}
+
+	return bashLogKeysetPage, nil
+}
+
+func (u *BashLogUseCase) GetBashLogKeysetPageByRunId(
+	ctx context.Context,
+	runId uuid.UUID,
+	paginationParams pagination.KeysetParams,
+) (alias.BashLogKeysetPage, error) {
+	var bashLogKeysetPage alias.BashLogKeysetPage
+
+	_, err := u.bashRunService.GetOneById(ctx, runId)
+	if err != nil {
+		return bashLogKeysetPage, u.httpErrors.BashRunDoesNotExists
+	}
+
+	bashLogKeysetPage, err = u.service.GetKeysetPageByRunId(
+		ctx,
+		runId,
+		paginationParams,
+	)
+	if err != nil {
+		return bashLogKeysetPage, u.httpErrors.BashLogGetPaginationPageByRunId
+	}
+
+	return bashLogKeysetPage, nil
+}
+
+// ExportBashLogByBashId checks the bash script before the first log is passed to fn,
+// so a missing script can still be answered with an http error.
+func (u *BashLogUseCase) ExportBashLogByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	filter dto.BashLogFilter,
+	fn func(bashLog *model.BashLog) error,
+) error {
+	if _, err := u.bashService.GetOneById(ctx, bashId); err != nil {
+		return u.httpErrors.BashDoesNotExists
+	}
+
+	if err := u.service.ExportByBashId(ctx, bashId, filter, fn); err != nil {
+		if isInvalidRegularExpression(err) {
+			return u.httpErrors.BashLogFilterRegex
+		}
+		return u.httpErrors.BashLogExport
+	}
+	return nil
+}
+
+// isInvalidRegularExpression reports whether postgres rejected the regex filter.
+func isInvalidRegularExpression(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == invalidRegularExpressionCode
+}
+
 func GetBashLogUseCase() IBashLogUseCase {
 	return &BashLogUseCase{
-		service:     service.GetBashLogService(),
-		bashService: service.GetBashService(),
-		httpErrors:  config.GetHTTPErrors(),
+		service:        service.GetBashLogService(),
+		bashService:    service.GetBashService(),
+		bashRunService: service.GetBashRunService(),
+		httpErrors:     config.GetHTTPErrors(),
 	}
 }
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
 	"pg-sh-scripts/internal/model"
 	mock_service "pg-sh-scripts/internal/service/mock"
 	"pg-sh-scripts/internal/type/alias"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -20,6 +22,7 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 		inStruct struct {
 			ctx              context.Context
 			bashId           uuid.UUID
+			filter           dto.BashLogFilter
 			paginationParams pagination.LimitOffsetParams
 		}
 
@@ -30,11 +33,12 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 	)
 
 	httpErrors := config.GetHTTPErrors()
+	runId := uuid.NewV4()
 
 	testCases := []struct {
 		name         string
 		in           inStruct
-		mockBehavior func(*mock_service.MockIBashLogService, *mock_service.MockIBashService, context.Context, uuid.UUID, pagination.LimitOffsetParams)
+		mockBehavior func(*mock_service.MockIBashLogService, *mock_service.MockIBashService, context.Context, uuid.UUID, dto.BashLogFilter, pagination.LimitOffsetParams)
 		expected     expectedStruct
 	}{
 		{
@@ -44,12 +48,40 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 				bashId:           uuid.NewV4(),
 				paginationParams: pagination.LimitOffsetParams{},
 			},
-			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) {
 				gomock.InOrder(
 					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
 					mbl.EXPECT().GetPaginationPageByBashId(
 						ctx,
 						bashId,
+						filter,
+						paginationParams,
+					).Return(
+						alias.BashLogLimitOffsetPage{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.BashLogLimitOffsetPage{},
+				err:            nil,
+			},
+		},
+		{
+			name: "Success with run filter",
+			in: inStruct{
+				ctx:              context.Background(),
+				bashId:           uuid.NewV4(),
+				filter:           dto.BashLogFilter{RunId: &runId},
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().GetPaginationPageByBashId(
+						ctx,
+						bashId,
+						filter,
 						paginationParams,
 					).Return(
 						alias.BashLogLimitOffsetPage{},
@@ -69,7 +101,7 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 				bashId:           uuid.NewV4(),
 				paginationParams: pagination.LimitOffsetParams{},
 			},
-			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) {
 				mb.EXPECT().GetOneById(ctx, bashId).Return(nil, httpErrors.BashDoesNotExists)
 			},
 			expected: expectedStruct{
@@ -84,12 +116,13 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 				bashId:           uuid.NewV4(),
 				paginationParams: pagination.LimitOffsetParams{},
 			},
-			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) {
 				gomock.InOrder(
 					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
 					mbl.EXPECT().GetPaginationPageByBashId(
 						ctx,
 						bashId,
+						filter,
 						paginationParams,
 					).Return(
 						alias.BashLogLimitOffsetPage{},
@@ -102,6 +135,32 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 				err:            httpErrors.BashLogGetPaginationPageByBashId,
 			},
 		},
+		{
+			name: "Invalid postgres regex filter",
+			in: inStruct{
+				ctx:              context.Background(),
+				bashId:           uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().GetPaginationPageByBashId(
+						ctx,
+						bashId,
+						filter,
+						paginationParams,
+					).Return(
+						alias.BashLogLimitOffsetPage{},
+						&pgconn.PgError{Code: invalidRegularExpressionCode},
+					),
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.BashLogLimitOffsetPage{},
+				err:            httpErrors.BashLogFilterRegex,
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -116,6 +175,7 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 				mockBashService,
 				testCase.in.ctx,
 				testCase.in.bashId,
+				testCase.in.filter,
 				testCase.in.paginationParams,
 			)
 
@@ -126,7 +186,9 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 			}
 
 			bashLogPaginationPage, err := bashLogUseCase.GetBashLogPaginationPageByBashId(
+				testCase.in.ctx,
 				testCase.in.bashId,
+				testCase.in.filter,
 				testCase.in.paginationParams,
 			)
 
@@ -135,3 +197,384 @@ func TestBashLogUseCase_GetBashLogPaginationPageByBashId(t *testing.T) {
 		})
 	}
 }
+
+func TestBashLogUseCase_GetBashLogKeysetPageByBashId(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx              context.Context
+			bashId           uuid.UUID
+			filter           dto.BashLogFilter
+			paginationParams pagination.KeysetParams
+		}
+
+		expectedStruct struct {
+			keysetPage alias.BashLogKeysetPage
+			err        error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+	runId := uuid.NewV4()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashLogService, *mock_service.MockIBashService, context.Context, uuid.UUID, dto.BashLogFilter, pagination.KeysetParams)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:              context.Background(),
+				bashId:           uuid.NewV4(),
+				paginationParams: pagination.KeysetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.KeysetParams) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().GetKeysetPageByBashId(
+						ctx,
+						bashId,
+						filter,
+						paginationParams,
+					).Return(
+						alias.BashLogKeysetPage{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				keysetPage: alias.BashLogKeysetPage{},
+				err:        nil,
+			},
+		},
+		{
+			name: "Success with run filter",
+			in: inStruct{
+				ctx:              context.Background(),
+				bashId:           uuid.NewV4(),
+				filter:           dto.BashLogFilter{RunId: &runId},
+				paginationParams: pagination.KeysetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.KeysetParams) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().GetKeysetPageByBashId(
+						ctx,
+						bashId,
+						filter,
+						paginationParams,
+					).Return(
+						alias.BashLogKeysetPage{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				keysetPage: alias.BashLogKeysetPage{},
+				err:        nil,
+			},
+		},
+		{
+			name: "Bash does not exists",
+			in: inStruct{
+				ctx:              context.Background(),
+				bashId:           uuid.NewV4(),
+				paginationParams: pagination.KeysetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.KeysetParams) {
+				mb.EXPECT().GetOneById(ctx, bashId).Return(nil, httpErrors.BashDoesNotExists)
+			},
+			expected: expectedStruct{
+				keysetPage: alias.BashLogKeysetPage{},
+				err:        httpErrors.BashDoesNotExists,
+			},
+		},
+		{
+			name: "Getting bash log keyset page error",
+			in: inStruct{
+				ctx:              context.Background(),
+				bashId:           uuid.NewV4(),
+				paginationParams: pagination.KeysetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.KeysetParams) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().GetKeysetPageByBashId(
+						ctx,
+						bashId,
+						filter,
+						paginationParams,
+					).Return(
+						alias.BashLogKeysetPage{},
+						httpErrors.BashLogGetPaginationPageByBashId,
+					),
+				)
+			},
+			expected: expectedStruct{
+				keysetPage: alias.BashLogKeysetPage{},
+				err:        httpErrors.BashLogGetPaginationPageByBashId,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashService := mock_service.NewMockIBashService(ctrl)
+			mockBashLogService := mock_service.NewMockIBashLogService(ctrl)
+			testCase.mockBehavior(
+				mockBashLogService,
+				mockBashService,
+				testCase.in.ctx,
+				testCase.in.bashId,
+				testCase.in.filter,
+				testCase.in.paginationParams,
+			)
+
+			bashLogUseCase := BashLogUseCase{
+				service:     mockBashLogService,
+				bashService: mockBashService,
+				httpErrors:  httpErrors,
+			}
+
+			bashLogKeysetPage, err := bashLogUseCase.GetBashLogKeysetPageByBashId(
+				testCase.in.ctx,
+				testCase.in.bashId,
+				testCase.in.filter,
+				testCase.in.paginationParams,
+			)
+
+			assert.Equal(t, testCase.expected.keysetPage, bashLogKeysetPage)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestBashLogUseCase_GetBashLogPaginationPageByRunId(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx              context.Context
+			runId            uuid.UUID
+			paginationParams pagination.LimitOffsetParams
+		}
+
+		expectedStruct struct {
+			paginationPage alias.BashLogLimitOffsetPage
+			err            error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashLogService, *mock_service.MockIBashRunService, context.Context, uuid.UUID, pagination.LimitOffsetParams)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:              context.Background(),
+				runId:            uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mbr *mock_service.MockIBashRunService, ctx context.Context, runId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+				gomock.InOrder(
+					mbr.EXPECT().GetOneById(ctx, runId).Return(&model.BashRun{}, nil),
+					mbl.EXPECT().GetPaginationPageByRunId(
+						ctx,
+						runId,
+						paginationParams,
+					).Return(
+						alias.BashLogLimitOffsetPage{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.BashLogLimitOffsetPage{},
+				err:            nil,
+			},
+		},
+		{
+			name: "Bash run does not exists",
+			in: inStruct{
+				ctx:              context.Background(),
+				runId:            uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mbr *mock_service.MockIBashRunService, ctx context.Context, runId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+				mbr.EXPECT().GetOneById(ctx, runId).Return(nil, httpErrors.BashRunDoesNotExists)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.BashLogLimitOffsetPage{},
+				err:            httpErrors.BashRunDoesNotExists,
+			},
+		},
+		{
+			name: "Getting bash log pagination page error",
+			in: inStruct{
+				ctx:              context.Background(),
+				runId:            uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mbr *mock_service.MockIBashRunService, ctx context.Context, runId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+				gomock.InOrder(
+					mbr.EXPECT().GetOneById(ctx, runId).Return(&model.BashRun{}, nil),
+					mbl.EXPECT().GetPaginationPageByRunId(
+						ctx,
+						runId,
+						paginationParams,
+					).Return(
+						alias.BashLogLimitOffsetPage{},
+						httpErrors.BashLogGetPaginationPageByRunId,
+					),
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.BashLogLimitOffsetPage{},
+				err:            httpErrors.BashLogGetPaginationPageByRunId,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashRunService := mock_service.NewMockIBashRunService(ctrl)
+			mockBashLogService := mock_service.NewMockIBashLogService(ctrl)
+			testCase.mockBehavior(
+				mockBashLogService,
+				mockBashRunService,
+				testCase.in.ctx,
+				testCase.in.runId,
+				testCase.in.paginationParams,
+			)
+
+			bashLogUseCase := BashLogUseCase{
+				service:        mockBashLogService,
+				bashRunService: mockBashRunService,
+				httpErrors:     httpErrors,
+			}
+
+			bashLogPaginationPage, err := bashLogUseCase.GetBashLogPaginationPageByRunId(
+				testCase.in.ctx,
+				testCase.in.runId,
+				testCase.in.paginationParams,
+			)
+
+			assert.Equal(t, testCase.expected.paginationPage, bashLogPaginationPage)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestBashLogUseCase_ExportBashLogByBashId(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx    context.Context
+			bashId uuid.UUID
+			filter dto.BashLogFilter
+		}
+
+		expectedStruct struct {
+			err error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashLogService, *mock_service.MockIBashService, context.Context, uuid.UUID, dto.BashLogFilter)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:    context.Background(),
+				bashId: uuid.NewV4(),
+				filter: dto.BashLogFilter{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().ExportByBashId(ctx, bashId, filter, gomock.Any()).Return(nil),
+				)
+			},
+			expected: expectedStruct{
+				err: nil,
+			},
+		},
+		{
+			name: "Bash does not exists",
+			in: inStruct{
+				ctx:    context.Background(),
+				bashId: uuid.NewV4(),
+				filter: dto.BashLogFilter{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter) {
+				mb.EXPECT().GetOneById(ctx, bashId).Return(nil, httpErrors.BashDoesNotExists)
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashDoesNotExists,
+			},
+		},
+		{
+			name: "Exporting bash logs error",
+			in: inStruct{
+				ctx:    context.Background(),
+				bashId: uuid.NewV4(),
+				filter: dto.BashLogFilter{},
+			},
+			mockBehavior: func(mbl *mock_service.MockIBashLogService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					mbl.EXPECT().ExportByBashId(ctx, bashId, filter, gomock.Any()).Return(context.Canceled),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashLogExport,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashService := mock_service.NewMockIBashService(ctrl)
+			mockBashLogService := mock_service.NewMockIBashLogService(ctrl)
+			testCase.mockBehavior(
+				mockBashLogService,
+				mockBashService,
+				testCase.in.ctx,
+				testCase.in.bashId,
+				testCase.in.filter,
+			)
+
+			bashLogUseCase := BashLogUseCase{
+				service:     mockBashLogService,
+				bashService: mockBashService,
+				httpErrors:  httpErrors,
+			}
+
+			err := bashLogUseCase.ExportBashLogByBashId(
+				testCase.in.ctx,
+				testCase.in.bashId,
+				testCase.in.filter,
+				func(bashLog *model.BashLog) error { return nil },
+			)
+
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/pkg/validation"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashlogretention.go  -destination=./mock/bashlogretention.go
+
+type (
+	IBashLogRetentionUseCase interface {
+		GetBashLogRetentionByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error)
+		SetBashLogRetention(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error)
+		RemoveBashLogRetentionByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error)
+		PruneBashLog(ctx context.Context, dryRun bool) (*schema.BashLogPrune, error)
+	}
+
+	BashLogRetentionUseCase struct {
+		service     service.IBashLogRetentionService
+		bashService service.IBashService
+		httpErrors  *config.HTTPErrors
+		policy      dto.BashLogRetentionPolicy
+		batchSize   int
+	}
+)
+
+func (u *BashLogRetentionUseCase) GetBashLogRetentionByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+) (*model.BashLogRetention, error) {
+	_, err := u.bashService.GetOneById(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashDoesNotExists
+	}
+
+	bashLogRetention, err := u.service.GetOneByBashId(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashLogRetentionDoesNotExists
+	}
+
+	return bashLogRetention, nil
+}
+
+func (u *BashLogRetentionUseCase) SetBashLogRetention(
+	ctx context.Context,
+	dto dto.SetBashLogRetention,
+) (*model.BashLogRetention, error) {
+	if fieldErrors := validation.ValidateStruct(dto); len(fieldErrors) > 0 {
+		return nil, schema.WithFieldErrors(u.httpErrors.Validate, fieldErrors)
+	}
+
+	_, err := u.bashService.GetOneById(ctx, dto.BashId)
+	if err != nil {
+		return nil, u.httpErrors.BashDoesNotExists
+	}
+
+	bashLogRetention, err := u.service.Set(ctx, dto)
+	if err != nil {
+		return nil, u.httpErrors.BashLogRetentionSet
+	}
+
+	return bashLogRetention, nil
+}
+
+func (u *BashLogRetentionUseCase) RemoveBashLogRetentionByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+) (*model.BashLogRetention, error) {
+	_, err := u.service.GetOneByBashId(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashLogRetentionDoesNotExists
+	}
+
+	bashLogRetention, err := u.service.RemoveByBashId(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashLogRetentionRemove
+	}
+
+	return bashLogRetention, nil
+}
+
+// PruneBashLog removes the bash logs outside the retention right away,
+// the dry run only counts the logs which would be removed.
+func (u *BashLogRetentionUseCase) PruneBashLog(ctx context.Context, dryRun bool) (*schema.BashLogPrune, error) {
+	if dryRun {
+		prunableCount, err := u.service.CountPrunable(ctx, u.policy)
+		if err != nil {
+			return nil, u.httpErrors.BashLogPrune
+		}
+		return &schema.BashLogPrune{DryRun: true, Count: prunableCount}, nil
+	}
+
+	removedCount, err := u.service.Prune(ctx, u.policy, u.batchSize)
+	if err != nil {
+		return nil, u.httpErrors.BashLogPrune
+	}
+	return &schema.BashLogPrune{DryRun: false, Count: removedCount}, nil
+}
+
+func GetBashLogRetentionUseCase() IBashLogRetentionUseCase {
+	cfg := config.GetConfig()
+
+	return &BashLogRetentionUseCase{
+		service:     service.GetBashLogRetentionService(),
+		bashService: service.GetBashService(),
+		httpErrors:  config.GetHTTPErrors(),
+		policy:      dto.GetBashLogRetentionPolicy(cfg.Retention),
+		batchSize:   cfg.Retention.BatchSize,
+	}
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	"pg-sh-scripts/pkg/validation"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBashLogRetentionUseCase_SetBashLogRetention(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx context.Context
+			dto dto.SetBashLogRetention
+		}
+
+		expectedStruct struct {
+			bashLogRetention *model.BashLogRetention
+			err              error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	maxAgeSeconds := int64(604800)
+	keepRuns := int64(10)
+	negativeMaxRows := int64(-1)
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashLogRetentionService, *mock_service.MockIBashService, context.Context, dto.SetBashLogRetention)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.SetBashLogRetention{BashId: uuid.NewV4(), MaxAgeSeconds: &maxAgeSeconds, KeepRuns: &keepRuns},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.SetBashLogRetention) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, dto.BashId).Return(&model.Bash{}, nil),
+					mr.EXPECT().Set(ctx, dto).Return(&model.BashLogRetention{}, nil),
+				)
+			},
+			expected: expectedStruct{
+				bashLogRetention: &model.BashLogRetention{},
+				err:              nil,
+			},
+		},
+		{
+			name: "Validation negative limit error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.SetBashLogRetention{BashId: uuid.NewV4(), MaxRows: &negativeMaxRows},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.SetBashLogRetention) {
+			},
+			expected: expectedStruct{
+				bashLogRetention: nil,
+				err: schema.WithFieldErrors(httpErrors.Validate, []*validation.FieldError{
+					{Field: "$.maxRows", Reason: "gte=0", Value: int64(-1)},
+				}),
+			},
+		},
+		{
+			name: "Bash does not exists",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.SetBashLogRetention{BashId: uuid.NewV4()},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.SetBashLogRetention) {
+				mb.EXPECT().GetOneById(ctx, dto.BashId).Return(nil, httpErrors.BashDoesNotExists)
+			},
+			expected: expectedStruct{
+				bashLogRetention: nil,
+				err:              httpErrors.BashDoesNotExists,
+			},
+		},
+		{
+			name: "Setting bash log retention error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.SetBashLogRetention{BashId: uuid.NewV4(), KeepRuns: &keepRuns},
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.SetBashLogRetention) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, dto.BashId).Return(&model.Bash{}, nil),
+					mr.EXPECT().Set(ctx, dto).Return(nil, errors.New("set error")),
+				)
+			},
+			expected: expectedStruct{
+				bashLogRetention: nil,
+				err:              httpErrors.BashLogRetentionSet,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashLogRetentionService := mock_service.NewMockIBashLogRetentionService(ctrl)
+			mockBashService := mock_service.NewMockIBashService(ctrl)
+			testCase.mockBehavior(mockBashLogRetentionService, mockBashService, testCase.in.ctx, testCase.in.dto)
+
+			bashLogRetentionUseCase := BashLogRetentionUseCase{
+				service:     mockBashLogRetentionService,
+				bashService: mockBashService,
+				httpErrors:  httpErrors,
+			}
+
+			bashLogRetention, err := bashLogRetentionUseCase.SetBashLogRetention(testCase.in.ctx, testCase.in.dto)
+
+			assert.Equal(t, testCase.expected.bashLogRetention, bashLogRetention)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestBashLogRetentionUseCase_PruneBashLog(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx    context.Context
+			dryRun bool
+		}
+
+		expectedStruct struct {
+			bashLogPrune *schema.BashLogPrune
+			err          error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	policy := dto.BashLogRetentionPolicy{MaxAgeSeconds: 2592000, KeepRuns: 5}
+	batchSize := 1000
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashLogRetentionService, context.Context)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:    context.Background(),
+				dryRun: false,
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, ctx context.Context) {
+				mr.EXPECT().Prune(ctx, policy, batchSize).Return(int64(2500), nil)
+			},
+			expected: expectedStruct{
+				bashLogPrune: &schema.BashLogPrune{DryRun: false, Count: 2500},
+				err:          nil,
+			},
+		},
+		{
+			name: "Success dry run",
+			in: inStruct{
+				ctx:    context.Background(),
+				dryRun: true,
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, ctx context.Context) {
+				mr.EXPECT().CountPrunable(ctx, policy).Return(int64(2500), nil)
+			},
+			expected: expectedStruct{
+				bashLogPrune: &schema.BashLogPrune{DryRun: true, Count: 2500},
+				err:          nil,
+			},
+		},
+		{
+			name: "Pruning bash logs error",
+			in: inStruct{
+				ctx:    context.Background(),
+				dryRun: false,
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, ctx context.Context) {
+				mr.EXPECT().Prune(ctx, policy, batchSize).Return(int64(1000), context.Canceled)
+			},
+			expected: expectedStruct{
+				bashLogPrune: nil,
+				err:          httpErrors.BashLogPrune,
+			},
+		},
+		{
+			name: "Counting prunable bash logs error",
+			in: inStruct{
+				ctx:    context.Background(),
+				dryRun: true,
+			},
+			mockBehavior: func(mr *mock_service.MockIBashLogRetentionService, ctx context.Context) {
+				mr.EXPECT().CountPrunable(ctx, policy).Return(int64(0), errors.New("count error"))
+			},
+			expected: expectedStruct{
+				bashLogPrune: nil,
+				err:          httpErrors.BashLogPrune,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashLogRetentionService := mock_service.NewMockIBashLogRetentionService(ctrl)
+			testCase.mockBehavior(mockBashLogRetentionService, testCase.in.ctx)
+
+			bashLogRetentionUseCase := BashLogRetentionUseCase{
+				service:    mockBashLogRetentionService,
+				httpErrors: httpErrors,
+				policy:     policy,
+				batchSize:  batchSize,
+			}
+
+			bashLogPrune, err := bashLogRetentionUseCase.PruneBashLog(testCase.in.ctx, testCase.in.dryRun)
+
+			assert.Equal(t, testCase.expected.bashLogPrune, bashLogPrune)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashrun.go  -destination=./mock/bashrun.go
+
+type (
+	IBashRunUseCase interface {
+		GetBashRunPaginationPageByBashId(
+			ctx context.Context,
+			bashId uuid.UUID,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.BashRunLimitOffsetPage, error)
+	}
+
+	BashRunUseCase struct {
+		service     service.IBashRunService
+		bashService service.IBashService
+		httpErrors  *config.HTTPErrors
+	}
+)
+
+func (u *BashRunUseCase) GetBashRunPaginationPageByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.BashRunLimitOffsetPage, error) {
+	var bashRunPaginationPage alias.BashRunLimitOffsetPage
+
+	_, err := u.bashService.GetOneById(ctx, bashId)
+	if err != nil {
+		return bashRunPaginationPage, u.httpErrors.BashDoesNotExists
+	}
+
+	bashRunPaginationPage, err = u.service.GetPaginationPageByBashId(
+		ctx,
+		bashId,
+		paginationParams,
+	)
+	if err != nil {
+		return bashRunPaginationPage, u.httpErrors.BashRunGetPaginationPageByBashId
+	}
+
+	return bashRunPaginationPage, nil
+}
+
+func GetBashRunUseCase() IBashRunUseCase {
+	return &BashRunUseCase{
+		service:     service.GetBashRunService(),
+		bashService: service.GetBashService(),
+		httpErrors:  config.GetHTTPErrors(),
+	}
+}
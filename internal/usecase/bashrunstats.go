@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/pkg/stats"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./bashrunstats.go  -destination=./mock/bashrunstats.go
+
+type (
+	IBashRunStatsUseCase interface {
+		GetBashRunStatsByBashId(ctx context.Context, bashId uuid.UUID, windowNames []string) (*schema.BashStats, error)
+		GetBashRunStatsOverview(ctx context.Context, windowName string) (*schema.StatsOverview, error)
+	}
+
+	BashRunStatsUseCase struct {
+		service     service.IBashRunStatsService
+		bashService service.IBashService
+		httpErrors  *config.HTTPErrors
+		windows     []stats.Window
+		rollup      bool
+	}
+)
+
+// getWindow returns the configured window by its name.
+func (u *BashRunStatsUseCase) getWindow(name string) (stats.Window, error) {
+	for _, window := range u.windows {
+		if window.Name == name {
+			return window, nil
+		}
+	}
+	return stats.Window{}, u.httpErrors.BashRunStatsWindowParam
+}
+
+// GetBashRunStatsByBashId aggregates the runs of the bash script over the named windows,
+// all configured windows are used without names.
+func (u *BashRunStatsUseCase) GetBashRunStatsByBashId(
+	ctx context.Context,
+	bashId uuid.UUID,
+	windowNames []string,
+) (*schema.BashStats, error) {
+	windows := u.windows
+	if len(windowNames) > 0 {
+		windows = make([]stats.Window, 0, len(windowNames))
+		for _, windowName := range windowNames {
+			window, err := u.getWindow(windowName)
+			if err != nil {
+				return nil, err
+			}
+			windows = append(windows, window)
+		}
+	}
+
+	_, err := u.bashService.GetOneById(ctx, bashId)
+	if err != nil {
+		return nil, u.httpErrors.BashDoesNotExists
+	}
+
+	bashRunWindowStatsList, err := u.service.GetWindowListByBashId(ctx, bashId, windows)
+	if err != nil {
+		return nil, u.httpErrors.BashRunStatsGetByBashId
+	}
+
+	return &schema.BashStats{BashId: bashId, Windows: bashRunWindowStatsList}, nil
+}
+
+// GetBashRunStatsOverview aggregates the runs of all scripts over the named window, the first configured
+// window is used without name. The overview is read from the rollup when it's enabled
+// and is aggregated from the runs until the window is rolled up.
+func (u *BashRunStatsUseCase) GetBashRunStatsOverview(ctx context.Context, windowName string) (*schema.StatsOverview, error) {
+	if windowName == "" && len(u.windows) > 0 {
+		windowName = u.windows[0].Name
+	}
+	window, err := u.getWindow(windowName)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.rollup {
+		bashRunStatsRollup, err := u.service.GetRolledUpTotal(ctx, window)
+		if err == nil {
+			bashRunScriptStatsList, err := u.service.GetRolledUpScriptList(ctx, window)
+			if err != nil {
+				return nil, u.httpErrors.BashRunStatsGetOverview
+			}
+
+			return &schema.StatsOverview{
+				BashRunWindowStats: bashRunStatsRollup.BashRunWindowStats,
+				RolledUpAt:         &bashRunStatsRollup.RolledUpAt,
+				Scripts:            bashRunScriptStatsList,
+			}, nil
+		}
+	}
+
+	bashRunWindowStats, err := u.service.GetTotal(ctx, window)
+	if err != nil {
+		return nil, u.httpErrors.BashRunStatsGetOverview
+	}
+
+	bashRunScriptStatsList, err := u.service.GetScriptList(ctx, window)
+	if err != nil {
+		return nil, u.httpErrors.BashRunStatsGetOverview
+	}
+
+	return &schema.StatsOverview{
+		BashRunWindowStats: *bashRunWindowStats,
+		Scripts:            bashRunScriptStatsList,
+	}, nil
+}
+
+func GetBashRunStatsUseCase() IBashRunStatsUseCase {
+	cfg := config.GetConfig()
+
+	return &BashRunStatsUseCase{
+		service:     service.GetBashRunStatsService(),
+		bashService: service.GetBashService(),
+		httpErrors:  config.GetHTTPErrors(),
+		windows:     cfg.Stats.Windows,
+		rollup:      cfg.Stats.RollupIntervalSeconds > 0,
+	}
+}
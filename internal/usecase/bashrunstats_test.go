@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	"pg-sh-scripts/pkg/stats"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testDayWindow  = stats.Window{Name: "24h", Duration: 24 * time.Hour}
+	testWeekWindow = stats.Window{Name: "7d", Duration: 7 * 24 * time.Hour}
+)
+
+func TestBashRunStatsUseCase_GetBashRunStatsByBashId(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx         context.Context
+			bashId      uuid.UUID
+			windowNames []string
+		}
+
+		expectedStruct struct {
+			bashStats *schema.BashStats
+			err       error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	bashId := uuid.NewV4()
+	weekStats := []*model.BashRunWindowStats{{Window: "7d", BashRunStats: model.BashRunStats{Total: 3, Success: 2}}}
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashRunStatsService, *mock_service.MockIBashService, context.Context, uuid.UUID)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success with configured windows",
+			in:   inStruct{ctx: context.Background(), bashId: bashId},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					ms.EXPECT().
+						GetWindowListByBashId(ctx, bashId, []stats.Window{testDayWindow, testWeekWindow}).
+						Return(weekStats, nil),
+				)
+			},
+			expected: expectedStruct{
+				bashStats: &schema.BashStats{BashId: bashId, Windows: weekStats},
+			},
+		},
+		{
+			name: "Success with named windows",
+			in:   inStruct{ctx: context.Background(), bashId: bashId, windowNames: []string{"7d"}},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					ms.EXPECT().GetWindowListByBashId(ctx, bashId, []stats.Window{testWeekWindow}).Return(weekStats, nil),
+				)
+			},
+			expected: expectedStruct{
+				bashStats: &schema.BashStats{BashId: bashId, Windows: weekStats},
+			},
+		},
+		{
+			name: "Unknown window error",
+			in:   inStruct{ctx: context.Background(), bashId: bashId, windowNames: []string{"7d", "1y"}},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashRunStatsWindowParam,
+			},
+		},
+		{
+			name: "Bash does not exists",
+			in:   inStruct{ctx: context.Background(), bashId: bashId},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
+				mb.EXPECT().GetOneById(ctx, bashId).Return(nil, errors.New("not found"))
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashDoesNotExists,
+			},
+		},
+		{
+			name: "Getting bash run stats error",
+			in:   inStruct{ctx: context.Background(), bashId: bashId},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, mb *mock_service.MockIBashService, ctx context.Context, bashId uuid.UUID) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, bashId).Return(&model.Bash{}, nil),
+					ms.EXPECT().GetWindowListByBashId(ctx, bashId, gomock.Any()).Return(nil, errors.New("stats error")),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashRunStatsGetByBashId,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashRunStatsService := mock_service.NewMockIBashRunStatsService(ctrl)
+			mockBashService := mock_service.NewMockIBashService(ctrl)
+			testCase.mockBehavior(mockBashRunStatsService, mockBashService, testCase.in.ctx, testCase.in.bashId)
+
+			bashRunStatsUseCase := BashRunStatsUseCase{
+				service:     mockBashRunStatsService,
+				bashService: mockBashService,
+				httpErrors:  httpErrors,
+				windows:     []stats.Window{testDayWindow, testWeekWindow},
+			}
+
+			bashStats, err := bashRunStatsUseCase.GetBashRunStatsByBashId(
+				testCase.in.ctx,
+				testCase.in.bashId,
+				testCase.in.windowNames,
+			)
+
+			assert.Equal(t, testCase.expected.bashStats, bashStats)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestBashRunStatsUseCase_GetBashRunStatsOverview(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx        context.Context
+			windowName string
+			rollup     bool
+		}
+
+		expectedStruct struct {
+			statsOverview *schema.StatsOverview
+			err           error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	rolledUpAt := time.Date(2024, 4, 14, 15, 45, 0, 0, time.UTC)
+	dayTotal := model.BashRunWindowStats{Window: "24h", BashRunStats: model.BashRunStats{Total: 5, Failure: 1}}
+	weekTotal := model.BashRunWindowStats{Window: "7d", BashRunStats: model.BashRunStats{Total: 9, Failure: 2}}
+	scripts := []*model.BashRunScriptStats{{BashId: uuid.NewV4(), Title: "backup", BashRunStats: model.BashRunStats{Total: 5}}}
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIBashRunStatsService, context.Context)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success with default window",
+			in:   inStruct{ctx: context.Background()},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, ctx context.Context) {
+				gomock.InOrder(
+					ms.EXPECT().GetTotal(ctx, testDayWindow).Return(&dayTotal, nil),
+					ms.EXPECT().GetScriptList(ctx, testDayWindow).Return(scripts, nil),
+				)
+			},
+			expected: expectedStruct{
+				statsOverview: &schema.StatsOverview{BashRunWindowStats: dayTotal, Scripts: scripts},
+			},
+		},
+		{
+			name: "Success from rollup",
+			in:   inStruct{ctx: context.Background(), windowName: "7d", rollup: true},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, ctx context.Context) {
+				gomock.InOrder(
+					ms.EXPECT().
+						GetRolledUpTotal(ctx, testWeekWindow).
+						Return(&model.BashRunStatsRollup{BashRunWindowStats: weekTotal, RolledUpAt: rolledUpAt}, nil),
+					ms.EXPECT().GetRolledUpScriptList(ctx, testWeekWindow).Return(scripts, nil),
+				)
+			},
+			expected: expectedStruct{
+				statsOverview: &schema.StatsOverview{BashRunWindowStats: weekTotal, RolledUpAt: &rolledUpAt, Scripts: scripts},
+			},
+		},
+		{
+			name: "Success without rollup of window",
+			in:   inStruct{ctx: context.Background(), windowName: "7d", rollup: true},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, ctx context.Context) {
+				gomock.InOrder(
+					ms.EXPECT().GetRolledUpTotal(ctx, testWeekWindow).Return(nil, errors.New("no rows")),
+					ms.EXPECT().GetTotal(ctx, testWeekWindow).Return(&weekTotal, nil),
+					ms.EXPECT().GetScriptList(ctx, testWeekWindow).Return(scripts, nil),
+				)
+			},
+			expected: expectedStruct{
+				statsOverview: &schema.StatsOverview{BashRunWindowStats: weekTotal, Scripts: scripts},
+			},
+		},
+		{
+			name:         "Unknown window error",
+			in:           inStruct{ctx: context.Background(), windowName: "1y"},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, ctx context.Context) {},
+			expected: expectedStruct{
+				err: httpErrors.BashRunStatsWindowParam,
+			},
+		},
+		{
+			name: "Getting scripts stats error",
+			in:   inStruct{ctx: context.Background(), windowName: "7d"},
+			mockBehavior: func(ms *mock_service.MockIBashRunStatsService, ctx context.Context) {
+				gomock.InOrder(
+					ms.EXPECT().GetTotal(ctx, testWeekWindow).Return(&weekTotal, nil),
+					ms.EXPECT().GetScriptList(ctx, testWeekWindow).Return(nil, errors.New("stats error")),
+				)
+			},
+			expected: expectedStruct{
+				err: httpErrors.BashRunStatsGetOverview,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBashRunStatsService := mock_service.NewMockIBashRunStatsService(ctrl)
+			testCase.mockBehavior(mockBashRunStatsService, testCase.in.ctx)
+
+			bashRunStatsUseCase := BashRunStatsUseCase{
+				service:    mockBashRunStatsService,
+				httpErrors: httpErrors,
+				windows:    []stats.Window{testDayWindow, testWeekWindow},
+				rollup:     testCase.in.rollup,
+			}
+
+			statsOverview, err := bashRunStatsUseCase.GetBashRunStatsOverview(testCase.in.ctx, testCase.in.windowName)
+
+			assert.Equal(t, testCase.expected.statsOverview, statsOverview)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
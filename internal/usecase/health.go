@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"pg-sh-scripts/internal/common"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/schema"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/pkg/gosha"
+	"time"
+)
+
+//go:generate mockgen -source=./health.go  -destination=./mock/health.go
+
+const (
+	healthCheckDatabase   = "database"
+	healthCheckMigrations = "migrations"
+	healthCheckTmpDir     = "tmpDir"
+	healthCheckExecutor   = "executor"
+)
+
+type (
+	IHealthUseCase interface {
+		GetLiveness(ctx context.Context) *schema.Health
+		GetReadiness(ctx context.Context) *schema.Health
+	}
+
+	HealthUseCase struct {
+		service           service.IHealthService
+		goshaHelper       gosha.IHelper
+		executionGroup    common.IExecutionGroup
+		checkTimeout      time.Duration
+		maxRunningScripts int64
+	}
+)
+
+func getHealthCheck(err error) *schema.HealthCheck {
+	if err != nil {
+		return &schema.HealthCheck{Status: schema.HealthStatusDown, Detail: err.Error()}
+	}
+	return &schema.HealthCheck{Status: schema.HealthStatusUp}
+}
+
+// GetLiveness only reports that the process is able to serve requests,
+// dependencies are checked by GetReadiness, so an unavailable database does not restart the app.
+func (u *HealthUseCase) GetLiveness(_ context.Context) *schema.Health {
+	return &schema.Health{Status: schema.HealthStatusUp}
+}
+
+func (u *HealthUseCase) GetReadiness(ctx context.Context) *schema.Health {
+	ctx, cancel := context.WithTimeout(ctx, u.checkTimeout)
+	defer cancel()
+
+	health := &schema.Health{
+		Status: schema.HealthStatusUp,
+		Checks: map[string]*schema.HealthCheck{
+			healthCheckDatabase:   getHealthCheck(u.service.Ping(ctx)),
+			healthCheckMigrations: u.getMigrationsCheck(ctx),
+			healthCheckTmpDir:     getHealthCheck(u.goshaHelper.CheckTmpDir()),
+			healthCheckExecutor:   u.getExecutorCheck(),
+		},
+	}
+	for _, check := range health.Checks {
+		if check.Status != schema.HealthStatusUp {
+			health.Status = schema.HealthStatusDown
+		}
+	}
+	return health
+}
+
+func (u *HealthUseCase) getMigrationsCheck(ctx context.Context) *schema.HealthCheck {
+	migrationStatus, err := u.service.GetMigrationStatus(ctx)
+	if err != nil {
+		return getHealthCheck(err)
+	}
+
+	detail := fmt.Sprintf("version %d of %d", migrationStatus.CurrentVersion, migrationStatus.LatestVersion)
+	if migrationStatus.CurrentVersion < migrationStatus.LatestVersion {
+		return &schema.HealthCheck{Status: schema.HealthStatusDown, Detail: detail}
+	}
+	return &schema.HealthCheck{Status: schema.HealthStatusUp, Detail: detail}
+}
+
+// getExecutorCheck is down while the server is shutting down
+// or when the running scripts reach maxRunningScripts, zero means no limit.
+func (u *HealthUseCase) getExecutorCheck() *schema.HealthCheck {
+	if u.executionGroup.Context().Err() != nil {
+		return &schema.HealthCheck{Status: schema.HealthStatusDown, Detail: "executions are interrupted"}
+	}
+
+	running := u.executionGroup.Running()
+	if u.maxRunningScripts <= 0 {
+		return &schema.HealthCheck{Status: schema.HealthStatusUp, Detail: fmt.Sprintf("%d scripts are running", running)}
+	}
+
+	detail := fmt.Sprintf("%d of %d scripts are running", running, u.maxRunningScripts)
+	if running >= u.maxRunningScripts {
+		return &schema.HealthCheck{Status: schema.HealthStatusDown, Detail: detail}
+	}
+	return &schema.HealthCheck{Status: schema.HealthStatusUp, Detail: detail}
+}
+
+func GetHealthUseCase() IHealthUseCase {
+	cfg := config.GetConfig()
+
+	return &HealthUseCase{
+		service:           service.GetHealthService(),
+		goshaHelper:       gosha.GetHelper(),
+		executionGroup:    common.GetExecutionGroup(),
+		checkTimeout:      cfg.Health.CheckTimeoutSeconds,
+		maxRunningScripts: cfg.Health.MaxRunningScripts,
+	}
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	mock_common "pg-sh-scripts/internal/common/mock"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/schema"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	mock_gosha "pg-sh-scripts/pkg/gosha/mock"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthUseCase_GetLiveness(t *testing.T) {
+	healthUseCase := HealthUseCase{}
+
+	health := healthUseCase.GetLiveness(context.Background())
+
+	assert.Equal(t, &schema.Health{Status: schema.HealthStatusUp}, health)
+}
+
+func TestHealthUseCase_GetReadiness(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx               context.Context
+			maxRunningScripts int64
+		}
+
+		expectedStruct struct {
+			health *schema.Health
+		}
+	)
+
+	upToDate := &model.MigrationStatus{CurrentVersion: 20261019170000, LatestVersion: 20261019170000}
+	outdated := &model.MigrationStatus{CurrentVersion: 20261019160000, LatestVersion: 20261019170000}
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIHealthService, *mock_gosha.MockIHelper, *mock_common.MockIExecutionGroup)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:               context.Background(),
+				maxRunningScripts: 10,
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(nil)
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(upToDate, nil)
+				mh.EXPECT().CheckTmpDir().Return(nil)
+				me.EXPECT().Context().Return(context.Background())
+				me.EXPECT().Running().Return(int64(2))
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusUp,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusUp},
+						"migrations": {Status: schema.HealthStatusUp, Detail: "version 20261019170000 of 20261019170000"},
+						"tmpDir":     {Status: schema.HealthStatusUp},
+						"executor":   {Status: schema.HealthStatusUp, Detail: "2 of 10 scripts are running"},
+					},
+				},
+			},
+		},
+		{
+			name: "Without running limit",
+			in: inStruct{
+				ctx: context.Background(),
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(nil)
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(upToDate, nil)
+				mh.EXPECT().CheckTmpDir().Return(nil)
+				me.EXPECT().Context().Return(context.Background())
+				me.EXPECT().Running().Return(int64(25))
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusUp,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusUp},
+						"migrations": {Status: schema.HealthStatusUp, Detail: "version 20261019170000 of 20261019170000"},
+						"tmpDir":     {Status: schema.HealthStatusUp},
+						"executor":   {Status: schema.HealthStatusUp, Detail: "25 scripts are running"},
+					},
+				},
+			},
+		},
+		{
+			name: "Database error",
+			in: inStruct{
+				ctx:               context.Background(),
+				maxRunningScripts: 10,
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(errors.New("connection refused"))
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(nil, errors.New("connection refused"))
+				mh.EXPECT().CheckTmpDir().Return(nil)
+				me.EXPECT().Context().Return(context.Background())
+				me.EXPECT().Running().Return(int64(0))
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusDown,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusDown, Detail: "connection refused"},
+						"migrations": {Status: schema.HealthStatusDown, Detail: "connection refused"},
+						"tmpDir":     {Status: schema.HealthStatusUp},
+						"executor":   {Status: schema.HealthStatusUp, Detail: "0 of 10 scripts are running"},
+					},
+				},
+			},
+		},
+		{
+			name: "Outdated migrations",
+			in: inStruct{
+				ctx:               context.Background(),
+				maxRunningScripts: 10,
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(nil)
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(outdated, nil)
+				mh.EXPECT().CheckTmpDir().Return(nil)
+				me.EXPECT().Context().Return(context.Background())
+				me.EXPECT().Running().Return(int64(0))
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusDown,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusUp},
+						"migrations": {Status: schema.HealthStatusDown, Detail: "version 20261019160000 of 20261019170000"},
+						"tmpDir":     {Status: schema.HealthStatusUp},
+						"executor":   {Status: schema.HealthStatusUp, Detail: "0 of 10 scripts are running"},
+					},
+				},
+			},
+		},
+		{
+			name: "Tmp dir error",
+			in: inStruct{
+				ctx:               context.Background(),
+				maxRunningScripts: 10,
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(nil)
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(upToDate, nil)
+				mh.EXPECT().CheckTmpDir().Return(errors.New("mkdir tmp: permission denied"))
+				me.EXPECT().Context().Return(context.Background())
+				me.EXPECT().Running().Return(int64(0))
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusDown,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusUp},
+						"migrations": {Status: schema.HealthStatusUp, Detail: "version 20261019170000 of 20261019170000"},
+						"tmpDir":     {Status: schema.HealthStatusDown, Detail: "mkdir tmp: permission denied"},
+						"executor":   {Status: schema.HealthStatusUp, Detail: "0 of 10 scripts are running"},
+					},
+				},
+			},
+		},
+		{
+			name: "Executor saturated",
+			in: inStruct{
+				ctx:               context.Background(),
+				maxRunningScripts: 10,
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(nil)
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(upToDate, nil)
+				mh.EXPECT().CheckTmpDir().Return(nil)
+				me.EXPECT().Context().Return(context.Background())
+				me.EXPECT().Running().Return(int64(10))
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusDown,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusUp},
+						"migrations": {Status: schema.HealthStatusUp, Detail: "version 20261019170000 of 20261019170000"},
+						"tmpDir":     {Status: schema.HealthStatusUp},
+						"executor":   {Status: schema.HealthStatusDown, Detail: "10 of 10 scripts are running"},
+					},
+				},
+			},
+		},
+		{
+			name: "Executor interrupted",
+			in: inStruct{
+				ctx:               context.Background(),
+				maxRunningScripts: 10,
+			},
+			mockBehavior: func(ms *mock_service.MockIHealthService, mh *mock_gosha.MockIHelper, me *mock_common.MockIExecutionGroup) {
+				ms.EXPECT().Ping(gomock.Any()).Return(nil)
+				ms.EXPECT().GetMigrationStatus(gomock.Any()).Return(upToDate, nil)
+				mh.EXPECT().CheckTmpDir().Return(nil)
+				me.EXPECT().Context().Return(cancelledCtx)
+			},
+			expected: expectedStruct{
+				health: &schema.Health{
+					Status: schema.HealthStatusDown,
+					Checks: map[string]*schema.HealthCheck{
+						"database":   {Status: schema.HealthStatusUp},
+						"migrations": {Status: schema.HealthStatusUp, Detail: "version 20261019170000 of 20261019170000"},
+						"tmpDir":     {Status: schema.HealthStatusUp},
+						"executor":   {Status: schema.HealthStatusDown, Detail: "executions are interrupted"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHealthService := mock_service.NewMockIHealthService(ctrl)
+			mockGoshaHelper := mock_gosha.NewMockIHelper(ctrl)
+			mockExecutionGroup := mock_common.NewMockIExecutionGroup(ctrl)
+			testCase.mockBehavior(mockHealthService, mockGoshaHelper, mockExecutionGroup)
+
+			healthUseCase := HealthUseCase{
+				service:           mockHealthService,
+				goshaHelper:       mockGoshaHelper,
+				executionGroup:    mockExecutionGroup,
+				checkTimeout:      time.Second,
+				maxRunningScripts: testCase.in.maxRunningScripts,
+			}
+
+			health := healthUseCase.GetReadiness(testCase.in.ctx)
+
+			assert.Equal(t, testCase.expected.health, health)
+		})
+	}
+}
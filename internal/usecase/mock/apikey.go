@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apikey.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIApiKeyUseCase is a mock of IApiKeyUseCase interface.
+type MockIApiKeyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyUseCaseMockRecorder
+}
+
+// MockIApiKeyUseCaseMockRecorder is the mock recorder for MockIApiKeyUseCase.
+type MockIApiKeyUseCaseMockRecorder struct {
+	mock *MockIApiKeyUseCase
+}
+
+// NewMockIApiKeyUseCase creates a new mock instance.
+func NewMockIApiKeyUseCase(ctrl *gomock.Controller) *MockIApiKeyUseCase {
+	mock := &MockIApiKeyUseCase{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKeyUseCase) EXPECT() *MockIApiKeyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIApiKeyUseCase) Authenticate(ctx context.Context, token string) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIApiKeyUseCaseMockRecorder) Authenticate(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIApiKeyUseCase)(nil).Authenticate), ctx, token)
+}
+
+// CreateApiKey mocks base method.
+func (m *MockIApiKeyUseCase) CreateApiKey(ctx context.Context, dto dto.CreateApiKey) (*model.ApiKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", ctx, dto)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockIApiKeyUseCaseMockRecorder) CreateApiKey(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockIApiKeyUseCase)(nil).CreateApiKey), ctx, dto)
+}
+
+// ExpireApiKeyById mocks base method.
+func (m *MockIApiKeyUseCase) ExpireApiKeyById(ctx context.Context, apiKeyId uuid.UUID, dto dto.ExpireApiKey) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireApiKeyById", ctx, apiKeyId, dto)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireApiKeyById indicates an expected call of ExpireApiKeyById.
+func (mr *MockIApiKeyUseCaseMockRecorder) ExpireApiKeyById(ctx, apiKeyId, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireApiKeyById", reflect.TypeOf((*MockIApiKeyUseCase)(nil).ExpireApiKeyById), ctx, apiKeyId, dto)
+}
+
+// GetApiKeyPaginationPage mocks base method.
+func (m *MockIApiKeyUseCase) GetApiKeyPaginationPage(ctx context.Context, paginationParams pagination.LimitOffsetParams) (alias.ApiKeyLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiKeyPaginationPage", ctx, paginationParams)
+	ret0, _ := ret[0].(alias.ApiKeyLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiKeyPaginationPage indicates an expected call of GetApiKeyPaginationPage.
+func (mr *MockIApiKeyUseCaseMockRecorder) GetApiKeyPaginationPage(ctx, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeyPaginationPage", reflect.TypeOf((*MockIApiKeyUseCase)(nil).GetApiKeyPaginationPage), ctx, paginationParams)
+}
+
+// RevokeApiKeyById mocks base method.
+func (m *MockIApiKeyUseCase) RevokeApiKeyById(ctx context.Context, apiKeyId uuid.UUID) (*model.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKeyById", ctx, apiKeyId)
+	ret0, _ := ret[0].(*model.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeApiKeyById indicates an expected call of RevokeApiKeyById.
+func (mr *MockIApiKeyUseCaseMockRecorder) RevokeApiKeyById(ctx, apiKeyId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKeyById", reflect.TypeOf((*MockIApiKeyUseCase)(nil).RevokeApiKeyById), ctx, apiKeyId)
+}
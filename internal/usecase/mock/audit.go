@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./audit.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIAuditUseCase is a mock of IAuditUseCase interface.
+type MockIAuditUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditUseCaseMockRecorder
+}
+
+// MockIAuditUseCaseMockRecorder is the mock recorder for MockIAuditUseCase.
+type MockIAuditUseCaseMockRecorder struct {
+	mock *MockIAuditUseCase
+}
+
+// NewMockIAuditUseCase creates a new mock instance.
+func NewMockIAuditUseCase(ctrl *gomock.Controller) *MockIAuditUseCase {
+	mock := &MockIAuditUseCase{ctrl: ctrl}
+	mock.recorder = &MockIAuditUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuditUseCase) EXPECT() *MockIAuditUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ExportAuditEventList mocks base method.
+func (m *MockIAuditUseCase) ExportAuditEventList(ctx context.Context, filter dto.AuditEventFilter, fn func(*model.AuditEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuditEventList", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAuditEventList indicates an expected call of ExportAuditEventList.
+func (mr *MockIAuditUseCaseMockRecorder) ExportAuditEventList(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuditEventList", reflect.TypeOf((*MockIAuditUseCase)(nil).ExportAuditEventList), ctx, filter, fn)
+}
+
+// GetAuditEventPaginationPage mocks base method.
+func (m *MockIAuditUseCase) GetAuditEventPaginationPage(ctx context.Context, filter dto.AuditEventFilter, paginationParams pagination.LimitOffsetParams) (alias.AuditEventLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEventPaginationPage", ctx, filter, paginationParams)
+	ret0, _ := ret[0].(alias.AuditEventLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEventPaginationPage indicates an expected call of GetAuditEventPaginationPage.
+func (mr *MockIAuditUseCaseMockRecorder) GetAuditEventPaginationPage(ctx, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEventPaginationPage", reflect.TypeOf((*MockIAuditUseCase)(nil).GetAuditEventPaginationPage), ctx, filter, paginationParams)
+}
+
+// RecordAuditEvent mocks base method.
+func (m *MockIAuditUseCase) RecordAuditEvent(ctx context.Context, dto dto.CreateAuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditEvent", ctx, dto)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditEvent indicates an expected call of RecordAuditEvent.
+func (mr *MockIAuditUseCaseMockRecorder) RecordAuditEvent(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditEvent", reflect.TypeOf((*MockIAuditUseCase)(nil).RecordAuditEvent), ctx, dto)
+}
@@ -6,6 +6,7 @@ package mock_usecase
 
 import (
 	bytes "bytes"
+	context "context"
 	multipart "mime/multipart"
 	dto "pg-sh-scripts/internal/dto"
 	model "pg-sh-scripts/internal/model"
@@ -41,53 +42,69 @@ func (m *MockIBashUseCase) EXPECT() *MockIBashUseCaseMockRecorder {
 }
 
 // CreateBash mocks base method.
-func (m *MockIBashUseCase) CreateBash(file *multipart.FileHeader) (*model.Bash, error) {
+func (m *MockIBashUseCase) CreateBash(ctx context.Context, file *multipart.FileHeader) (*model.Bash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBash", file)
+	ret := m.ctrl.Call(m, "CreateBash", ctx, file)
 	ret0, _ := ret[0].(*model.Bash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBash indicates an expected call of CreateBash.
-func (mr *MockIBashUseCaseMockRecorder) CreateBash(file interface{}) *gomock.Call {
+func (mr *MockIBashUseCaseMockRecorder) CreateBash(ctx, file interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBash", reflect.TypeOf((*MockIBashUseCase)(nil).CreateBash), file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBash", reflect.TypeOf((*MockIBashUseCase)(nil).CreateBash), ctx, file)
+}
+
+// ExecBashInline mocks base method.
+func (m *MockIBashUseCase) ExecBashInline(ctx context.Context, dto dto.ExecBashInline, file *multipart.FileHeader) (*model.BashRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecBashInline", ctx, dto, file)
+	ret0, _ := ret[0].(*model.BashRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecBashInline indicates an expected call of ExecBashInline.
+func (mr *MockIBashUseCaseMockRecorder) ExecBashInline(ctx, dto, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecBashInline", reflect.TypeOf((*MockIBashUseCase)(nil).ExecBashInline), ctx, dto, file)
 }
 
 // ExecBashList mocks base method.
-func (m *MockIBashUseCase) ExecBashList(isSync bool, dto []dto.ExecBash) error {
+func (m *MockIBashUseCase) ExecBashList(ctx context.Context, principal *model.Principal, isSync bool, dto []dto.ExecBash, idempotencyKey string) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecBashList", isSync, dto)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ExecBashList", ctx, principal, isSync, dto, idempotencyKey)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ExecBashList indicates an expected call of ExecBashList.
-func (mr *MockIBashUseCaseMockRecorder) ExecBashList(isSync, dto interface{}) *gomock.Call {
+func (mr *MockIBashUseCaseMockRecorder) ExecBashList(ctx, principal, isSync, dto, idempotencyKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecBashList", reflect.TypeOf((*MockIBashUseCase)(nil).ExecBashList), isSync, dto)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecBashList", reflect.TypeOf((*MockIBashUseCase)(nil).ExecBashList), ctx, principal, isSync, dto, idempotencyKey)
 }
 
 // GetBashById mocks base method.
-func (m *MockIBashUseCase) GetBashById(bashId uuid.UUID) (*model.Bash, error) {
+func (m *MockIBashUseCase) GetBashById(ctx context.Context, bashId uuid.UUID) (*model.Bash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBashById", bashId)
+	ret := m.ctrl.Call(m, "GetBashById", ctx, bashId)
 	ret0, _ := ret[0].(*model.Bash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBashById indicates an expected call of GetBashById.
-func (mr *MockIBashUseCaseMockRecorder) GetBashById(bashId interface{}) *gomock.Call {
+func (mr *MockIBashUseCaseMockRecorder) GetBashById(ctx, bashId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashById", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashById), bashId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashById", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashById), ctx, bashId)
 }
 
 // GetBashFileBufferById mocks base method.
-func (m *MockIBashUseCase) GetBashFileBufferById(bashId uuid.UUID) (*bytes.Buffer, alias.BashTitle, error) {
+func (m *MockIBashUseCase) GetBashFileBufferById(ctx context.Context, bashId uuid.UUID) (*bytes.Buffer, alias.BashTitle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBashFileBufferById", bashId)
+	ret := m.ctrl.Call(m, "GetBashFileBufferById", ctx, bashId)
 	ret0, _ := ret[0].(*bytes.Buffer)
 	ret1, _ := ret[1].(alias.BashTitle)
 	ret2, _ := ret[2].(error)
@@ -95,37 +112,52 @@ func (m *MockIBashUseCase) GetBashFileBufferById(bashId uuid.UUID) (*bytes.Buffe
 }
 
 // GetBashFileBufferById indicates an expected call of GetBashFileBufferById.
-func (mr *MockIBashUseCaseMockRecorder) GetBashFileBufferById(bashId interface{}) *gomock.Call {
+func (mr *MockIBashUseCaseMockRecorder) GetBashFileBufferById(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashFileBufferById", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashFileBufferById), ctx, bashId)
+}
+
+// GetBashKeysetPage mocks base method.
+func (m *MockIBashUseCase) GetBashKeysetPage(ctx context.Context, filter dto.BashFilter, paginationParams pagination.KeysetParams) (alias.BashKeysetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashKeysetPage", ctx, filter, paginationParams)
+	ret0, _ := ret[0].(alias.BashKeysetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashKeysetPage indicates an expected call of GetBashKeysetPage.
+func (mr *MockIBashUseCaseMockRecorder) GetBashKeysetPage(ctx, filter, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashFileBufferById", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashFileBufferById), bashId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashKeysetPage", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashKeysetPage), ctx, filter, paginationParams)
 }
 
 // GetBashPaginationPage mocks base method.
-func (m *MockIBashUseCase) GetBashPaginationPage(paginationParams pagination.LimitOffsetParams) (alias.BashLimitOffsetPage, error) {
+func (m *MockIBashUseCase) GetBashPaginationPage(ctx context.Context, filter dto.BashFilter, paginationParams pagination.LimitOffsetParams) (alias.BashLimitOffsetPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBashPaginationPage", paginationParams)
+	ret := m.ctrl.Call(m, "GetBashPaginationPage", ctx, filter, paginationParams)
 	ret0, _ := ret[0].(alias.BashLimitOffsetPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBashPaginationPage indicates an expected call of GetBashPaginationPage.
-func (mr *MockIBashUseCaseMockRecorder) GetBashPaginationPage(paginationParams interface{}) *gomock.Call {
+func (mr *MockIBashUseCaseMockRecorder) GetBashPaginationPage(ctx, filter, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashPaginationPage", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashPaginationPage), paginationParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashPaginationPage", reflect.TypeOf((*MockIBashUseCase)(nil).GetBashPaginationPage), ctx, filter, paginationParams)
 }
 
 // RemoveBashById mocks base method.
-func (m *MockIBashUseCase) RemoveBashById(bashId uuid.UUID) (*model.Bash, error) {
+func (m *MockIBashUseCase) RemoveBashById(ctx context.Context, bashId uuid.UUID) (*model.Bash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveBashById", bashId)
+	ret := m.ctrl.Call(m, "RemoveBashById", ctx, bashId)
 	ret0, _ := ret[0].(*model.Bash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveBashById indicates an expected call of RemoveBashById.
-func (mr *MockIBashUseCaseMockRecorder) RemoveBashById(bashId interface{}) *gomock.Call {
+func (mr *MockIBashUseCaseMockRecorder) RemoveBashById(ctx, bashId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBashById", reflect.TypeOf((*MockIBashUseCase)(nil).RemoveBashById), bashId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBashById", reflect.TypeOf((*MockIBashUseCase)(nil).RemoveBashById), ctx, bashId)
 }
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashacl.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashAclUseCase is a mock of IBashAclUseCase interface.
+type MockIBashAclUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashAclUseCaseMockRecorder
+}
+
+// MockIBashAclUseCaseMockRecorder is the mock recorder for MockIBashAclUseCase.
+type MockIBashAclUseCaseMockRecorder struct {
+	mock *MockIBashAclUseCase
+}
+
+// NewMockIBashAclUseCase creates a new mock instance.
+func NewMockIBashAclUseCase(ctrl *gomock.Controller) *MockIBashAclUseCase {
+	mock := &MockIBashAclUseCase{ctrl: ctrl}
+	mock.recorder = &MockIBashAclUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashAclUseCase) EXPECT() *MockIBashAclUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateBashAcl mocks base method.
+func (m *MockIBashAclUseCase) CreateBashAcl(ctx context.Context, dto dto.CreateBashAcl) (*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBashAcl", ctx, dto)
+	ret0, _ := ret[0].(*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBashAcl indicates an expected call of CreateBashAcl.
+func (mr *MockIBashAclUseCaseMockRecorder) CreateBashAcl(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBashAcl", reflect.TypeOf((*MockIBashAclUseCase)(nil).CreateBashAcl), ctx, dto)
+}
+
+// GetBashAclListByBashId mocks base method.
+func (m *MockIBashAclUseCase) GetBashAclListByBashId(ctx context.Context, bashId uuid.UUID) ([]*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashAclListByBashId", ctx, bashId)
+	ret0, _ := ret[0].([]*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashAclListByBashId indicates an expected call of GetBashAclListByBashId.
+func (mr *MockIBashAclUseCaseMockRecorder) GetBashAclListByBashId(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashAclListByBashId", reflect.TypeOf((*MockIBashAclUseCase)(nil).GetBashAclListByBashId), ctx, bashId)
+}
+
+// RemoveBashAclById mocks base method.
+func (m *MockIBashAclUseCase) RemoveBashAclById(ctx context.Context, bashId, bashAclId uuid.UUID) (*model.BashAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBashAclById", ctx, bashId, bashAclId)
+	ret0, _ := ret[0].(*model.BashAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBashAclById indicates an expected call of RemoveBashAclById.
+func (mr *MockIBashAclUseCaseMockRecorder) RemoveBashAclById(ctx, bashId, bashAclId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBashAclById", reflect.TypeOf((*MockIBashAclUseCase)(nil).RemoveBashAclById), ctx, bashId, bashAclId)
+}
@@ -5,6 +5,9 @@
 package mock_usecase
 
 import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
 	alias "pg-sh-scripts/internal/type/alias"
 	pagination "pg-sh-scripts/pkg/sql/pagination"
 	reflect "reflect"
@@ -36,17 +39,76 @@ func (m *MockIBashLogUseCase) EXPECT() *MockIBashLogUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ExportBashLogByBashId mocks base method.
+func (m *MockIBashLogUseCase) ExportBashLogByBashId(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, fn func(*model.BashLog) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBashLogByBashId", ctx, bashId, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBashLogByBashId indicates an expected call of ExportBashLogByBashId.
+func (mr *MockIBashLogUseCaseMockRecorder) ExportBashLogByBashId(ctx, bashId, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBashLogByBashId", reflect.TypeOf((*MockIBashLogUseCase)(nil).ExportBashLogByBashId), ctx, bashId, filter, fn)
+}
+
+// GetBashLogKeysetPageByBashId mocks base method.
+func (m *MockIBashLogUseCase) GetBashLogKeysetPageByBashId(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.KeysetParams) (alias.BashLogKeysetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashLogKeysetPageByBashId", ctx, bashId, filter, paginationParams)
+	ret0, _ := ret[0].(alias.BashLogKeysetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashLogKeysetPageByBashId indicates an expected call of GetBashLogKeysetPageByBashId.
+func (mr *MockIBashLogUseCaseMockRecorder) GetBashLogKeysetPageByBashId(ctx, bashId, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashLogKeysetPageByBashId", reflect.TypeOf((*MockIBashLogUseCase)(nil).GetBashLogKeysetPageByBashId), ctx, bashId, filter, paginationParams)
+}
+
+// GetBashLogKeysetPageByRunId mocks base method.
+func (m *MockIBashLogUseCase) GetBashLogKeysetPageByRunId(ctx context.Context, runId uuid.UUID, paginationParams pagination.KeysetParams) (alias.BashLogKeysetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashLogKeysetPageByRunId", ctx, runId, paginationParams)
+	ret0, _ := ret[0].(alias.BashLogKeysetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashLogKeysetPageByRunId indicates an expected call of GetBashLogKeysetPageByRunId.
+func (mr *MockIBashLogUseCaseMockRecorder) GetBashLogKeysetPageByRunId(ctx, runId, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashLogKeysetPageByRunId", reflect.TypeOf((*MockIBashLogUseCase)(nil).GetBashLogKeysetPageByRunId), ctx, runId, paginationParams)
+}
+
 // GetBashLogPaginationPageByBashId mocks base method.
-func (m *MockIBashLogUseCase) GetBashLogPaginationPageByBashId(bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.BashLogLimitOffsetPage, error) {
+func (m *MockIBashLogUseCase) GetBashLogPaginationPageByBashId(ctx context.Context, bashId uuid.UUID, filter dto.BashLogFilter, paginationParams pagination.LimitOffsetParams) (alias.BashLogLimitOffsetPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBashLogPaginationPageByBashId", bashId, paginationParams)
+	ret := m.ctrl.Call(m, "GetBashLogPaginationPageByBashId", ctx, bashId, filter, paginationParams)
 	ret0, _ := ret[0].(alias.BashLogLimitOffsetPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBashLogPaginationPageByBashId indicates an expected call of GetBashLogPaginationPageByBashId.
-func (mr *MockIBashLogUseCaseMockRecorder) GetBashLogPaginationPageByBashId(bashId, paginationParams interface{}) *gomock.Call {
+func (mr *MockIBashLogUseCaseMockRecorder) GetBashLogPaginationPageByBashId(ctx, bashId, filter, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashLogPaginationPageByBashId", reflect.TypeOf((*MockIBashLogUseCase)(nil).GetBashLogPaginationPageByBashId), ctx, bashId, filter, paginationParams)
+}
+
+// GetBashLogPaginationPageByRunId mocks base method.
+func (m *MockIBashLogUseCase) GetBashLogPaginationPageByRunId(ctx context.Context, runId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.BashLogLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashLogPaginationPageByRunId", ctx, runId, paginationParams)
+	ret0, _ := ret[0].(alias.BashLogLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashLogPaginationPageByRunId indicates an expected call of GetBashLogPaginationPageByRunId.
+func (mr *MockIBashLogUseCaseMockRecorder) GetBashLogPaginationPageByRunId(ctx, runId, paginationParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashLogPaginationPageByBashId", reflect.TypeOf((*MockIBashLogUseCase)(nil).GetBashLogPaginationPageByBashId), bashId, paginationParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashLogPaginationPageByRunId", reflect.TypeOf((*MockIBashLogUseCase)(nil).GetBashLogPaginationPageByRunId), ctx, runId, paginationParams)
 }
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashlogretention.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	schema "pg-sh-scripts/internal/schema"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashLogRetentionUseCase is a mock of IBashLogRetentionUseCase interface.
+type MockIBashLogRetentionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashLogRetentionUseCaseMockRecorder
+}
+
+// MockIBashLogRetentionUseCaseMockRecorder is the mock recorder for MockIBashLogRetentionUseCase.
+type MockIBashLogRetentionUseCaseMockRecorder struct {
+	mock *MockIBashLogRetentionUseCase
+}
+
+// NewMockIBashLogRetentionUseCase creates a new mock instance.
+func NewMockIBashLogRetentionUseCase(ctrl *gomock.Controller) *MockIBashLogRetentionUseCase {
+	mock := &MockIBashLogRetentionUseCase{ctrl: ctrl}
+	mock.recorder = &MockIBashLogRetentionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashLogRetentionUseCase) EXPECT() *MockIBashLogRetentionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetBashLogRetentionByBashId mocks base method.
+func (m *MockIBashLogRetentionUseCase) GetBashLogRetentionByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashLogRetentionByBashId", ctx, bashId)
+	ret0, _ := ret[0].(*model.BashLogRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashLogRetentionByBashId indicates an expected call of GetBashLogRetentionByBashId.
+func (mr *MockIBashLogRetentionUseCaseMockRecorder) GetBashLogRetentionByBashId(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashLogRetentionByBashId", reflect.TypeOf((*MockIBashLogRetentionUseCase)(nil).GetBashLogRetentionByBashId), ctx, bashId)
+}
+
+// PruneBashLog mocks base method.
+func (m *MockIBashLogRetentionUseCase) PruneBashLog(ctx context.Context, dryRun bool) (*schema.BashLogPrune, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneBashLog", ctx, dryRun)
+	ret0, _ := ret[0].(*schema.BashLogPrune)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneBashLog indicates an expected call of PruneBashLog.
+func (mr *MockIBashLogRetentionUseCaseMockRecorder) PruneBashLog(ctx, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneBashLog", reflect.TypeOf((*MockIBashLogRetentionUseCase)(nil).PruneBashLog), ctx, dryRun)
+}
+
+// RemoveBashLogRetentionByBashId mocks base method.
+func (m *MockIBashLogRetentionUseCase) RemoveBashLogRetentionByBashId(ctx context.Context, bashId uuid.UUID) (*model.BashLogRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBashLogRetentionByBashId", ctx, bashId)
+	ret0, _ := ret[0].(*model.BashLogRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBashLogRetentionByBashId indicates an expected call of RemoveBashLogRetentionByBashId.
+func (mr *MockIBashLogRetentionUseCaseMockRecorder) RemoveBashLogRetentionByBashId(ctx, bashId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBashLogRetentionByBashId", reflect.TypeOf((*MockIBashLogRetentionUseCase)(nil).RemoveBashLogRetentionByBashId), ctx, bashId)
+}
+
+// SetBashLogRetention mocks base method.
+func (m *MockIBashLogRetentionUseCase) SetBashLogRetention(ctx context.Context, dto dto.SetBashLogRetention) (*model.BashLogRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBashLogRetention", ctx, dto)
+	ret0, _ := ret[0].(*model.BashLogRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBashLogRetention indicates an expected call of SetBashLogRetention.
+func (mr *MockIBashLogRetentionUseCaseMockRecorder) SetBashLogRetention(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBashLogRetention", reflect.TypeOf((*MockIBashLogRetentionUseCase)(nil).SetBashLogRetention), ctx, dto)
+}
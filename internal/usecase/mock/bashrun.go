@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashrun.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashRunUseCase is a mock of IBashRunUseCase interface.
+type MockIBashRunUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashRunUseCaseMockRecorder
+}
+
+// MockIBashRunUseCaseMockRecorder is the mock recorder for MockIBashRunUseCase.
+type MockIBashRunUseCaseMockRecorder struct {
+	mock *MockIBashRunUseCase
+}
+
+// NewMockIBashRunUseCase creates a new mock instance.
+func NewMockIBashRunUseCase(ctrl *gomock.Controller) *MockIBashRunUseCase {
+	mock := &MockIBashRunUseCase{ctrl: ctrl}
+	mock.recorder = &MockIBashRunUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashRunUseCase) EXPECT() *MockIBashRunUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetBashRunPaginationPageByBashId mocks base method.
+func (m *MockIBashRunUseCase) GetBashRunPaginationPageByBashId(ctx context.Context, bashId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.BashRunLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashRunPaginationPageByBashId", ctx, bashId, paginationParams)
+	ret0, _ := ret[0].(alias.BashRunLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashRunPaginationPageByBashId indicates an expected call of GetBashRunPaginationPageByBashId.
+func (mr *MockIBashRunUseCaseMockRecorder) GetBashRunPaginationPageByBashId(ctx, bashId, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashRunPaginationPageByBashId", reflect.TypeOf((*MockIBashRunUseCase)(nil).GetBashRunPaginationPageByBashId), ctx, bashId, paginationParams)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./bashrunstats.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	schema "pg-sh-scripts/internal/schema"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIBashRunStatsUseCase is a mock of IBashRunStatsUseCase interface.
+type MockIBashRunStatsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBashRunStatsUseCaseMockRecorder
+}
+
+// MockIBashRunStatsUseCaseMockRecorder is the mock recorder for MockIBashRunStatsUseCase.
+type MockIBashRunStatsUseCaseMockRecorder struct {
+	mock *MockIBashRunStatsUseCase
+}
+
+// NewMockIBashRunStatsUseCase creates a new mock instance.
+func NewMockIBashRunStatsUseCase(ctrl *gomock.Controller) *MockIBashRunStatsUseCase {
+	mock := &MockIBashRunStatsUseCase{ctrl: ctrl}
+	mock.recorder = &MockIBashRunStatsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBashRunStatsUseCase) EXPECT() *MockIBashRunStatsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetBashRunStatsByBashId mocks base method.
+func (m *MockIBashRunStatsUseCase) GetBashRunStatsByBashId(ctx context.Context, bashId uuid.UUID, windowNames []string) (*schema.BashStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashRunStatsByBashId", ctx, bashId, windowNames)
+	ret0, _ := ret[0].(*schema.BashStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashRunStatsByBashId indicates an expected call of GetBashRunStatsByBashId.
+func (mr *MockIBashRunStatsUseCaseMockRecorder) GetBashRunStatsByBashId(ctx, bashId, windowNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashRunStatsByBashId", reflect.TypeOf((*MockIBashRunStatsUseCase)(nil).GetBashRunStatsByBashId), ctx, bashId, windowNames)
+}
+
+// GetBashRunStatsOverview mocks base method.
+func (m *MockIBashRunStatsUseCase) GetBashRunStatsOverview(ctx context.Context, windowName string) (*schema.StatsOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBashRunStatsOverview", ctx, windowName)
+	ret0, _ := ret[0].(*schema.StatsOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBashRunStatsOverview indicates an expected call of GetBashRunStatsOverview.
+func (mr *MockIBashRunStatsUseCaseMockRecorder) GetBashRunStatsOverview(ctx, windowName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBashRunStatsOverview", reflect.TypeOf((*MockIBashRunStatsUseCase)(nil).GetBashRunStatsOverview), ctx, windowName)
+}
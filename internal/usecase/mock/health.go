@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./health.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	schema "pg-sh-scripts/internal/schema"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIHealthUseCase is a mock of IHealthUseCase interface.
+type MockIHealthUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthUseCaseMockRecorder
+}
+
+// MockIHealthUseCaseMockRecorder is the mock recorder for MockIHealthUseCase.
+type MockIHealthUseCaseMockRecorder struct {
+	mock *MockIHealthUseCase
+}
+
+// NewMockIHealthUseCase creates a new mock instance.
+func NewMockIHealthUseCase(ctrl *gomock.Controller) *MockIHealthUseCase {
+	mock := &MockIHealthUseCase{ctrl: ctrl}
+	mock.recorder = &MockIHealthUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthUseCase) EXPECT() *MockIHealthUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetLiveness mocks base method.
+func (m *MockIHealthUseCase) GetLiveness(ctx context.Context) *schema.Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLiveness", ctx)
+	ret0, _ := ret[0].(*schema.Health)
+	return ret0
+}
+
+// GetLiveness indicates an expected call of GetLiveness.
+func (mr *MockIHealthUseCaseMockRecorder) GetLiveness(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveness", reflect.TypeOf((*MockIHealthUseCase)(nil).GetLiveness), ctx)
+}
+
+// GetReadiness mocks base method.
+func (m *MockIHealthUseCase) GetReadiness(ctx context.Context) *schema.Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadiness", ctx)
+	ret0, _ := ret[0].(*schema.Health)
+	return ret0
+}
+
+// GetReadiness indicates an expected call of GetReadiness.
+func (mr *MockIHealthUseCaseMockRecorder) GetReadiness(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadiness", reflect.TypeOf((*MockIHealthUseCase)(nil).GetReadiness), ctx)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./token.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "pg-sh-scripts/internal/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITokenUseCase is a mock of ITokenUseCase interface.
+type MockITokenUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockITokenUseCaseMockRecorder
+}
+
+// MockITokenUseCaseMockRecorder is the mock recorder for MockITokenUseCase.
+type MockITokenUseCaseMockRecorder struct {
+	mock *MockITokenUseCase
+}
+
+// NewMockITokenUseCase creates a new mock instance.
+func NewMockITokenUseCase(ctrl *gomock.Controller) *MockITokenUseCase {
+	mock := &MockITokenUseCase{ctrl: ctrl}
+	mock.recorder = &MockITokenUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITokenUseCase) EXPECT() *MockITokenUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockITokenUseCase) Authenticate(ctx context.Context, token string) (*model.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*model.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockITokenUseCaseMockRecorder) Authenticate(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockITokenUseCase)(nil).Authenticate), ctx, token)
+}
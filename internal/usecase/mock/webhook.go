@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./webhook.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	dto "pg-sh-scripts/internal/dto"
+	model "pg-sh-scripts/internal/model"
+	alias "pg-sh-scripts/internal/type/alias"
+	pagination "pg-sh-scripts/pkg/sql/pagination"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MockIWebhookUseCase is a mock of IWebhookUseCase interface.
+type MockIWebhookUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookUseCaseMockRecorder
+}
+
+// MockIWebhookUseCaseMockRecorder is the mock recorder for MockIWebhookUseCase.
+type MockIWebhookUseCaseMockRecorder struct {
+	mock *MockIWebhookUseCase
+}
+
+// NewMockIWebhookUseCase creates a new mock instance.
+func NewMockIWebhookUseCase(ctrl *gomock.Controller) *MockIWebhookUseCase {
+	mock := &MockIWebhookUseCase{ctrl: ctrl}
+	mock.recorder = &MockIWebhookUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookUseCase) EXPECT() *MockIWebhookUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockIWebhookUseCase) CreateWebhook(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, dto)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockIWebhookUseCaseMockRecorder) CreateWebhook(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockIWebhookUseCase)(nil).CreateWebhook), ctx, dto)
+}
+
+// GetWebhookDeliveryPaginationPageByWebhookId mocks base method.
+func (m *MockIWebhookUseCase) GetWebhookDeliveryPaginationPageByWebhookId(ctx context.Context, webhookId uuid.UUID, paginationParams pagination.LimitOffsetParams) (alias.WebhookDeliveryLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryPaginationPageByWebhookId", ctx, webhookId, paginationParams)
+	ret0, _ := ret[0].(alias.WebhookDeliveryLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveryPaginationPageByWebhookId indicates an expected call of GetWebhookDeliveryPaginationPageByWebhookId.
+func (mr *MockIWebhookUseCaseMockRecorder) GetWebhookDeliveryPaginationPageByWebhookId(ctx, webhookId, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryPaginationPageByWebhookId", reflect.TypeOf((*MockIWebhookUseCase)(nil).GetWebhookDeliveryPaginationPageByWebhookId), ctx, webhookId, paginationParams)
+}
+
+// GetWebhookPaginationPage mocks base method.
+func (m *MockIWebhookUseCase) GetWebhookPaginationPage(ctx context.Context, paginationParams pagination.LimitOffsetParams) (alias.WebhookLimitOffsetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookPaginationPage", ctx, paginationParams)
+	ret0, _ := ret[0].(alias.WebhookLimitOffsetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookPaginationPage indicates an expected call of GetWebhookPaginationPage.
+func (mr *MockIWebhookUseCaseMockRecorder) GetWebhookPaginationPage(ctx, paginationParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookPaginationPage", reflect.TypeOf((*MockIWebhookUseCase)(nil).GetWebhookPaginationPage), ctx, paginationParams)
+}
+
+// RemoveWebhookById mocks base method.
+func (m *MockIWebhookUseCase) RemoveWebhookById(ctx context.Context, webhookId uuid.UUID) (*model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWebhookById", ctx, webhookId)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveWebhookById indicates an expected call of RemoveWebhookById.
+func (mr *MockIWebhookUseCaseMockRecorder) RemoveWebhookById(ctx, webhookId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWebhookById", reflect.TypeOf((*MockIWebhookUseCase)(nil).RemoveWebhookById), ctx, webhookId)
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pg-sh-scripts/internal/common"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/oidc"
+	"slices"
+)
+
+//go:generate mockgen -source=./token.go  -destination=./mock/token.go
+
+type (
+	ITokenUseCase interface {
+		Authenticate(ctx context.Context, token string) (*model.Principal, error)
+	}
+
+	TokenUseCase struct {
+		verifier     *oidc.Verifier
+		subjectClaim string
+		nameClaim    string
+		rolesClaim   string
+		roleMapping  map[string]string
+		httpErrors   *config.HTTPErrors
+	}
+)
+
+// getRoles maps the values of the roles claim to the known roles, the values without a mapping are skipped,
+// so the identity provider grants no role that is not mapped explicitly.
+func (u *TokenUseCase) getRoles(values []string) []string {
+	roles := make([]string, 0, len(values))
+	for _, value := range values {
+		role, ok := u.roleMapping[value]
+		if !ok {
+			continue
+		}
+		if slices.Contains(model.Roles, role) && !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// Authenticate verifies the token against the key set of the issuer
+// and returns the principal with the roles from the configured claim.
+func (u *TokenUseCase) Authenticate(ctx context.Context, token string) (*model.Principal, error) {
+	claims, err := u.verifier.Verify(token)
+	if err != nil {
+		if errors.Is(err, oidc.ErrTokenExpired) {
+			return nil, u.httpErrors.TokenExpired
+		}
+		return nil, u.httpErrors.TokenInvalid
+	}
+
+	principal := &model.Principal{
+		Roles: u.getRoles(oidc.ClaimStrings(claims, u.rolesClaim)),
+	}
+	if subject := oidc.ClaimStrings(claims, u.subjectClaim); len(subject) == 1 {
+		principal.Subject = subject[0]
+	}
+	if name := oidc.ClaimStrings(claims, u.nameClaim); len(name) == 1 {
+		principal.Name = name[0]
+	} else {
+		principal.Name = principal.Subject
+	}
+
+	return principal, nil
+}
+
+func GetTokenUseCase() ITokenUseCase {
+	cfg := config.GetConfig()
+
+	return &TokenUseCase{
+		verifier: oidc.NewVerifier(
+			common.GetJWKSProvider(),
+			cfg.Jwt.Issuer,
+			cfg.Jwt.Audience,
+			cfg.Jwt.LeewaySeconds,
+		),
+		subjectClaim: cfg.Jwt.SubjectClaim,
+		nameClaim:    cfg.Jwt.NameClaim,
+		rolesClaim:   cfg.Jwt.RolesClaim,
+		roleMapping:  cfg.Jwt.RoleMapping,
+		httpErrors:   config.GetHTTPErrors(),
+	}
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/pkg/jwks"
+	"pg-sh-scripts/pkg/oidc"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenUseCase_Authenticate(t *testing.T) {
+	type (
+		inStruct struct {
+			roleMapping map[string]string
+			claims      jwt.MapClaims
+		}
+
+		expectedStruct struct {
+			principal *model.Principal
+			err       error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	issuer := "https://sso.example.com"
+	audience := "pg-sh-scripts"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	jsonWebKey, err := jwks.NewJSONWebKey("local", &key.PublicKey)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	data, err := json.Marshal(jwks.JSONWebKeySet{Keys: []jwks.JSONWebKey{jsonWebKey}})
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	keySet, err := jwks.Parse(data)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
+	getClaims := func(roles []any, exp time.Time) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                issuer,
+			"aud":                audience,
+			"sub":                "f3b1c2d4",
+			"preferred_username": "jane",
+			"exp":                exp.Unix(),
+			"realm_access":       map[string]any{"roles": roles},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				roleMapping: map[string]string{
+					"operator": model.RoleOperator,
+				},
+				claims: getClaims([]any{"operator", "unknown"}, time.Now().Add(time.Hour)),
+			},
+			expected: expectedStruct{
+				principal: &model.Principal{Subject: "f3b1c2d4", Name: "jane", Roles: []string{model.RoleOperator}},
+				err:       nil,
+			},
+		},
+		{
+			name: "Success with role mapping",
+			in: inStruct{
+				roleMapping: map[string]string{
+					"scripts-admins": model.RoleAdmin,
+					"scripts-devs":   model.RoleAuthor,
+				},
+				claims: getClaims([]any{"scripts-devs", "operator"}, time.Now().Add(time.Hour)),
+			},
+			expected: expectedStruct{
+				principal: &model.Principal{Subject: "f3b1c2d4", Name: "jane", Roles: []string{model.RoleAuthor}},
+				err:       nil,
+			},
+		},
+		{
+			name: "Success without role mapping",
+			in: inStruct{
+				claims: getClaims([]any{"admin", "operator"}, time.Now().Add(time.Hour)),
+			},
+			expected: expectedStruct{
+				principal: &model.Principal{Subject: "f3b1c2d4", Name: "jane", Roles: []string{}},
+				err:       nil,
+			},
+		},
+		{
+			name: "Expired error",
+			in: inStruct{
+				claims: getClaims([]any{"operator"}, time.Now().Add(-time.Hour)),
+			},
+			expected: expectedStruct{
+				principal: nil,
+				err:       httpErrors.TokenExpired,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			token := jwt.NewWithClaims(jwt.SigningMethodES256, testCase.in.claims)
+			token.Header["kid"] = "local"
+			tokenString, err := token.SignedString(key)
+			if err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+
+			tokenUseCase := TokenUseCase{
+				verifier:     oidc.NewVerifier(keySet, issuer, audience, 0),
+				subjectClaim: "sub",
+				nameClaim:    "preferred_username",
+				rolesClaim:   "realm_access.roles",
+				roleMapping:  testCase.in.roleMapping,
+				httpErrors:   httpErrors,
+			}
+
+			principal, err := tokenUseCase.Authenticate(context.Background(), tokenString)
+
+			assert.Equal(t, testCase.expected.principal, principal)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+
+	t.Run("Invalid audience error", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, getClaims(nil, time.Now().Add(time.Hour)))
+		token.Header["kid"] = "local"
+		tokenString, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("%s Error: %s", t.Name(), err)
+		}
+
+		tokenUseCase := TokenUseCase{
+			verifier:   oidc.NewVerifier(keySet, issuer, "another", 0),
+			httpErrors: httpErrors,
+		}
+
+		principal, err := tokenUseCase.Authenticate(context.Background(), tokenString)
+
+		assert.Nil(t, principal)
+		assert.Equal(t, httpErrors.TokenInvalid, err)
+	})
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	"pg-sh-scripts/internal/service"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"slices"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate mockgen -source=./webhook.go  -destination=./mock/webhook.go
+
+type (
+	IWebhookUseCase interface {
+		GetWebhookPaginationPage(
+			ctx context.Context,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.WebhookLimitOffsetPage, error)
+		GetWebhookDeliveryPaginationPageByWebhookId(
+			ctx context.Context,
+			webhookId uuid.UUID,
+			paginationParams pagination.LimitOffsetParams,
+		) (alias.WebhookDeliveryLimitOffsetPage, error)
+		CreateWebhook(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error)
+		RemoveWebhookById(ctx context.Context, webhookId uuid.UUID) (*model.Webhook, error)
+	}
+
+	WebhookUseCase struct {
+		service         service.IWebhookService
+		deliveryService service.IWebhookDeliveryService
+		bashService     service.IBashService
+		httpErrors      *config.HTTPErrors
+	}
+)
+
+func (u *WebhookUseCase) validateUrl(rawUrl string) bool {
+	webhookUrl, err := url.ParseRequestURI(rawUrl)
+	if err != nil {
+		return false
+	}
+	return (webhookUrl.Scheme == "http" || webhookUrl.Scheme == "https") && webhookUrl.Host != ""
+}
+
+func (u *WebhookUseCase) validateEvents(events []string) bool {
+	if len(events) == 0 {
+		return false
+	}
+	for _, event := range events {
+		if !slices.Contains(model.WebhookEvents, event) {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *WebhookUseCase) GetWebhookPaginationPage(
+	ctx context.Context,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.WebhookLimitOffsetPage, error) {
+	webhookPaginationPage, err := u.service.GetPaginationPage(
+		ctx,
+		paginationParams,
+	)
+	if err != nil {
+		return webhookPaginationPage, u.httpErrors.WebhookGetPaginationPage
+	}
+	return webhookPaginationPage, nil
+}
+
+func (u *WebhookUseCase) GetWebhookDeliveryPaginationPageByWebhookId(
+	ctx context.Context,
+	webhookId uuid.UUID,
+	paginationParams pagination.LimitOffsetParams,
+) (alias.WebhookDeliveryLimitOffsetPage, error) {
+	var webhookDeliveryPaginationPage alias.WebhookDeliveryLimitOffsetPage
+
+	_, err := u.service.GetOneById(ctx, webhookId)
+	if err != nil {
+		return webhookDeliveryPaginationPage, u.httpErrors.WebhookDoesNotExists
+	}
+
+	webhookDeliveryPaginationPage, err = u.deliveryService.GetPaginationPageByWebhookId(
+		ctx,
+		webhookId,
+		paginationParams,
+	)
+	if err != nil {
+		return webhookDeliveryPaginationPage, u.httpErrors.WebhookDeliveryGetPaginationPageByWebhookId
+	}
+
+	return webhookDeliveryPaginationPage, nil
+}
+
+func (u *WebhookUseCase) CreateWebhook(ctx context.Context, dto dto.CreateWebhook) (*model.Webhook, error) {
+	if ok := u.validateUrl(dto.Url); !ok {
+		return nil, u.httpErrors.WebhookUrl
+	}
+	if dto.Secret == "" {
+		return nil, u.httpErrors.WebhookSecret
+	}
+	if ok := u.validateEvents(dto.Events); !ok {
+		return nil, u.httpErrors.WebhookEvents
+	}
+
+	if dto.BashId != nil {
+		if _, err := u.bashService.GetOneById(ctx, *dto.BashId); err != nil {
+			return nil, u.httpErrors.BashDoesNotExists
+		}
+	}
+
+	webhook, err := u.service.Create(ctx, dto)
+	if err != nil {
+		return nil, u.httpErrors.WebhookCreate
+	}
+
+	return webhook, nil
+}
+
+func (u *WebhookUseCase) RemoveWebhookById(ctx context.Context, webhookId uuid.UUID) (*model.Webhook, error) {
+	_, err := u.service.GetOneById(ctx, webhookId)
+	if err != nil {
+		return nil, u.httpErrors.WebhookDoesNotExists
+	}
+
+	webhook, err := u.service.RemoveById(ctx, webhookId)
+	if err != nil {
+		return nil, u.httpErrors.WebhookRemove
+	}
+
+	return webhook, nil
+}
+
+func GetWebhookUseCase() IWebhookUseCase {
+	return &WebhookUseCase{
+		service:         service.GetWebhookService(),
+		deliveryService: service.GetWebhookDeliveryService(),
+		bashService:     service.GetBashService(),
+		httpErrors:      config.GetHTTPErrors(),
+	}
+}
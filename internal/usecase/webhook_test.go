@@ -0,0 +1,404 @@
+package usecase
+
+import (
+	"context"
+	"pg-sh-scripts/internal/config"
+	"pg-sh-scripts/internal/dto"
+	"pg-sh-scripts/internal/model"
+	mock_service "pg-sh-scripts/internal/service/mock"
+	"pg-sh-scripts/internal/type/alias"
+	"pg-sh-scripts/pkg/sql/pagination"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookUseCase_CreateWebhook(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx context.Context
+			dto dto.CreateWebhook
+		}
+
+		expectedStruct struct {
+			webhook *model.Webhook
+			err     error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+	bashId := uuid.NewV4()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIWebhookService, *mock_service.MockIBashService, context.Context, dto.CreateWebhook)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success global webhook",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					Url:    "https://example.com/hooks",
+					Secret: "secret",
+					Events: []string{model.WebhookEventRunFailure},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+				mw.EXPECT().Create(ctx, dto).Return(&model.Webhook{}, nil)
+			},
+			expected: expectedStruct{
+				webhook: &model.Webhook{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Success bash webhook",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					BashId: &bashId,
+					Url:    "http://127.0.0.1:9000/hooks",
+					Secret: "secret",
+					Events: []string{model.WebhookEventRunStart, model.WebhookEventRunTimeout},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+				gomock.InOrder(
+					mb.EXPECT().GetOneById(ctx, *dto.BashId).Return(&model.Bash{}, nil),
+					mw.EXPECT().Create(ctx, dto).Return(&model.Webhook{}, nil),
+				)
+			},
+			expected: expectedStruct{
+				webhook: &model.Webhook{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Validation url error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					Url:    "ftp://example.com/hooks",
+					Secret: "secret",
+					Events: []string{model.WebhookEventRunFailure},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.WebhookUrl,
+			},
+		},
+		{
+			name: "Validation secret error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					Url:    "https://example.com/hooks",
+					Events: []string{model.WebhookEventRunFailure},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.WebhookSecret,
+			},
+		},
+		{
+			name: "Validation events error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					Url:    "https://example.com/hooks",
+					Secret: "secret",
+					Events: []string{"run.unknown"},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.WebhookEvents,
+			},
+		},
+		{
+			name: "Getting bash does not exists error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					BashId: &bashId,
+					Url:    "https://example.com/hooks",
+					Secret: "secret",
+					Events: []string{model.WebhookEventRunFailure},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+				mb.EXPECT().GetOneById(ctx, *dto.BashId).Return(nil, httpErrors.BashDoesNotExists)
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.BashDoesNotExists,
+			},
+		},
+		{
+			name: "Creating webhook error",
+			in: inStruct{
+				ctx: context.Background(),
+				dto: dto.CreateWebhook{
+					Url:    "https://example.com/hooks",
+					Secret: "secret",
+					Events: []string{model.WebhookEventRunFailure},
+				},
+			},
+			mockBehavior: func(mw *mock_service.MockIWebhookService, mb *mock_service.MockIBashService, ctx context.Context, dto dto.CreateWebhook) {
+				mw.EXPECT().Create(ctx, dto).Return(nil, httpErrors.WebhookCreate)
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.WebhookCreate,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookService := mock_service.NewMockIWebhookService(ctrl)
+			mockBashService := mock_service.NewMockIBashService(ctrl)
+			testCase.mockBehavior(mockWebhookService, mockBashService, testCase.in.ctx, testCase.in.dto)
+
+			webhookUseCase := WebhookUseCase{
+				service:     mockWebhookService,
+				bashService: mockBashService,
+				httpErrors:  httpErrors,
+			}
+
+			webhook, err := webhookUseCase.CreateWebhook(testCase.in.ctx, testCase.in.dto)
+
+			assert.Equal(t, testCase.expected.webhook, webhook)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestWebhookUseCase_GetWebhookDeliveryPaginationPageByWebhookId(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx              context.Context
+			webhookId        uuid.UUID
+			paginationParams pagination.LimitOffsetParams
+		}
+
+		expectedStruct struct {
+			paginationPage alias.WebhookDeliveryLimitOffsetPage
+			err            error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIWebhookDeliveryService, *mock_service.MockIWebhookService, context.Context, uuid.UUID, pagination.LimitOffsetParams)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:              context.Background(),
+				webhookId:        uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mwd *mock_service.MockIWebhookDeliveryService, mw *mock_service.MockIWebhookService, ctx context.Context, webhookId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+				gomock.InOrder(
+					mw.EXPECT().GetOneById(ctx, webhookId).Return(&model.Webhook{}, nil),
+					mwd.EXPECT().GetPaginationPageByWebhookId(
+						ctx,
+						webhookId,
+						paginationParams,
+					).Return(
+						alias.WebhookDeliveryLimitOffsetPage{},
+						nil,
+					),
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.WebhookDeliveryLimitOffsetPage{},
+				err:            nil,
+			},
+		},
+		{
+			name: "Webhook does not exists",
+			in: inStruct{
+				ctx:              context.Background(),
+				webhookId:        uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mwd *mock_service.MockIWebhookDeliveryService, mw *mock_service.MockIWebhookService, ctx context.Context, webhookId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+				mw.EXPECT().GetOneById(ctx, webhookId).Return(nil, httpErrors.WebhookDoesNotExists)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.WebhookDeliveryLimitOffsetPage{},
+				err:            httpErrors.WebhookDoesNotExists,
+			},
+		},
+		{
+			name: "Getting webhook delivery pagination page error",
+			in: inStruct{
+				ctx:              context.Background(),
+				webhookId:        uuid.NewV4(),
+				paginationParams: pagination.LimitOffsetParams{},
+			},
+			mockBehavior: func(mwd *mock_service.MockIWebhookDeliveryService, mw *mock_service.MockIWebhookService, ctx context.Context, webhookId uuid.UUID, paginationParams pagination.LimitOffsetParams) {
+				gomock.InOrder(
+					mw.EXPECT().GetOneById(ctx, webhookId).Return(&model.Webhook{}, nil),
+					mwd.EXPECT().GetPaginationPageByWebhookId(
+						ctx,
+						webhookId,
+						paginationParams,
+					).Return(
+						alias.WebhookDeliveryLimitOffsetPage{},
+						httpErrors.WebhookDeliveryGetPaginationPageByWebhookId,
+					),
+				)
+			},
+			expected: expectedStruct{
+				paginationPage: alias.WebhookDeliveryLimitOffsetPage{},
+				err:            httpErrors.WebhookDeliveryGetPaginationPageByWebhookId,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookService := mock_service.NewMockIWebhookService(ctrl)
+			mockWebhookDeliveryService := mock_service.NewMockIWebhookDeliveryService(ctrl)
+			testCase.mockBehavior(
+				mockWebhookDeliveryService,
+				mockWebhookService,
+				testCase.in.ctx,
+				testCase.in.webhookId,
+				testCase.in.paginationParams,
+			)
+
+			webhookUseCase := WebhookUseCase{
+				service:         mockWebhookService,
+				deliveryService: mockWebhookDeliveryService,
+				httpErrors:      httpErrors,
+			}
+
+			webhookDeliveryPaginationPage, err := webhookUseCase.GetWebhookDeliveryPaginationPageByWebhookId(
+				testCase.in.ctx,
+				testCase.in.webhookId,
+				testCase.in.paginationParams,
+			)
+
+			assert.Equal(t, testCase.expected.paginationPage, webhookDeliveryPaginationPage)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
+
+func TestWebhookUseCase_RemoveWebhookById(t *testing.T) {
+	type (
+		inStruct struct {
+			ctx       context.Context
+			webhookId uuid.UUID
+		}
+
+		expectedStruct struct {
+			webhook *model.Webhook
+			err     error
+		}
+	)
+
+	httpErrors := config.GetHTTPErrors()
+
+	testCases := []struct {
+		name         string
+		in           inStruct
+		mockBehavior func(*mock_service.MockIWebhookService, context.Context, uuid.UUID)
+		expected     expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				ctx:       context.Background(),
+				webhookId: uuid.NewV4(),
+			},
+			mockBehavior: func(m *mock_service.MockIWebhookService, ctx context.Context, webhookId uuid.UUID) {
+				gomock.InOrder(
+					m.EXPECT().GetOneById(ctx, webhookId).Return(&model.Webhook{}, nil),
+					m.EXPECT().RemoveById(ctx, webhookId).Return(&model.Webhook{}, nil),
+				)
+			},
+			expected: expectedStruct{
+				webhook: &model.Webhook{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Getting webhook does not exists error",
+			in: inStruct{
+				ctx:       context.Background(),
+				webhookId: uuid.NewV4(),
+			},
+			mockBehavior: func(m *mock_service.MockIWebhookService, ctx context.Context, webhookId uuid.UUID) {
+				m.EXPECT().GetOneById(ctx, webhookId).Return(nil, httpErrors.WebhookDoesNotExists)
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.WebhookDoesNotExists,
+			},
+		},
+		{
+			name: "Removing webhook error",
+			in: inStruct{
+				ctx:       context.Background(),
+				webhookId: uuid.NewV4(),
+			},
+			mockBehavior: func(m *mock_service.MockIWebhookService, ctx context.Context, webhookId uuid.UUID) {
+				gomock.InOrder(
+					m.EXPECT().GetOneById(ctx, webhookId).Return(&model.Webhook{}, nil),
+					m.EXPECT().RemoveById(ctx, webhookId).Return(nil, httpErrors.WebhookRemove),
+				)
+			},
+			expected: expectedStruct{
+				webhook: nil,
+				err:     httpErrors.WebhookRemove,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookService := mock_service.NewMockIWebhookService(ctrl)
+			testCase.mockBehavior(mockWebhookService, testCase.in.ctx, testCase.in.webhookId)
+
+			webhookUseCase := WebhookUseCase{
+				service:    mockWebhookService,
+				httpErrors: httpErrors,
+			}
+
+			webhook, err := webhookUseCase.RemoveWebhookById(testCase.in.ctx, testCase.in.webhookId)
+
+			assert.Equal(t, testCase.expected.webhook, webhook)
+			assert.Equal(t, testCase.expected.err, err)
+		})
+	}
+}
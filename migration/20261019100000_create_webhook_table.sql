@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.webhook (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    bash_id uuid,
+    url VARCHAR NOT NULL,
+    secret VARCHAR NOT NULL,
+    events VARCHAR[] NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_bash_id_fkey
+ON scripts.webhook (bash_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.webhook_bash_id_fkey;
+
+DROP TABLE IF EXISTS scripts.webhook;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.webhook_delivery (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id uuid NOT NULL,
+    event VARCHAR NOT NULL,
+    payload JSONB NOT NULL,
+    attempt INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error VARCHAR NOT NULL DEFAULT '',
+    is_success BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    FOREIGN KEY (webhook_id) REFERENCES scripts.webhook (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_webhook_id_fkey
+ON scripts.webhook_delivery (webhook_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.webhook_delivery_webhook_id_fkey;
+
+DROP TABLE IF EXISTS scripts.webhook_delivery;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.bash_run (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    bash_id uuid NOT NULL,
+    status VARCHAR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    finished_at TIMESTAMP WITH TIME ZONE,
+    FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS bash_run_bash_id_fkey
+ON scripts.bash_run (bash_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.bash_run_bash_id_fkey;
+
+DROP TABLE IF EXISTS scripts.bash_run;
+-- +goose StatementEnd
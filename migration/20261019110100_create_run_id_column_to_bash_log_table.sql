@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS
+    scripts.bash_log
+ADD COLUMN IF NOT EXISTS
+    run_id uuid;
+
+ALTER TABLE IF EXISTS
+    scripts.bash_log
+ADD CONSTRAINT
+    bash_log_run_id_fkey
+FOREIGN KEY (run_id) REFERENCES scripts.bash_run (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS bash_log_run_id_fkey
+ON scripts.bash_log (run_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.bash_log_run_id_fkey;
+
+ALTER TABLE IF EXISTS
+    scripts.bash_log
+DROP CONSTRAINT IF EXISTS
+    bash_log_run_id_fkey;
+
+ALTER TABLE IF EXISTS
+    scripts.bash_log
+DROP COLUMN IF EXISTS
+    run_id;
+-- +goose StatementEnd
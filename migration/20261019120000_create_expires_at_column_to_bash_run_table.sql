@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE scripts.bash_run
+ALTER COLUMN bash_id DROP NOT NULL;
+
+ALTER TABLE scripts.bash_run
+ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS bash_run_expires_at_idx
+ON scripts.bash_run (expires_at)
+WHERE expires_at IS NOT NULL;
+
+ALTER TABLE scripts.bash_log
+ALTER COLUMN bash_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM scripts.bash_log WHERE bash_id IS NULL;
+
+ALTER TABLE scripts.bash_log
+ALTER COLUMN bash_id SET NOT NULL;
+
+DROP INDEX IF EXISTS scripts.bash_run_expires_at_idx;
+
+DELETE FROM scripts.bash_run WHERE bash_id IS NULL;
+
+ALTER TABLE scripts.bash_run
+DROP COLUMN IF EXISTS expires_at;
+
+ALTER TABLE scripts.bash_run
+ALTER COLUMN bash_id SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.idempotency_key (
+    key VARCHAR PRIMARY KEY,
+    fingerprint VARCHAR NOT NULL,
+    response JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_expires_at_idx
+ON scripts.idempotency_key (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.idempotency_key_expires_at_idx;
+
+DROP TABLE IF EXISTS scripts.idempotency_key;
+-- +goose StatementEnd
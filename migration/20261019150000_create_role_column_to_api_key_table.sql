@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE scripts.api_key ADD COLUMN role VARCHAR NOT NULL DEFAULT 'viewer';
+UPDATE scripts.api_key SET role = 'admin' WHERE is_admin;
+ALTER TABLE scripts.api_key DROP COLUMN is_admin;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scripts.api_key ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT false;
+UPDATE scripts.api_key SET is_admin = true WHERE role = 'admin';
+ALTER TABLE scripts.api_key DROP COLUMN role;
+-- +goose StatementEnd
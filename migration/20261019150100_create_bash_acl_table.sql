@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.bash_acl (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    bash_id uuid NOT NULL,
+    role VARCHAR,
+    subject VARCHAR,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE,
+    CHECK ((role IS NULL) <> (subject IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS bash_acl_bash_id_fkey
+ON scripts.bash_acl (bash_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.bash_acl_bash_id_fkey;
+
+DROP TABLE IF EXISTS scripts.bash_acl;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.audit_event (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    action VARCHAR NOT NULL,
+    actor VARCHAR NOT NULL,
+    actor_subject VARCHAR,
+    client_ip VARCHAR NOT NULL,
+    request_id VARCHAR,
+    method VARCHAR NOT NULL,
+    path VARCHAR NOT NULL,
+    target_ids VARCHAR[] NOT NULL DEFAULT '{}',
+    outcome VARCHAR NOT NULL,
+    status_code INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_event_created_at_idx
+ON scripts.audit_event (created_at);
+
+CREATE INDEX IF NOT EXISTS audit_event_target_ids_idx
+ON scripts.audit_event USING GIN (target_ids);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.audit_event_target_ids_idx;
+
+DROP INDEX IF EXISTS scripts.audit_event_created_at_idx;
+
+DROP TABLE IF EXISTS scripts.audit_event;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE scripts.bash_run ADD COLUMN request_id VARCHAR;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scripts.bash_run DROP COLUMN request_id;
+-- +goose StatementEnd
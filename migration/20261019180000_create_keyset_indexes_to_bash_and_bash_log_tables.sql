@@ -0,0 +1,19 @@
+-- +goose NO TRANSACTION
+-- The indexes are built concurrently, so the scripts and the logs are still written while they are created.
+
+-- +goose Up
+CREATE INDEX CONCURRENTLY IF NOT EXISTS bash_created_at_id_idx
+ON scripts.bash (created_at, id);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS bash_log_bash_id_created_at_id_idx
+ON scripts.bash_log (bash_id, created_at, id);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS bash_log_run_id_created_at_id_idx
+ON scripts.bash_log (run_id, created_at, id);
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS scripts.bash_log_run_id_created_at_id_idx;
+
+DROP INDEX CONCURRENTLY IF EXISTS scripts.bash_log_bash_id_created_at_id_idx;
+
+DROP INDEX CONCURRENTLY IF EXISTS scripts.bash_created_at_id_idx;
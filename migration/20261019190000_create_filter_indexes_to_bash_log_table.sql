@@ -0,0 +1,16 @@
+-- +goose NO TRANSACTION
+-- The indexes are built concurrently, so the logs are still written while they are created.
+
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS bash_log_bash_id_is_error_created_at_id_idx
+ON scripts.bash_log (bash_id, is_error, created_at, id);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS bash_log_body_trgm_idx
+ON scripts.bash_log USING gin (body gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS scripts.bash_log_body_trgm_idx;
+
+DROP INDEX CONCURRENTLY IF EXISTS scripts.bash_log_bash_id_is_error_created_at_id_idx;
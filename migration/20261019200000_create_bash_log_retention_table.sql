@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.bash_log_retention (
+    bash_id uuid PRIMARY KEY,
+    max_age_seconds BIGINT CHECK (max_age_seconds >= 0),
+    max_rows BIGINT CHECK (max_rows >= 0),
+    keep_runs BIGINT CHECK (keep_runs >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS bash_run_bash_id_created_at_id_idx
+ON scripts.bash_run (bash_id, created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS scripts.bash_run_bash_id_created_at_id_idx;
+
+DROP TABLE IF EXISTS scripts.bash_log_retention;
+-- +goose StatementEnd
@@ -0,0 +1,134 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.bash_log_partitioned (
+    id uuid NOT NULL DEFAULT gen_random_uuid(),
+    bash_id uuid,
+    run_id uuid,
+    body VARCHAR NOT NULL,
+    is_error BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+) PARTITION BY RANGE (created_at);
+
+-- Monthly partitions cover the existing logs and the next months,
+-- the server creates the following ones ahead of time.
+DO $$
+DECLARE
+    partition_from TIMESTAMP WITHOUT TIME ZONE;
+    partition_to TIMESTAMP WITHOUT TIME ZONE;
+    last_from TIMESTAMP WITHOUT TIME ZONE := date_trunc('month', (now() AT TIME ZONE 'UTC')) + interval '2 month';
+BEGIN
+    SELECT
+        LEAST(date_trunc('month', MIN(created_at)), date_trunc('month', (now() AT TIME ZONE 'UTC')))
+    INTO
+        partition_from
+    FROM
+        scripts.bash_log;
+
+    WHILE partition_from <= last_from LOOP
+        partition_to := partition_from + interval '1 month';
+        EXECUTE format(
+            'CREATE TABLE IF NOT EXISTS scripts.%I PARTITION OF scripts.bash_log_partitioned FOR VALUES FROM (%L) TO (%L)',
+            'bash_log_p' || to_char(partition_from, 'YYYYMM'),
+            partition_from,
+            partition_to
+        );
+        partition_from := partition_to;
+    END LOOP;
+END
+$$;
+
+-- The default partition keeps the logs when the partition of their month is missing.
+CREATE TABLE IF NOT EXISTS scripts.bash_log_default
+PARTITION OF scripts.bash_log_partitioned DEFAULT;
+
+INSERT INTO scripts.bash_log_partitioned
+    (id, bash_id, run_id, body, is_error, created_at)
+SELECT
+    id, bash_id, run_id, body, is_error, created_at
+FROM
+    scripts.bash_log;
+
+DROP TABLE scripts.bash_log;
+
+ALTER TABLE scripts.bash_log_partitioned RENAME TO bash_log;
+
+ALTER TABLE scripts.bash_log
+ADD CONSTRAINT bash_log_pkey PRIMARY KEY (id, created_at);
+
+ALTER TABLE scripts.bash_log
+ADD CONSTRAINT bash_log_bash_id_fkey
+FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE;
+
+ALTER TABLE scripts.bash_log
+ADD CONSTRAINT bash_log_run_id_fkey
+FOREIGN KEY (run_id) REFERENCES scripts.bash_run (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS bash_log_bash_id_fkey
+ON scripts.bash_log (bash_id);
+
+CREATE INDEX IF NOT EXISTS bash_log_run_id_fkey
+ON scripts.bash_log (run_id);
+
+CREATE INDEX IF NOT EXISTS bash_log_bash_id_created_at_id_idx
+ON scripts.bash_log (bash_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS bash_log_run_id_created_at_id_idx
+ON scripts.bash_log (run_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS bash_log_bash_id_is_error_created_at_id_idx
+ON scripts.bash_log (bash_id, is_error, created_at, id);
+
+CREATE INDEX IF NOT EXISTS bash_log_body_trgm_idx
+ON scripts.bash_log USING gin (body gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scripts.bash_log_plain (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    bash_id uuid,
+    run_id uuid,
+    body VARCHAR NOT NULL,
+    is_error BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT now()
+);
+
+INSERT INTO scripts.bash_log_plain
+    (id, bash_id, run_id, body, is_error, created_at)
+SELECT
+    id, bash_id, run_id, body, is_error, created_at
+FROM
+    scripts.bash_log;
+
+DROP TABLE scripts.bash_log;
+
+ALTER TABLE scripts.bash_log_plain RENAME TO bash_log;
+
+ALTER INDEX scripts.bash_log_plain_pkey RENAME TO bash_log_pkey;
+
+ALTER TABLE scripts.bash_log
+ADD CONSTRAINT bash_log_bash_id_fkey
+FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE;
+
+ALTER TABLE scripts.bash_log
+ADD CONSTRAINT bash_log_run_id_fkey
+FOREIGN KEY (run_id) REFERENCES scripts.bash_run (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS bash_log_bash_id_fkey
+ON scripts.bash_log (bash_id);
+
+CREATE INDEX IF NOT EXISTS bash_log_run_id_fkey
+ON scripts.bash_log (run_id);
+
+CREATE INDEX IF NOT EXISTS bash_log_bash_id_created_at_id_idx
+ON scripts.bash_log (bash_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS bash_log_run_id_created_at_id_idx
+ON scripts.bash_log (run_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS bash_log_bash_id_is_error_created_at_id_idx
+ON scripts.bash_log (bash_id, is_error, created_at, id);
+
+CREATE INDEX IF NOT EXISTS bash_log_body_trgm_idx
+ON scripts.bash_log USING gin (body gin_trgm_ops);
+-- +goose StatementEnd
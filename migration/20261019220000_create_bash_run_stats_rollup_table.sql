@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS bash_run_created_at_idx
+ON scripts.bash_run (created_at);
+
+-- The rollup keeps the overview stats of every configured window,
+-- the row without bash_id is the total of all scripts.
+CREATE TABLE IF NOT EXISTS scripts.bash_run_stats_rollup (
+    window_name VARCHAR NOT NULL,
+    bash_id uuid,
+    since TIMESTAMP WITH TIME ZONE NOT NULL,
+    total BIGINT NOT NULL,
+    success BIGINT NOT NULL,
+    failure BIGINT NOT NULL,
+    timeout BIGINT NOT NULL,
+    interrupted BIGINT NOT NULL,
+    running BIGINT NOT NULL,
+    success_rate DOUBLE PRECISION,
+    duration_p50 DOUBLE PRECISION,
+    duration_p95 DOUBLE PRECISION,
+    duration_max DOUBLE PRECISION,
+    last_success_at TIMESTAMP WITH TIME ZONE,
+    last_failure_at TIMESTAMP WITH TIME ZONE,
+    rolled_up_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    FOREIGN KEY (bash_id) REFERENCES scripts.bash (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS bash_run_stats_rollup_window_name_idx
+ON scripts.bash_run_stats_rollup (window_name);
+
+CREATE INDEX IF NOT EXISTS bash_run_stats_rollup_bash_id_fkey
+ON scripts.bash_run_stats_rollup (bash_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scripts.bash_run_stats_rollup;
+
+DROP INDEX IF EXISTS scripts.bash_run_created_at_idx;
+-- +goose StatementEnd
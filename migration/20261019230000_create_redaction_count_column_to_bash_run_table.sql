@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE scripts.bash_run
+ADD COLUMN IF NOT EXISTS redaction_count BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scripts.bash_run
+DROP COLUMN IF EXISTS redaction_count;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE scripts.idempotency_key
+ADD COLUMN IF NOT EXISTS principal_id VARCHAR NOT NULL DEFAULT '';
+
+ALTER TABLE scripts.idempotency_key
+DROP CONSTRAINT IF EXISTS idempotency_key_pkey;
+
+ALTER TABLE scripts.idempotency_key
+ADD CONSTRAINT idempotency_key_pkey PRIMARY KEY (principal_id, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scripts.idempotency_key
+DROP CONSTRAINT IF EXISTS idempotency_key_pkey;
+
+DELETE FROM scripts.idempotency_key
+WHERE principal_id <> '';
+
+ALTER TABLE scripts.idempotency_key
+ADD CONSTRAINT idempotency_key_pkey PRIMARY KEY (key);
+
+ALTER TABLE scripts.idempotency_key
+DROP COLUMN IF EXISTS principal_id;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The monthly partitions are UTC, so the creation time of the logs
+-- does not depend on the time zone of the session.
+ALTER TABLE scripts.bash_log
+ALTER COLUMN created_at SET DEFAULT (now() AT TIME ZONE 'UTC');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scripts.bash_log
+ALTER COLUMN created_at SET DEFAULT now();
+-- +goose StatementEnd
@@ -0,0 +1,86 @@
+package apikey
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const (
+	keyPrefix    = "psk"
+	keySeparator = "_"
+	prefixSize   = 4
+	secretSize   = 32
+	saltSize     = 16
+)
+
+var ErrKeyFormat = errors.New("api key must be in the psk_<prefix>_<secret> format")
+
+type Key struct {
+	// Value is the full api key which is shown to the user only once.
+	Value string
+	// Prefix is stored as is and used to find the key.
+	Prefix string
+	// Secret is stored only as a salted hash.
+	Secret string
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Generate returns a new random api key.
+func Generate() (*Key, error) {
+	prefix, err := randomHex(prefixSize)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomHex(secretSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		Value:  strings.Join([]string{keyPrefix, prefix, secret}, keySeparator),
+		Prefix: prefix,
+		Secret: secret,
+	}, nil
+}
+
+// Parse splits the api key into its prefix and secret.
+func Parse(value string) (*Key, error) {
+	parts := strings.Split(value, keySeparator)
+	if len(parts) != 3 || parts[0] != keyPrefix || parts[1] == "" || parts[2] == "" {
+		return nil, ErrKeyFormat
+	}
+
+	return &Key{
+		Value:  value,
+		Prefix: parts[1],
+		Secret: parts[2],
+	}, nil
+}
+
+// GenerateSalt returns a new random salt for hashing the api key secret.
+func GenerateSalt() (string, error) {
+	return randomHex(saltSize)
+}
+
+// Hash returns the hex encoded HMAC-SHA256 of the secret keyed by the salt.
+func Hash(salt string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the secret matches the stored salted hash.
+func Verify(salt string, secret string, hash string) bool {
+	return hmac.Equal([]byte(Hash(salt, secret)), []byte(hash))
+}
@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	key, err := Generate()
+	assert.NoError(t, err)
+
+	parsedKey, err := Parse(key.Value)
+	assert.NoError(t, err)
+	assert.Equal(t, key, parsedKey)
+
+	otherKey, err := Generate()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key.Value, otherKey.Value)
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value string
+		isErr bool
+	}{
+		{name: "Success", value: "psk_0a1b2c3d_secret", isErr: false},
+		{name: "Empty key error", value: "", isErr: true},
+		{name: "Wrong key prefix error", value: "key_0a1b2c3d_secret", isErr: true},
+		{name: "Empty secret error", value: "psk_0a1b2c3d_", isErr: true},
+		{name: "Extra part error", value: "psk_0a1b2c3d_secret_extra", isErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse(testCase.value)
+			assert.Equal(t, testCase.isErr, err != nil)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	salt, err := GenerateSalt()
+	assert.NoError(t, err)
+
+	hash := Hash(salt, "secret")
+
+	assert.True(t, Verify(salt, "secret", hash))
+	assert.False(t, Verify(salt, "other", hash))
+	assert.False(t, Verify("other", "secret", hash))
+	assert.NotEqual(t, hash, Hash("other", "secret"))
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -27,6 +28,7 @@ type (
 		Port              string
 		RetryCount        int
 		RetrySleepSeconds time.Duration
+		Tracer            pgx.QueryTracer
 	}
 )
 
@@ -47,7 +49,13 @@ func getPool(ctx context.Context, connConfig *ConnConfig) (*pgxpool.Pool, error)
 		connErr      error
 	)
 
-	db, err := pgxpool.New(ctx, getConnString(connConfig))
+	poolConfig, err := pgxpool.ParseConfig(getConnString(connConfig))
+	if err != nil {
+		return nil, err
+	}
+	poolConfig.ConnConfig.Tracer = connConfig.Tracer
+
+	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type (
+	poolStatDesc struct {
+		desc      *prometheus.Desc
+		valueType prometheus.ValueType
+		value     func(stat *pgxpool.Stat) float64
+	}
+
+	// PoolStatCollector exposes the stats of the pgx pool as prometheus metrics,
+	// the stats are read on every scrape.
+	PoolStatCollector struct {
+		pool  *pgxpool.Pool
+		descs []poolStatDesc
+	}
+)
+
+func newPoolStatDesc(
+	namespace string,
+	name string,
+	help string,
+	valueType prometheus.ValueType,
+	value func(stat *pgxpool.Stat) float64,
+) poolStatDesc {
+	return poolStatDesc{
+		desc:      prometheus.NewDesc(prometheus.BuildFQName(namespace, "pgxpool", name), help, nil, nil),
+		valueType: valueType,
+		value:     value,
+	}
+}
+
+func NewPoolStatCollector(pool *pgxpool.Pool, namespace string) *PoolStatCollector {
+	return &PoolStatCollector{
+		pool: pool,
+		descs: []poolStatDesc{
+			newPoolStatDesc(namespace, "acquired_conns", "Number of currently acquired connections.",
+				prometheus.GaugeValue, func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) }),
+			newPoolStatDesc(namespace, "idle_conns", "Number of currently idle connections.",
+				prometheus.GaugeValue, func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) }),
+			newPoolStatDesc(namespace, "constructing_conns", "Number of connections being constructed.",
+				prometheus.GaugeValue, func(s *pgxpool.Stat) float64 { return float64(s.ConstructingConns()) }),
+			newPoolStatDesc(namespace, "total_conns", "Total number of connections in the pool.",
+				prometheus.GaugeValue, func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) }),
+			newPoolStatDesc(namespace, "max_conns", "Maximum size of the pool.",
+				prometheus.GaugeValue, func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) }),
+			newPoolStatDesc(namespace, "acquires_total", "Number of successful connection acquires.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return float64(s.AcquireCount()) }),
+			newPoolStatDesc(namespace, "acquire_duration_seconds_total", "Total time spent acquiring connections.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() }),
+			newPoolStatDesc(namespace, "canceled_acquires_total", "Number of acquires canceled by the context.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return float64(s.CanceledAcquireCount()) }),
+			newPoolStatDesc(namespace, "empty_acquires_total", "Number of acquires which waited for a connection.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) }),
+			newPoolStatDesc(namespace, "new_conns_total", "Number of opened connections.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return float64(s.NewConnsCount()) }),
+			newPoolStatDesc(namespace, "max_lifetime_destroys_total", "Number of connections closed by max lifetime.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return float64(s.MaxLifetimeDestroyCount()) }),
+			newPoolStatDesc(namespace, "max_idle_destroys_total", "Number of connections closed by max idle time.",
+				prometheus.CounterValue, func(s *pgxpool.Stat) float64 { return float64(s.MaxIdleDestroyCount()) }),
+		},
+	}
+}
+
+func (c *PoolStatCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range c.descs {
+		ch <- d.desc
+	}
+}
+
+func (c *PoolStatCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	for _, d := range c.descs {
+		ch <- prometheus.MustNewConstMetric(d.desc, d.valueType, d.value(stat))
+	}
+}
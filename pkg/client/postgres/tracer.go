@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "pg-sh-scripts/pkg/client/postgres"
+
+// QueryTracer starts a client span for every query executed by the pool.
+type QueryTracer struct {
+	tracer trace.Tracer
+}
+
+// getOperation returns the first keyword of the sql, it is used as the span name.
+func getOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "query"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	operation := getOperation(data.SQL)
+
+	ctx, _ = t.tracer.Start(
+		ctx,
+		"pgx "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBName(conn.Config().Database),
+			semconv.DBOperation(operation),
+			semconv.DBStatement(data.SQL),
+		),
+	)
+	return ctx
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+}
+
+func NewQueryTracer(provider trace.TracerProvider) *QueryTracer {
+	return &QueryTracer{
+		tracer: provider.Tracer(tracerName),
+	}
+}
@@ -2,58 +2,92 @@ package gosha
 
 import (
 	"context"
+	"errors"
+	"os"
 	"os/exec"
 	"time"
 )
 
 type (
 	ICmd interface {
-		run(IScanner) error
-		syncRun(IScanner, chan<- error)
+		run(context.Context, IScanner) error
+		syncRun(context.Context, IScanner, chan<- error)
 	}
 
 	Cmd struct {
+		Id      string
 		Title   string
 		Path    string
+		Args    []string
+		Env     []string
 		Timeout time.Duration
 	}
 )
 
-func (c *Cmd) run(scanner IScanner) error {
-	var cmdExec *exec.Cmd
+// getCtxErrGroup returns the error group of the command stopped by its context,
+// or the fallback group when the context is still alive.
+func getCtxErrGroup(ctx context.Context, fallback ErrGroup) ErrGroup {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return timeoutExecErrGroup
+	case errors.Is(ctx.Err(), context.Canceled):
+		return interruptExecErrGroup
+	}
+	return fallback
+}
 
+func (c *Cmd) run(ctx context.Context, scanner IScanner) (err error) {
 	cmdPath := c.Path
 	cmdTimeout := c.Timeout
 
+	if hook, ok := scanner.(IHook); ok {
+		if err = hook.OnStart(c); err != nil {
+			return GetExecErr(c, startHookErrGroup, err)
+		}
+		defer func() {
+			hook.OnFinish(c, err)
+		}()
+	}
+
+	var cancel context.CancelFunc
 	if cmdTimeout > 0 {
-		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
-		defer cancel()
-		cmdExec = exec.CommandContext(ctx, execOperator, cmdPath)
+		ctx, cancel = context.WithTimeout(ctx, cmdTimeout)
 	} else {
-		cmdExec = exec.Command(execOperator, cmdPath)
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	cmdExec := exec.CommandContext(ctx, execOperator, append([]string{cmdPath}, c.Args...)...)
+	if len(c.Env) > 0 {
+		cmdExec.Env = append(os.Environ(), c.Env...)
 	}
+	setProcessGroup(cmdExec)
 
 	stdout, err := cmdExec.StdoutPipe()
 	if err != nil {
-		return GetExecErr(c, ErrFmt(stdoutErrGroup, err))
+		return GetExecErr(c, stdoutErrGroup, err)
 	}
 
 	if err = cmdExec.Start(); err != nil {
-		return GetExecErr(c, ErrFmt(startExecErrGroup, err))
+		return GetExecErr(c, startExecErrGroup, err)
 
 	}
 
 	if err = scanner.Scan(stdout, c); err != nil {
-		return GetExecErr(c, ErrFmt(scanErrGroup, err))
+		// The output is no longer read, so the process is killed and waited
+		// instead of being left blocked on the pipe.
+		errGroup := getCtxErrGroup(ctx, scanErrGroup)
+		cancel()
+		_ = cmdExec.Wait()
+		return GetExecErr(c, errGroup, err)
 	}
 
 	if err = cmdExec.Wait(); err != nil {
-		return GetExecErr(c, ErrFmt(waitExecErrGroup, err))
+		return GetExecErr(c, getCtxErrGroup(ctx, waitExecErrGroup), err)
 	}
 
 	return nil
 }
 
-func (c *Cmd) syncRun(scanner IScanner, ch chan<- error) {
-	ch <- c.run(scanner)
+func (c *Cmd) syncRun(ctx context.Context, scanner IScanner, ch chan<- error) {
+	ch <- c.run(ctx, scanner)
 }
@@ -1,36 +1,70 @@
 package gosha
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+)
 
 type (
 	ErrGroup string
 
 	ExecErr struct {
+		Id     string
 		Title  string
 		Path   string
+		Group  ErrGroup
 		Detail string
+		// ExitCode is the exit code of the process, or -1 if it has not exited by itself.
+		ExitCode int
 	}
 )
 
 const (
-	stdoutErrGroup    ErrGroup = "stdout"
-	startExecErrGroup ErrGroup = "start execute"
-	waitExecErrGroup  ErrGroup = "wait execute"
-	scanErrGroup      ErrGroup = "scan"
+	startHookErrGroup     ErrGroup = "start hook"
+	stdoutErrGroup        ErrGroup = "stdout"
+	startExecErrGroup     ErrGroup = "start execute"
+	waitExecErrGroup      ErrGroup = "wait execute"
+	timeoutExecErrGroup   ErrGroup = "timeout execute"
+	interruptExecErrGroup ErrGroup = "interrupt execute"
+	scanErrGroup          ErrGroup = "scan"
 )
 
 func (e *ExecErr) Error() string {
 	return fmt.Sprintf("gosha was shocked - %s", e.Detail)
 }
 
+func (e *ExecErr) IsTimeout() bool {
+	return e.Group == timeoutExecErrGroup
+}
+
+func (e *ExecErr) IsInterrupted() bool {
+	return e.Group == interruptExecErrGroup
+}
+
+// IsStartHook reports whether the command was not started since its start hook failed.
+func (e *ExecErr) IsStartHook() bool {
+	return e.Group == startHookErrGroup
+}
+
 func ErrFmt(group ErrGroup, err error) string {
 	return fmt.Sprintf("%s error: %s", group, err)
 }
 
-func GetExecErr(cmd *Cmd, detail string) error {
+func GetExecErr(cmd *Cmd, group ErrGroup, err error) error {
+	exitCode := -1
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	}
+
 	return &ExecErr{
-		Title:  cmd.Title,
-		Path:   cmd.Path,
-		Detail: detail,
+		Id:       cmd.Id,
+		Title:    cmd.Title,
+		Path:     cmd.Path,
+		Group:    group,
+		Detail:   ErrFmt(group, err),
+		ExitCode: exitCode,
 	}
 }
@@ -1,28 +1,30 @@
 package gosha
 
+import "context"
+
 const (
 	execOperator = "/bin/bash"
 )
 
 type (
 	IExec interface {
-		Run(IScanner, []ICmd) error
-		SyncRun(IScanner, []ICmd) []error
+		Run(context.Context, IScanner, []ICmd) error
+		SyncRun(context.Context, IScanner, []ICmd) []error
 	}
 
 	Exec struct{}
 )
 
-func (e *Exec) Run(scanner IScanner, commands []ICmd) error {
+func (e *Exec) Run(ctx context.Context, scanner IScanner, commands []ICmd) error {
 	for _, cmd := range commands {
-		if err := cmd.run(scanner); err != nil {
+		if err := cmd.run(ctx, scanner); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (e *Exec) SyncRun(scanner IScanner, commands []ICmd) []error {
+func (e *Exec) SyncRun(ctx context.Context, scanner IScanner, commands []ICmd) []error {
 	commandsCount := len(commands)
 
 	errPool := make([]error, 0, commandsCount)
@@ -30,7 +32,7 @@ func (e *Exec) SyncRun(scanner IScanner, commands []ICmd) []error {
 	defer close(errCh)
 
 	for _, cmd := range commands {
-		go cmd.syncRun(scanner, errCh)
+		go cmd.syncRun(ctx, scanner, errCh)
 	}
 
 	for i := 0; i < commandsCount; i++ {
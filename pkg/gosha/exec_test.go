@@ -0,0 +1,218 @@
+package gosha
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExec_Run(t *testing.T) {
+	type (
+		inStruct struct {
+			body      string
+			timeout   time.Duration
+			interrupt time.Duration
+		}
+
+		expectedStruct struct {
+			isErr         bool
+			isTimeout     bool
+			isInterrupted bool
+			exitCode      int
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				body: "echo hello",
+			},
+			expected: expectedStruct{},
+		},
+		{
+			name: "Exit code error",
+			in: inStruct{
+				body: "exit 3",
+			},
+			expected: expectedStruct{
+				isErr:    true,
+				exitCode: 3,
+			},
+		},
+		{
+			name: "Timeout error",
+			in: inStruct{
+				body:    "echo start\nsleep 30",
+				timeout: 100 * time.Millisecond,
+			},
+			expected: expectedStruct{
+				isErr:     true,
+				isTimeout: true,
+				exitCode:  -1,
+			},
+		},
+		{
+			name: "Interrupt error",
+			in: inStruct{
+				body:      "echo start\nsleep 30",
+				interrupt: 100 * time.Millisecond,
+			},
+			expected: expectedStruct{
+				isErr:         true,
+				isInterrupted: true,
+				exitCode:      -1,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cmdPath := path.Join(t.TempDir(), "script"+bashExtension)
+			if err := os.WriteFile(cmdPath, []byte(testCase.in.body), 0600); err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if testCase.in.interrupt > 0 {
+				time.AfterFunc(testCase.in.interrupt, cancel)
+			}
+
+			cmd := &Cmd{Title: "test", Path: cmdPath, Timeout: testCase.in.timeout}
+
+			startedAt := time.Now()
+			err := GetExec().Run(ctx, GetDefaultScanner(), []ICmd{cmd})
+
+			var execErr *ExecErr
+			errors.As(err, &execErr)
+
+			assert.Equal(t, testCase.expected.isErr, err != nil)
+			assert.Equal(t, testCase.expected.isTimeout, execErr != nil && execErr.IsTimeout())
+			assert.Equal(t, testCase.expected.isInterrupted, execErr != nil && execErr.IsInterrupted())
+			if execErr != nil {
+				assert.Equal(t, testCase.expected.exitCode, execErr.ExitCode)
+			}
+			assert.Less(t, time.Since(startedAt), 10*time.Second)
+		})
+	}
+}
+
+type testHookScanner struct {
+	startErr   error
+	scanErr    error
+	isFinished bool
+}
+
+func (s *testHookScanner) Scan(stdout io.ReadCloser, cmd *Cmd) error {
+	if s.scanErr != nil {
+		return s.scanErr
+	}
+	_, err := io.Copy(io.Discard, stdout)
+	return err
+}
+
+func (s *testHookScanner) OnStart(cmd *Cmd) error {
+	return s.startErr
+}
+
+func (s *testHookScanner) OnFinish(cmd *Cmd, err error) {
+	s.isFinished = true
+}
+
+func TestExec_RunHook(t *testing.T) {
+	type (
+		inStruct struct {
+			body     string
+			startErr error
+			scanErr  error
+		}
+
+		expectedStruct struct {
+			isErr       bool
+			isStartHook bool
+			isStarted   bool
+			isFinished  bool
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				body: "touch started",
+			},
+			expected: expectedStruct{
+				isStarted:  true,
+				isFinished: true,
+			},
+		},
+		{
+			name: "Start hook error",
+			in: inStruct{
+				body:     "touch started",
+				startErr: errors.New("creating run"),
+			},
+			expected: expectedStruct{
+				isErr:       true,
+				isStartHook: true,
+			},
+		},
+		{
+			name: "Scan error",
+			in: inStruct{
+				body:    "touch started\nsleep 30",
+				scanErr: errors.New("creating log"),
+			},
+			expected: expectedStruct{
+				isErr:      true,
+				isStarted:  true,
+				isFinished: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cmdPath := path.Join(dir, "script"+bashExtension)
+			body := "cd " + dir + "\n" + testCase.in.body
+			if err := os.WriteFile(cmdPath, []byte(body), 0600); err != nil {
+				t.Fatalf("%s Error: %s", t.Name(), err)
+			}
+
+			scanner := &testHookScanner{startErr: testCase.in.startErr, scanErr: testCase.in.scanErr}
+			cmd := &Cmd{Title: "test", Path: cmdPath}
+
+			startedAt := time.Now()
+			err := GetExec().Run(context.Background(), scanner, []ICmd{cmd})
+
+			var execErr *ExecErr
+			errors.As(err, &execErr)
+
+			_, statErr := os.Stat(path.Join(dir, "started"))
+
+			assert.Equal(t, testCase.expected.isErr, err != nil)
+			assert.Equal(t, testCase.expected.isStartHook, execErr != nil && execErr.IsStartHook())
+			assert.Equal(t, testCase.expected.isFinished, scanner.isFinished)
+			if !testCase.expected.isStarted {
+				assert.True(t, os.IsNotExist(statErr))
+			}
+			assert.Less(t, time.Since(startedAt), 10*time.Second)
+		})
+	}
+}
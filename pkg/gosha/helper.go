@@ -20,6 +20,7 @@ type (
 	IHelper interface {
 		GetTmpFile(string) (*os.File, error)
 		RemoveTmpFile(*os.File) error
+		CheckTmpDir() error
 	}
 
 	Helper struct{}
@@ -65,6 +66,15 @@ func (h *Helper) RemoveTmpFile(f *os.File) error {
 	return nil
 }
 
+// CheckTmpDir reports an error if bash script files cannot be written to the temp dir.
+func (h *Helper) CheckTmpDir() error {
+	f, err := h.GetTmpFile("")
+	if err != nil {
+		return err
+	}
+	return h.RemoveTmpFile(f)
+}
+
 func GetHelper() IHelper {
 	return &Helper{}
 }
@@ -34,6 +34,20 @@ func (m *MockIHelper) EXPECT() *MockIHelperMockRecorder {
 	return m.recorder
 }
 
+// CheckTmpDir mocks base method.
+func (m *MockIHelper) CheckTmpDir() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckTmpDir")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckTmpDir indicates an expected call of CheckTmpDir.
+func (mr *MockIHelperMockRecorder) CheckTmpDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTmpDir", reflect.TypeOf((*MockIHelper)(nil).CheckTmpDir))
+}
+
 // GetTmpFile mocks base method.
 func (m *MockIHelper) GetTmpFile(arg0 string) (*os.File, error) {
 	m.ctrl.T.Helper()
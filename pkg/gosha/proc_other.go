@@ -0,0 +1,7 @@
+//go:build !unix
+
+package gosha
+
+import "os/exec"
+
+func setProcessGroup(*exec.Cmd) {}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package gosha
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts the command in its own process group and kills the whole group
+// on cancellation, so the children of the script can not keep its stdout open.
+func setProcessGroup(cmdExec *exec.Cmd) {
+	cmdExec.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmdExec.Cancel = func() error {
+		return syscall.Kill(-cmdExec.Process.Pid, syscall.SIGKILL)
+	}
+}
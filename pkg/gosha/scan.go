@@ -12,6 +12,14 @@ type (
 		Scan(io.ReadCloser, *Cmd) error
 	}
 
+	// IHook is an optional scanner extension which is notified
+	// when a command starts and when it finishes with its result,
+	// the command is not started if OnStart returns an error.
+	IHook interface {
+		OnStart(*Cmd) error
+		OnFinish(*Cmd, error)
+	}
+
 	DefaultScanner struct{}
 )
 
@@ -0,0 +1,83 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/text/language"
+)
+
+type (
+	// Messages are the translations of one language keyed by the code of the message.
+	Messages map[int]string
+
+	Catalog struct {
+		defaultLanguage string
+		languages       []string
+		matcher         language.Matcher
+		messages        map[string]Messages
+	}
+)
+
+// NewCatalog creates the catalog of the messages keyed by the language,
+// the default language is used when the client does not accept any of them.
+func NewCatalog(defaultLanguage string, messages map[string]Messages) (*Catalog, error) {
+	if _, ok := messages[defaultLanguage]; !ok {
+		return nil, fmt.Errorf("the catalog has no messages of the default language: %s", defaultLanguage)
+	}
+
+	languages := []string{defaultLanguage}
+	for lang := range messages {
+		if lang != defaultLanguage {
+			languages = append(languages, lang)
+		}
+	}
+	sort.Strings(languages[1:])
+
+	tags := make([]language.Tag, 0, len(languages))
+	for _, lang := range languages {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return nil, fmt.Errorf("invalid catalog language %s: %w", lang, err)
+		}
+		tags = append(tags, tag)
+	}
+
+	return &Catalog{
+		defaultLanguage: defaultLanguage,
+		languages:       languages,
+		matcher:         language.NewMatcher(tags),
+		messages:        messages,
+	}, nil
+}
+
+func (c *Catalog) GetDefaultLanguage() string {
+	return c.defaultLanguage
+}
+
+// GetLanguage returns the catalog language which best matches the Accept-Language header,
+// or the default language when the header is empty, invalid or nothing matches.
+func (c *Catalog) GetLanguage(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return c.defaultLanguage
+	}
+
+	_, index, confidence := c.matcher.Match(tags...)
+	if confidence == language.No {
+		return c.defaultLanguage
+	}
+	return c.languages[index]
+}
+
+// GetMessage returns the message and its language, falls back to the default language
+// when the translation is missing and reports whether the message was found at all.
+func (c *Catalog) GetMessage(lang string, code int) (string, string, bool) {
+	if message, ok := c.messages[lang][code]; ok {
+		return message, lang, true
+	}
+	if message, ok := c.messages[c.defaultLanguage][code]; ok {
+		return message, c.defaultLanguage, true
+	}
+	return "", "", false
+}
@@ -0,0 +1,75 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog_GetLanguage(t *testing.T) {
+	catalog, err := NewCatalog("en", map[string]Messages{
+		"en": {0: "Internal Error"},
+		"ru": {0: "Внутренняя ошибка"},
+	})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "Empty header", acceptLanguage: "", expected: "en"},
+		{name: "Exact language", acceptLanguage: "ru", expected: "ru"},
+		{name: "Regional language", acceptLanguage: "ru-RU,ru;q=0.9,en;q=0.8", expected: "ru"},
+		{name: "Quality order", acceptLanguage: "ru;q=0.5, en", expected: "en"},
+		{name: "Unsupported language", acceptLanguage: "de-DE", expected: "en"},
+		{name: "Wildcard", acceptLanguage: "*", expected: "en"},
+		{name: "Invalid header", acceptLanguage: "\x00;q=x", expected: "en"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, catalog.GetLanguage(testCase.acceptLanguage))
+		})
+	}
+}
+
+func TestCatalog_GetMessage(t *testing.T) {
+	catalog, err := NewCatalog("ru", map[string]Messages{
+		"en": {0: "Internal Error", 1: "Invalid fields"},
+		"ru": {0: "Внутренняя ошибка", 2: "Ошибка выполнения"},
+	})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		in       string
+		code     int
+		expected string
+		lang     string
+		ok       bool
+	}{
+		{name: "Translation", in: "en", code: 0, expected: "Internal Error", lang: "en", ok: true},
+		{name: "Default language", in: "ru", code: 0, expected: "Внутренняя ошибка", lang: "ru", ok: true},
+		{name: "Missing translation falls back to default", in: "en", code: 2, expected: "Ошибка выполнения", lang: "ru", ok: true},
+		{name: "Missing in every language", in: "en", code: 3, expected: "", lang: "", ok: false},
+		{name: "Unknown language falls back to default", in: "de", code: 0, expected: "Внутренняя ошибка", lang: "ru", ok: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			message, lang, ok := catalog.GetMessage(testCase.in, testCase.code)
+			assert.Equal(t, testCase.expected, message)
+			assert.Equal(t, testCase.lang, lang)
+			assert.Equal(t, testCase.ok, ok)
+		})
+	}
+}
+
+func TestNewCatalog(t *testing.T) {
+	_, err := NewCatalog("de", map[string]Messages{"en": {}})
+	assert.Error(t, err)
+
+	_, err = NewCatalog("en", map[string]Messages{"en": {}, "not a language!": {}})
+	assert.Error(t, err)
+}
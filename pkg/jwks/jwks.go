@@ -0,0 +1,160 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrKeyNotFound = errors.New("jwks: key not found")
+
+type (
+	// JSONWebKey is the public part of the RSA or EC key as defined in RFC 7517.
+	JSONWebKey struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		Use string `json:"use,omitempty"`
+		Alg string `json:"alg,omitempty"`
+		N   string `json:"n,omitempty"`
+		E   string `json:"e,omitempty"`
+		Crv string `json:"crv,omitempty"`
+		X   string `json:"x,omitempty"`
+		Y   string `json:"y,omitempty"`
+	}
+
+	JSONWebKeySet struct {
+		Keys []JSONWebKey `json:"keys"`
+	}
+
+	// KeySet holds the parsed signing keys by their id.
+	KeySet struct {
+		keys map[string]crypto.PublicKey
+	}
+)
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func getCurve(crv string) (elliptic.Curve, error) {
+	switch crv {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	}
+	return nil, fmt.Errorf("jwks: unsupported curve %q", crv)
+}
+
+// PublicKey returns the RSA or ECDSA public key of the json web key.
+func (k JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: invalid modulus of key %q: %w", k.Kid, err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: invalid exponent of key %q: %w", k.Kid, err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("jwks: invalid exponent of key %q", k.Kid)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, err := getCurve(k.Crv)
+		if err != nil {
+			return nil, err
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: invalid x coordinate of key %q: %w", k.Kid, err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: invalid y coordinate of key %q: %w", k.Kid, err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("jwks: point of key %q is not on the curve", k.Kid)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("jwks: unsupported key type %q", k.Kty)
+}
+
+// NewJSONWebKey returns the json web key of the RSA or ECDSA public key,
+// it allows to build a local key set, e.g. for tests.
+func NewJSONWebKey(kid string, publicKey crypto.PublicKey) (JSONWebKey, error) {
+	switch typedKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		return JSONWebKey{
+			Kid: kid,
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(typedKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(typedKey.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		size := (typedKey.Curve.Params().BitSize + 7) / 8
+		return JSONWebKey{
+			Kid: kid,
+			Kty: "EC",
+			Use: "sig",
+			Alg: "ES256",
+			Crv: typedKey.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(typedKey.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(typedKey.Y.FillBytes(make([]byte, size))),
+		}, nil
+	}
+	return JSONWebKey{}, fmt.Errorf("jwks: unsupported public key type %T", publicKey)
+}
+
+// Parse parses the json web key set, keys that are not used for signatures are skipped.
+func Parse(data []byte) (*KeySet, error) {
+	var jsonWebKeySet JSONWebKeySet
+	if err := json.Unmarshal(data, &jsonWebKeySet); err != nil {
+		return nil, fmt.Errorf("jwks: %w", err)
+	}
+
+	keySet := &KeySet{keys: make(map[string]crypto.PublicKey, len(jsonWebKeySet.Keys))}
+	for _, jsonWebKey := range jsonWebKeySet.Keys {
+		if jsonWebKey.Use != "" && jsonWebKey.Use != "sig" {
+			continue
+		}
+		publicKey, err := jsonWebKey.PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		keySet.keys[jsonWebKey.Kid] = publicKey
+	}
+
+	return keySet, nil
+}
+
+// Key returns the public key by its id.
+func (s *KeySet) Key(kid string) (crypto.PublicKey, error) {
+	publicKey, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return publicKey, nil
+}
+
+// Len returns the number of keys in the set.
+func (s *KeySet) Len() int {
+	return len(s.keys)
+}
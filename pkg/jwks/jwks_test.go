@@ -0,0 +1,128 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestKeySetData(t *testing.T) ([]byte, *rsa.PrivateKey, *ecdsa.PrivateKey) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
+	rsaJSONWebKey, err := NewJSONWebKey("rsa", &rsaKey.PublicKey)
+	assert.NoError(t, err)
+	ecJSONWebKey, err := NewJSONWebKey("ec", &ecKey.PublicKey)
+	assert.NoError(t, err)
+	encJSONWebKey := rsaJSONWebKey
+	encJSONWebKey.Kid = "enc"
+	encJSONWebKey.Use = "enc"
+
+	data, err := json.Marshal(JSONWebKeySet{Keys: []JSONWebKey{rsaJSONWebKey, ecJSONWebKey, encJSONWebKey}})
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	return data, rsaKey, ecKey
+}
+
+func TestParse(t *testing.T) {
+	data, rsaKey, ecKey := getTestKeySetData(t)
+
+	keySet, err := Parse(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, keySet.Len())
+
+	rsaPublicKey, err := keySet.Key("rsa")
+	assert.NoError(t, err)
+	assert.True(t, rsaKey.PublicKey.Equal(rsaPublicKey))
+
+	ecPublicKey, err := keySet.Key("ec")
+	assert.NoError(t, err)
+	assert.True(t, ecKey.PublicKey.Equal(ecPublicKey))
+
+	_, err = keySet.Key("enc")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestParse_Error(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{name: "Invalid json error", data: `{"keys":`},
+		{name: "Unsupported key type error", data: `{"keys":[{"kid":"1","kty":"oct","k":"c2VjcmV0"}]}`},
+		{name: "Unsupported curve error", data: `{"keys":[{"kid":"1","kty":"EC","crv":"P-192","x":"AQ","y":"AQ"}]}`},
+		{name: "Point is not on the curve error", data: `{"keys":[{"kid":"1","kty":"EC","crv":"P-256","x":"AQ","y":"AQ"}]}`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse([]byte(testCase.data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestProvider_Refresh(t *testing.T) {
+	data, rsaKey, _ := getTestKeySetData(t)
+
+	filePath := path.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	for _, source := range []string{filePath, server.URL} {
+		provider := NewProvider(source, server.Client())
+
+		_, err := provider.Key("rsa")
+		assert.ErrorIs(t, err, ErrKeySetNotLoaded)
+
+		assert.NoError(t, provider.Refresh(context.Background()))
+		assert.False(t, provider.Updated().IsZero())
+
+		publicKey, err := provider.Key("rsa")
+		assert.NoError(t, err)
+		assert.True(t, rsaKey.PublicKey.Equal(publicKey))
+	}
+}
+
+func TestProvider_RefreshKeepsKeySetOnError(t *testing.T) {
+	data, _, _ := getTestKeySetData(t)
+
+	filePath := path.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
+	provider := NewProvider(filePath, nil)
+	assert.NoError(t, provider.Refresh(context.Background()))
+
+	if err := os.WriteFile(filePath, []byte(`{"keys":`), 0600); err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	assert.Error(t, provider.Refresh(context.Background()))
+
+	_, err := provider.Key("ec")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxKeySetSize = 1 << 20
+
+var ErrKeySetNotLoaded = errors.New("jwks: key set is not loaded")
+
+// Provider keeps the key set loaded from the file or the http(s) url and refreshes it on demand.
+type Provider struct {
+	source  string
+	client  *http.Client
+	mu      sync.RWMutex
+	keySet  *KeySet
+	updated time.Time
+}
+
+func NewProvider(source string, client *http.Client) *Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{
+		source: source,
+		client: client,
+	}
+}
+
+func (p *Provider) isUrl() bool {
+	return strings.HasPrefix(p.source, "http://") || strings.HasPrefix(p.source, "https://")
+}
+
+func (p *Provider) read(ctx context.Context) ([]byte, error) {
+	if !p.isUrl() {
+		return os.ReadFile(p.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks: unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize))
+}
+
+// Refresh loads the key set from the source, the previous key set is kept on error.
+func (p *Provider) Refresh(ctx context.Context) error {
+	data, err := p.read(ctx)
+	if err != nil {
+		return err
+	}
+	keySet, err := Parse(data)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keySet = keySet
+	p.updated = time.Now()
+
+	return nil
+}
+
+// Key returns the public key by its id from the last loaded key set.
+func (p *Provider) Key(kid string) (crypto.PublicKey, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.keySet == nil {
+		return nil, ErrKeySetNotLoaded
+	}
+	return p.keySet.Key(kid)
+}
+
+// Updated returns the time of the last successful refresh.
+func (p *Provider) Updated() time.Time {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.updated
+}
@@ -0,0 +1,44 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+const RequestIdKey = "requestId"
+
+type requestIdContextKey struct{}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+// WithRequestId returns a copy of ctx carrying the request id,
+// records logged with the context include it.
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdContextKey{}, requestId)
+}
+
+// GetRequestId returns the request id carried by ctx or an empty string.
+func GetRequestId(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestId, _ := ctx.Value(requestIdContextKey{}).(string)
+	return requestId
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if requestId := GetRequestId(ctx); requestId != "" {
+		r.AddAttrs(slog.String(RequestIdKey, requestId))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextHandler(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name:     "With request id",
+			ctx:      WithRequestId(context.Background(), "4c1f7a52"),
+			expected: "level=INFO msg=message requestId=4c1f7a52\n",
+		},
+		{
+			name:     "Without request id",
+			ctx:      context.Background(),
+			expected: "level=INFO msg=message\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			logger := slog.New(&contextHandler{slog.NewTextHandler(b, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			})})
+
+			logger.InfoContext(testCase.ctx, "message")
+
+			assert.Equal(t, testCase.expected, b.String())
+		})
+	}
+}
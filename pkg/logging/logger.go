@@ -17,15 +17,15 @@ type (
 )
 
 func getLocalLogger() *Logger {
-	return slog.New(newPrettyHandler(&slog.HandlerOptions{Level: slog.LevelDebug}))
+	return slog.New(&contextHandler{newPrettyHandler(&slog.HandlerOptions{Level: slog.LevelDebug})})
 }
 
 func getDevLogger() *Logger {
-	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	return slog.New(&contextHandler{slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})})
 }
 
 func getProdLogger() *Logger {
-	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	return slog.New(&contextHandler{slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})})
 }
 
 func GetLogger(mode Mode) *Logger {
@@ -0,0 +1,106 @@
+package oidc
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrTokenInvalid = errors.New("oidc: token is invalid")
+	ErrTokenExpired = errors.New("oidc: token is expired")
+)
+
+// SigningMethods are the only accepted token algorithms.
+var SigningMethods = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+}
+
+type (
+	IKeyProvider interface {
+		Key(kid string) (crypto.PublicKey, error)
+	}
+
+	// Verifier checks the signature of the token by the key set and its issuer, audience and expiry.
+	Verifier struct {
+		keys   IKeyProvider
+		parser *jwt.Parser
+	}
+)
+
+func NewVerifier(keys IKeyProvider, issuer string, audience string, leeway time.Duration) *Verifier {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(SigningMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
+	return &Verifier{
+		keys:   keys,
+		parser: jwt.NewParser(options...),
+	}
+}
+
+func (v *Verifier) keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	return v.keys.Key(kid)
+}
+
+// Verify returns the claims of the valid token.
+func (v *Verifier) Verify(tokenString string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.keyfunc); err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, fmt.Errorf("%w: %w", ErrTokenExpired, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrTokenInvalid, err)
+	}
+
+	return claims, nil
+}
+
+// IsToken reports whether the value looks like a compact serialized token.
+func IsToken(value string) bool {
+	return strings.Count(value, ".") == 2
+}
+
+// ClaimStrings returns the string or the list of strings of the claim by the dot separated path,
+// like realm_access.roles, and nil if the claim is missing or has another type.
+func ClaimStrings(claims jwt.MapClaims, path string) []string {
+	var value any = map[string]any(claims)
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if value, ok = object[name]; !ok {
+			return nil
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case string:
+		return strings.Fields(typedValue)
+	case []any:
+		values := make([]string, 0, len(typedValue))
+		for _, item := range typedValue {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"pg-sh-scripts/pkg/jwks"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testIssuer   = "https://sso.example.com/realms/main"
+	testAudience = "pg-sh-scripts"
+)
+
+func getTestKeySet(t *testing.T, publicKeys map[string]crypto.PublicKey) *jwks.KeySet {
+	jsonWebKeySet := jwks.JSONWebKeySet{}
+	for kid, publicKey := range publicKeys {
+		jsonWebKey, err := jwks.NewJSONWebKey(kid, publicKey)
+		if err != nil {
+			t.Fatalf("%s Error: %s", t.Name(), err)
+		}
+		jsonWebKeySet.Keys = append(jsonWebKeySet.Keys, jsonWebKey)
+	}
+
+	data, err := json.Marshal(jsonWebKeySet)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	keySet, err := jwks.Parse(data)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	return keySet
+}
+
+func signTestToken(t *testing.T, method jwt.SigningMethod, kid string, key crypto.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+
+	tokenString, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	return tokenString
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+	otherRsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("%s Error: %s", t.Name(), err)
+	}
+
+	keySet := getTestKeySet(t, map[string]crypto.PublicKey{
+		"rsa": &rsaKey.PublicKey,
+		"ec":  &ecKey.PublicKey,
+	})
+	verifier := NewVerifier(keySet, testIssuer, testAudience, time.Second)
+
+	getClaims := func(update func(jwt.MapClaims)) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"iss": testIssuer,
+			"aud": []string{testAudience, "account"},
+			"sub": "user-1",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+		if update != nil {
+			update(claims)
+		}
+		return claims
+	}
+
+	testCases := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{
+			name:  "Success RS256",
+			token: signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, getClaims(nil)),
+		},
+		{
+			name:  "Success ES256",
+			token: signTestToken(t, jwt.SigningMethodES256, "ec", ecKey, getClaims(nil)),
+		},
+		{
+			name: "Expired error",
+			token: signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, getClaims(func(claims jwt.MapClaims) {
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			})),
+			err: ErrTokenExpired,
+		},
+		{
+			name: "Missing expiry error",
+			token: signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, getClaims(func(claims jwt.MapClaims) {
+				delete(claims, "exp")
+			})),
+			err: ErrTokenInvalid,
+		},
+		{
+			name: "Issuer error",
+			token: signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, getClaims(func(claims jwt.MapClaims) {
+				claims["iss"] = "https://evil.example.com"
+			})),
+			err: ErrTokenInvalid,
+		},
+		{
+			name: "Audience error",
+			token: signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, getClaims(func(claims jwt.MapClaims) {
+				claims["aud"] = "another"
+			})),
+			err: ErrTokenInvalid,
+		},
+		{
+			name:  "Unknown key error",
+			token: signTestToken(t, jwt.SigningMethodRS256, "unknown", rsaKey, getClaims(nil)),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "Signature error",
+			token: signTestToken(t, jwt.SigningMethodRS256, "rsa", otherRsaKey, getClaims(nil)),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "Signing method error",
+			token: signTestToken(t, jwt.SigningMethodHS256, "rsa", []byte("secret"), getClaims(nil)),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "Malformed error",
+			token: "a.b.c",
+			err:   ErrTokenInvalid,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			claims, err := verifier.Verify(testCase.token)
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "user-1", claims["sub"])
+		})
+	}
+}
+
+func TestClaimStrings(t *testing.T) {
+	claims := jwt.MapClaims{
+		"roles": []any{"operator", 1, "viewer"},
+		"scope": "openid bash:read",
+		"realm_access": map[string]any{
+			"roles": []any{"admin"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		path     string
+		expected []string
+	}{
+		{name: "List", path: "roles", expected: []string{"operator", "viewer"}},
+		{name: "Space separated string", path: "scope", expected: []string{"openid", "bash:read"}},
+		{name: "Nested list", path: "realm_access.roles", expected: []string{"admin"}},
+		{name: "Missing claim", path: "groups", expected: nil},
+		{name: "Missing nested claim", path: "scope.roles", expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ClaimStrings(claims, testCase.path))
+		})
+	}
+}
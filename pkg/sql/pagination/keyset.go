@@ -0,0 +1,153 @@
+package pagination
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	uuid "github.com/satori/go.uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+type (
+	// KeysetItem is the row of the keyset page, the page is ordered by its (created_at, id) key.
+	KeysetItem interface {
+		GetKeysetKey() (time.Time, string)
+	}
+
+	// Cursor points to the row after which the next page starts,
+	// or before which the previous page ends when it is backward.
+	Cursor struct {
+		CreatedAt time.Time `json:"c"`
+		Id        string    `json:"i"`
+		Backward  bool      `json:"b,omitempty"`
+	}
+)
+
+// Encode returns the opaque token of the cursor.
+func (c *Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses the opaque cursor token, an empty token points to the first page.
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	// The id is compared with the uuid column, so a malformed one would fail in postgres instead.
+	if _, err := uuid.FromString(cursor.Id); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+func getKeysetCursor[T KeysetItem](item T, backward bool) *string {
+	createdAt, id := item.GetKeysetKey()
+	token := (&Cursor{CreatedAt: createdAt, Id: id, Backward: backward}).Encode()
+	return &token
+}
+
+// getKeysetQuery wraps the query with the keyset condition of the cursor and the (created_at, id) order,
+// the rows before the cursor are selected in the reverse order.
+func getKeysetQuery(query string, argsCount int, cursor *Cursor) string {
+	var where string
+	order := "ASC"
+
+	if cursor != nil {
+		operator := ">"
+		if cursor.Backward {
+			operator = "<"
+			order = "DESC"
+		}
+		where = fmt.Sprintf(" WHERE (q1.created_at, q1.id) %s ($%d, $%d)", operator, argsCount+1, argsCount+2)
+		argsCount += 2
+	}
+
+	return fmt.Sprintf(
+		"SELECT * FROM (%s) AS q1%s ORDER BY q1.created_at %s, q1.id %s LIMIT $%d",
+		query,
+		where,
+		order,
+		order,
+		argsCount+1,
+	)
+}
+
+// getKeysetPage builds the page of the rows selected with one extra row,
+// which tells if there are more rows in the direction of the cursor.
+func getKeysetPage[T KeysetItem](items []T, params KeysetParams) KeysetPage[T] {
+	page := KeysetPage[T]{Limit: params.Limit}
+
+	hasMore := len(items) > params.Limit
+	if hasMore {
+		items = items[:params.Limit]
+	}
+
+	backward := params.Cursor != nil && params.Cursor.Backward
+	if backward {
+		slices.Reverse(items)
+	}
+	page.Items = items
+
+	if len(items) == 0 {
+		return page
+	}
+
+	if hasMore || backward {
+		page.Next = getKeysetCursor(items[len(items)-1], false)
+	}
+	if (hasMore && backward) || (params.Cursor != nil && !backward) {
+		page.Prev = getKeysetCursor(items[0], true)
+	}
+
+	return page
+}
+
+// PaginateKeyset selects the page of the query rows ordered by (created_at, id) after or before the cursor,
+// the query must select the created_at and id columns and must not be ordered or limited.
+func PaginateKeyset[T KeysetItem](
+	ctx context.Context,
+	db Querier,
+	query string,
+	params KeysetParams,
+	args ...any,
+) (KeysetPage[T], error) {
+	if params.Limit == 0 {
+		return KeysetPage[T]{Items: make([]T, 0)}, nil
+	}
+
+	argsCount := len(args)
+
+	itemsArgs := make([]any, 0, argsCount+3)
+	itemsArgs = append(itemsArgs, args...)
+	if params.Cursor != nil {
+		itemsArgs = append(itemsArgs, params.Cursor.CreatedAt, params.Cursor.Id)
+	}
+	itemsArgs = append(itemsArgs, params.Limit+1)
+
+	items := make([]T, 0, params.Limit+1)
+	qItems := getKeysetQuery(query, argsCount, params.Cursor)
+
+	if err := pgxscan.Select(ctx, db, &items, qItems, itemsArgs...); err != nil {
+		return KeysetPage[T]{Limit: params.Limit}, err
+	}
+
+	return getKeysetPage(items, params), nil
+}
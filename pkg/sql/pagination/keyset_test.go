@@ -0,0 +1,193 @@
+package pagination
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type keysetItem struct {
+	id        string
+	createdAt time.Time
+}
+
+// GetKeysetKey pads the short id of the item to the uuid, since the cursor accepts uuid ids only.
+func (i *keysetItem) GetKeysetKey() (time.Time, string) {
+	return i.createdAt, fmt.Sprintf("00000000-0000-0000-0000-%012s", i.id)
+}
+
+func getKeysetItems(from, to int) []*keysetItem {
+	createdAt := time.Date(2024, 4, 14, 15, 50, 21, 907561000, time.UTC)
+
+	items := make([]*keysetItem, 0, to-from)
+	for i := from; i < to; i++ {
+		items = append(items, &keysetItem{id: strconv.Itoa(i), createdAt: createdAt.Add(time.Duration(i) * time.Second)})
+	}
+	return items
+}
+
+func getItemCursor(item *keysetItem, backward bool) *Cursor {
+	createdAt, id := item.GetKeysetKey()
+	return &Cursor{CreatedAt: createdAt, Id: id, Backward: backward}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	cursor := &Cursor{
+		CreatedAt: time.Date(2024, 4, 14, 15, 50, 21, 907561000, time.UTC),
+		Id:        "59628b82-356c-4745-bc81-187015cde387",
+		Backward:  true,
+	}
+
+	decoded, err := DecodeCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.Id, decoded.Id)
+	assert.Equal(t, cursor.Backward, decoded.Backward)
+
+	decoded, err = DecodeCursor("")
+	assert.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	invalidIdCursor := &Cursor{CreatedAt: cursor.CreatedAt, Id: "1'; DROP TABLE bash_log; --"}
+	for _, token := range []string{"not base64!", "bm90IGpzb24", "e30", invalidIdCursor.Encode()} {
+		_, err = DecodeCursor(token)
+		assert.ErrorIs(t, err, ErrInvalidCursor, token)
+	}
+}
+
+func TestGetKeysetQuery(t *testing.T) {
+	query := "SELECT id, created_at FROM scripts.bash_log WHERE bash_id = $1"
+
+	assert.Equal(
+		t,
+		"SELECT * FROM (SELECT id, created_at FROM scripts.bash_log WHERE bash_id = $1) AS q1 "+
+			"ORDER BY q1.created_at ASC, q1.id ASC LIMIT $2",
+		getKeysetQuery(query, 1, nil),
+	)
+	assert.Equal(
+		t,
+		"SELECT * FROM (SELECT id, created_at FROM scripts.bash_log WHERE bash_id = $1) AS q1 "+
+			"WHERE (q1.created_at, q1.id) > ($2, $3) ORDER BY q1.created_at ASC, q1.id ASC LIMIT $4",
+		getKeysetQuery(query, 1, &Cursor{}),
+	)
+	assert.Equal(
+		t,
+		"SELECT * FROM (SELECT id, created_at FROM scripts.bash_log WHERE bash_id = $1) AS q1 "+
+			"WHERE (q1.created_at, q1.id) < ($2, $3) ORDER BY q1.created_at DESC, q1.id DESC LIMIT $4",
+		getKeysetQuery(query, 1, &Cursor{Backward: true}),
+	)
+}
+
+func TestGetKeysetPage(t *testing.T) {
+	items := getKeysetItems(0, 10)
+
+	type expectedStruct struct {
+		ids  []string
+		next *Cursor
+		prev *Cursor
+	}
+
+	testCases := []struct {
+		name     string
+		selected []*keysetItem
+		params   KeysetParams
+		expected expectedStruct
+	}{
+		{
+			name:     "Empty",
+			selected: []*keysetItem{},
+			params:   KeysetParams{Limit: 3},
+			expected: expectedStruct{ids: []string{}},
+		},
+		{
+			name:     "Single first page",
+			selected: items[0:2],
+			params:   KeysetParams{Limit: 3},
+			expected: expectedStruct{ids: []string{"0", "1"}},
+		},
+		{
+			name:     "First page",
+			selected: items[0:4],
+			params:   KeysetParams{Limit: 3},
+			expected: expectedStruct{
+				ids:  []string{"0", "1", "2"},
+				next: getItemCursor(items[2], false),
+			},
+		},
+		{
+			name:     "Next page",
+			selected: items[3:7],
+			params:   KeysetParams{Limit: 3, Cursor: getItemCursor(items[2], false)},
+			expected: expectedStruct{
+				ids:  []string{"3", "4", "5"},
+				next: getItemCursor(items[5], false),
+				prev: getItemCursor(items[3], true),
+			},
+		},
+		{
+			name:     "Last page",
+			selected: items[9:10],
+			params:   KeysetParams{Limit: 3, Cursor: getItemCursor(items[8], false)},
+			expected: expectedStruct{
+				ids:  []string{"9"},
+				prev: getItemCursor(items[9], true),
+			},
+		},
+		{
+			name:     "Previous page",
+			selected: []*keysetItem{items[5], items[4], items[3], items[2]},
+			params:   KeysetParams{Limit: 3, Cursor: getItemCursor(items[6], true)},
+			expected: expectedStruct{
+				ids:  []string{"3", "4", "5"},
+				next: getItemCursor(items[5], false),
+				prev: getItemCursor(items[3], true),
+			},
+		},
+		{
+			name:     "Previous first page",
+			selected: []*keysetItem{items[2], items[1], items[0]},
+			params:   KeysetParams{Limit: 3, Cursor: getItemCursor(items[3], true)},
+			expected: expectedStruct{
+				ids:  []string{"0", "1", "2"},
+				next: getItemCursor(items[2], false),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			selected := append([]*keysetItem{}, testCase.selected...)
+			page := getKeysetPage(selected, testCase.params)
+
+			ids := make([]string, 0, len(page.Items))
+			for _, item := range page.Items {
+				ids = append(ids, item.id)
+			}
+			assert.Equal(t, testCase.expected.ids, ids)
+			assert.Equal(t, testCase.params.Limit, page.Limit)
+
+			for _, c := range []struct {
+				token    *string
+				expected *Cursor
+			}{
+				{token: page.Next, expected: testCase.expected.next},
+				{token: page.Prev, expected: testCase.expected.prev},
+			} {
+				if c.expected == nil {
+					assert.Nil(t, c.token)
+					continue
+				}
+				if assert.NotNil(t, c.token) {
+					cursor, err := DecodeCursor(*c.token)
+					assert.NoError(t, err)
+					assert.Equal(t, c.expected.Id, cursor.Id)
+					assert.True(t, c.expected.CreatedAt.Equal(cursor.CreatedAt))
+					assert.Equal(t, c.expected.Backward, cursor.Backward)
+				}
+			}
+		})
+	}
+}
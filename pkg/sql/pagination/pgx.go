@@ -3,15 +3,42 @@ package pagination
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5"
 )
 
+// Querier is the pool or the transaction the page is selected with.
+type Querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type explainPlan struct {
+	Plan struct {
+		PlanRows float64 `json:"Plan Rows"`
+	} `json:"Plan"`
+}
+
 func Paginate[T any](
 	ctx context.Context,
-	db *pgxpool.Pool,
+	db Querier,
+	query string,
+	params LimitOffsetParams,
+	args ...any,
+) (LimitOffsetPage[T], error) {
+	return PaginateOrdered[T](ctx, db, query, "", params, args...)
+}
+
+// PaginateOrdered pages the query rows sorted by the ORDER BY clause,
+// the clause is applied to the items only, so the total is counted without sorting.
+// One extra row is selected to tell if there are more rows, the total is produced by the total mode.
+func PaginateOrdered[T any](
+	ctx context.Context,
+	db Querier,
 	query string,
+	orderBy string,
 	params LimitOffsetParams,
 	args ...any,
 ) (LimitOffsetPage[T], error) {
@@ -29,25 +56,58 @@ func Paginate[T any](
 			totalArgs = append(totalArgs, arg)
 		}
 	}
-	itemsArgs = append(itemsArgs, params.Offset, params.Limit)
+	itemsArgs = append(itemsArgs, params.Offset, params.Limit+1)
 
-	items := make([]T, 0, params.Limit)
+	items := make([]T, 0, params.Limit+1)
 	page := LimitOffsetPage[T]{
-		Limit:  params.Limit,
-		Offset: params.Offset,
+		Limit:     params.Limit,
+		Offset:    params.Offset,
+		TotalMode: params.TotalMode,
+	}
+	if page.TotalMode == "" {
+		page.TotalMode = TotalModeExact
 	}
 
-	qItems := fmt.Sprintf("%s OFFSET $%d LIMIT $%d", query, offsetArgNumber, limitArgNumber)
-	qTotal := fmt.Sprintf("SELECT COUNT(*) AS total FROM (%s) AS q1", query)
+	qItems := fmt.Sprintf("%s %s OFFSET $%d LIMIT $%d", query, orderBy, offsetArgNumber, limitArgNumber)
 
 	if err := pgxscan.Select(ctx, db, &items, qItems, itemsArgs...); err != nil {
 		return page, err
 	}
+	page.HasMore = len(items) > params.Limit
+	if page.HasMore {
+		items = items[:params.Limit]
+	}
 	page.Items = items
 
-	if err := pgxscan.Get(ctx, db, &page.Total, qTotal, totalArgs...); err != nil {
-		return page, err
+	switch page.TotalMode {
+	case TotalModeExact:
+		qTotal := fmt.Sprintf("SELECT COUNT(*) AS total FROM (%s) AS q1", query)
+
+		if err := pgxscan.Get(ctx, db, &page.Total, qTotal, totalArgs...); err != nil {
+			return page, err
+		}
+	case TotalModeEstimate:
+		var plans []explainPlan
+		qEstimate := fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", query)
+
+		if err := db.QueryRow(ctx, qEstimate, totalArgs...).Scan(&plans); err != nil {
+			return page, err
+		}
+		page.Total = getEstimatedTotal(plans, page)
 	}
 
 	return page, nil
 }
+
+// getEstimatedTotal returns the planner estimate of the query rows,
+// which is never less than the rows already seen by the page.
+func getEstimatedTotal[T any](plans []explainPlan, page LimitOffsetPage[T]) int {
+	seen := page.Offset + len(page.Items)
+	if page.HasMore {
+		seen++
+	}
+	if len(plans) == 0 {
+		return seen
+	}
+	return max(int(math.Round(plans[0].Plan.PlanRows)), seen)
+}
@@ -0,0 +1,64 @@
+package pagination
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTotalMode(t *testing.T) {
+	for _, mode := range []TotalMode{TotalModeExact, TotalModeEstimate, TotalModeNone} {
+		parsed, err := ParseTotalMode(string(mode))
+		assert.NoError(t, err)
+		assert.Equal(t, mode, parsed)
+	}
+
+	for _, raw := range []string{"", "Exact", "count"} {
+		_, err := ParseTotalMode(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestGetEstimatedTotal(t *testing.T) {
+	var plans []explainPlan
+	err := json.Unmarshal([]byte(`[{"Plan": {"Node Type": "Seq Scan", "Plan Rows": 1520.4}}]`), &plans)
+	assert.NoError(t, err)
+
+	testTable := []struct {
+		name     string
+		plans    []explainPlan
+		page     LimitOffsetPage[int]
+		expected int
+	}{
+		{
+			name:     "Plan rows",
+			plans:    plans,
+			page:     LimitOffsetPage[int]{Items: []int{1, 2}, Offset: 10, HasMore: true},
+			expected: 1520,
+		},
+		{
+			name:     "Plan rows less than seen rows",
+			plans:    plans,
+			page:     LimitOffsetPage[int]{Items: []int{1, 2}, Offset: 2000},
+			expected: 2002,
+		},
+		{
+			name:     "Plan rows less than seen rows with more rows",
+			plans:    plans,
+			page:     LimitOffsetPage[int]{Items: []int{1, 2}, Offset: 2000, HasMore: true},
+			expected: 2003,
+		},
+		{
+			name:     "No plans",
+			page:     LimitOffsetPage[int]{Items: []int{1}, Offset: 5},
+			expected: 6,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, getEstimatedTotal(testCase.plans, testCase.page))
+		})
+	}
+}
@@ -1,15 +1,54 @@
 package pagination
 
+import "fmt"
+
+const (
+	// TotalModeExact counts the rows of the query, it is used by default.
+	TotalModeExact TotalMode = "exact"
+	// TotalModeEstimate takes the row estimate of the query plan, so large tables are not scanned.
+	TotalModeEstimate TotalMode = "estimate"
+	// TotalModeNone skips the total, the page tells only if there are more rows.
+	TotalModeNone TotalMode = "none"
+)
+
 type (
+	TotalMode string
+
 	LimitOffsetParams struct {
-		Limit  int `json:"limit"`
-		Offset int `json:"offset"`
+		Limit     int       `json:"limit"`
+		Offset    int       `json:"offset"`
+		TotalMode TotalMode `json:"totalMode"`
 	}
 
 	LimitOffsetPage[T any] struct {
-		Items  []T `json:"items"`
-		Limit  int `json:"limit"`
-		Offset int `json:"offset"`
-		Total  int `json:"total"`
+		Items     []T       `json:"items"`
+		Limit     int       `json:"limit"`
+		Offset    int       `json:"offset"`
+		Total     int       `json:"total"`
+		TotalMode TotalMode `json:"totalMode"`
+		HasMore   bool      `json:"hasMore"`
+	}
+)
+
+func ParseTotalMode(raw string) (TotalMode, error) {
+	switch mode := TotalMode(raw); mode {
+	case TotalModeExact, TotalModeEstimate, TotalModeNone:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid total mode: %s", raw)
+	}
+}
+
+type (
+	KeysetParams struct {
+		Limit  int     `json:"limit"`
+		Cursor *Cursor `json:"cursor"`
+	}
+
+	KeysetPage[T any] struct {
+		Items []T     `json:"items"`
+		Limit int     `json:"limit"`
+		Next  *string `json:"next"`
+		Prev  *string `json:"prev"`
 	}
 )
@@ -0,0 +1,73 @@
+package partition
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const monthLayout = "200601"
+
+// MonthRange is the monthly range partition of the table, From is inclusive and To is exclusive.
+type MonthRange struct {
+	Name string
+	From time.Time
+	To   time.Time
+}
+
+// GetMonthRange returns the partition range of the UTC month of t named as <table>_pYYYYMM.
+func GetMonthRange(table string, t time.Time) MonthRange {
+	t = t.UTC()
+	from := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return MonthRange{
+		Name: fmt.Sprintf("%s_p%s", table, from.Format(monthLayout)),
+		From: from,
+		To:   from.AddDate(0, 1, 0),
+	}
+}
+
+// ParseMonthRange returns the partition range by the name of GetMonthRange,
+// false is returned for the other partitions like the default one.
+func ParseMonthRange(table string, name string) (MonthRange, bool) {
+	month, ok := strings.CutPrefix(name, table+"_p")
+	if !ok || len(month) != len(monthLayout) {
+		return MonthRange{}, false
+	}
+
+	from, err := time.Parse(monthLayout, month)
+	if err != nil {
+		return MonthRange{}, false
+	}
+	return GetMonthRange(table, from), true
+}
+
+// GetPremakeRanges returns the partition ranges of the current month and the next premakeMonths months.
+func GetPremakeRanges(table string, now time.Time, premakeMonths int) []MonthRange {
+	current := GetMonthRange(table, now)
+
+	ranges := make([]MonthRange, 0, premakeMonths+1)
+	for i := 0; i <= premakeMonths; i++ {
+		ranges = append(ranges, GetMonthRange(table, current.From.AddDate(0, i, 0)))
+	}
+	return ranges
+}
+
+// GetExpiredRanges returns the ranges of the named partitions which ended before
+// the last retentionMonths months, the zero retention keeps all partitions.
+func GetExpiredRanges(table string, names []string, now time.Time, retentionMonths int) []MonthRange {
+	if retentionMonths <= 0 {
+		return nil
+	}
+
+	cutoff := GetMonthRange(table, now).From.AddDate(0, -retentionMonths, 0)
+
+	var ranges []MonthRange
+	for _, name := range names {
+		monthRange, ok := ParseMonthRange(table, name)
+		if !ok || monthRange.To.After(cutoff) {
+			continue
+		}
+		ranges = append(ranges, monthRange)
+	}
+	return ranges
+}
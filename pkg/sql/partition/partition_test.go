@@ -0,0 +1,153 @@
+package partition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMonthRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       time.Time
+		expected string
+	}{
+		{
+			name:     "UTC time",
+			in:       time.Date(2024, 11, 30, 23, 0, 0, 0, time.UTC),
+			expected: "bash_log_p202411",
+		},
+		{
+			name:     "Time ahead of UTC in the next month",
+			in:       time.Date(2024, 12, 1, 2, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			expected: "bash_log_p202411",
+		},
+		{
+			name:     "Time behind UTC in the previous month",
+			in:       time.Date(2024, 11, 30, 22, 0, 0, 0, time.FixedZone("UTC-3", -3*60*60)),
+			expected: "bash_log_p202412",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			monthRange := GetMonthRange("bash_log", testCase.in)
+
+			assert.Equal(t, testCase.expected, monthRange.Name)
+			assert.Equal(t, time.UTC, monthRange.From.Location())
+		})
+	}
+}
+
+func TestParseMonthRange(t *testing.T) {
+	type expectedStruct struct {
+		monthRange MonthRange
+		ok         bool
+	}
+
+	testCases := []struct {
+		name     string
+		inName   string
+		expected expectedStruct
+	}{
+		{
+			name:   "Month partition",
+			inName: "bash_log_p202412",
+			expected: expectedStruct{
+				monthRange: MonthRange{
+					Name: "bash_log_p202412",
+					From: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+					To:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				ok: true,
+			},
+		},
+		{
+			name:     "Default partition",
+			inName:   "bash_log_default",
+			expected: expectedStruct{},
+		},
+		{
+			name:     "Other table",
+			inName:   "audit_log_p202412",
+			expected: expectedStruct{},
+		},
+		{
+			name:     "Invalid month",
+			inName:   "bash_log_p202413",
+			expected: expectedStruct{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			monthRange, ok := ParseMonthRange("bash_log", testCase.inName)
+
+			assert.Equal(t, testCase.expected.ok, ok)
+			assert.Equal(t, testCase.expected.monthRange, monthRange)
+		})
+	}
+}
+
+func TestGetPremakeRanges(t *testing.T) {
+	now := time.Date(2024, 11, 14, 15, 50, 21, 0, time.UTC)
+
+	ranges := GetPremakeRanges("bash_log", now, 2)
+
+	assert.Equal(t, []MonthRange{
+		{
+			Name: "bash_log_p202411",
+			From: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name: "bash_log_p202412",
+			From: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name: "bash_log_p202501",
+			From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}, ranges)
+}
+
+func TestGetExpiredRanges(t *testing.T) {
+	now := time.Date(2024, 11, 14, 15, 50, 21, 0, time.UTC)
+	names := []string{"bash_log_default", "bash_log_p202408", "bash_log_p202409", "bash_log_p202410", "bash_log_p202411"}
+
+	testCases := []struct {
+		name              string
+		inRetentionMonths int
+		expected          []string
+	}{
+		{
+			name:              "Zero retention",
+			inRetentionMonths: 0,
+			expected:          nil,
+		},
+		{
+			name:              "Two months retention",
+			inRetentionMonths: 2,
+			expected:          []string{"bash_log_p202408"},
+		},
+		{
+			name:              "One month retention",
+			inRetentionMonths: 1,
+			expected:          []string{"bash_log_p202408", "bash_log_p202409"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var expiredNames []string
+			for _, monthRange := range GetExpiredRanges("bash_log", names, now, testCase.inRetentionMonths) {
+				expiredNames = append(expiredNames, monthRange.Name)
+			}
+
+			assert.Equal(t, testCase.expected, expiredNames)
+		})
+	}
+}
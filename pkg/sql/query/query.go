@@ -0,0 +1,295 @@
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"pg-sh-scripts/pkg/validation"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const SortParam = "sort"
+
+const (
+	TypeString FieldType = iota
+	TypeInt
+	TypeBool
+	TypeTime
+	TypeUUID
+)
+
+const (
+	OperatorEq   Operator = "eq"
+	OperatorNe   Operator = "ne"
+	OperatorGt   Operator = "gt"
+	OperatorGte  Operator = "gte"
+	OperatorLt   Operator = "lt"
+	OperatorLte  Operator = "lte"
+	OperatorLike Operator = "like"
+	// OperatorMatch matches the POSIX regular expression, the value is checked to compile.
+	OperatorMatch Operator = "match"
+)
+
+var (
+	filterParamRegexp = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9]*)(?:\[([a-z]+)\])?$`)
+
+	sqlOperators = map[Operator]string{
+		OperatorEq:    "=",
+		OperatorNe:    "<>",
+		OperatorGt:    ">",
+		OperatorGte:   ">=",
+		OperatorLt:    "<",
+		OperatorLte:   "<=",
+		OperatorLike:  "ILIKE",
+		OperatorMatch: "~",
+	}
+
+	likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+)
+
+type (
+	FieldType int
+	Operator  string
+
+	// Field is the whitelisted field of the list, which is filtered and sorted by its column.
+	Field struct {
+		Column    string
+		Type      FieldType
+		Operators []Operator
+		// DefaultOperator is used for the param without operator, eq when it is empty.
+		DefaultOperator Operator
+		Sortable        bool
+	}
+
+	// Schema whitelists the fields of the list by their names in the request.
+	Schema struct {
+		Fields map[string]Field
+		// DefaultSort is used when the request has no sort param.
+		DefaultSort []Order
+		// Tiebreaker is the unique column appended to the order, so the pages are stable.
+		Tiebreaker string
+	}
+
+	Condition struct {
+		Column   string
+		Operator Operator
+		Value    any
+	}
+
+	Order struct {
+		Column string
+		Desc   bool
+	}
+
+	// Params are the parsed filters and order of the list.
+	Params struct {
+		Conditions []Condition
+		Orders     []Order
+	}
+)
+
+func (t FieldType) parse(raw string) (any, string) {
+	switch t {
+	case TypeInt:
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, "int"
+		}
+		return value, ""
+	case TypeBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, "boolean"
+		}
+		return value, ""
+	case TypeTime:
+		value, err := time.Parse(time.RFC3339Nano, raw)
+		if err != nil {
+			return nil, "datetime=RFC3339"
+		}
+		// The columns are stored in UTC without the time zone, which pgx drops from the value.
+		return value.UTC(), ""
+	case TypeUUID:
+		value, err := uuid.FromString(raw)
+		if err != nil {
+			return nil, "uuid"
+		}
+		return value, ""
+	default:
+		return raw, ""
+	}
+}
+
+func (f Field) allows(operator Operator) bool {
+	for _, allowed := range f.Operators {
+		if allowed == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Schema) parseSort(raw string) ([]Order, []*validation.FieldError) {
+	var fieldErrors []*validation.FieldError
+
+	orders := make([]Order, 0)
+	seen := make(map[string]bool)
+
+	for _, name := range strings.Split(raw, ",") {
+		order := Order{}
+		if strings.HasPrefix(name, "-") {
+			order.Desc = true
+			name = name[1:]
+		}
+
+		field, ok := s.Fields[name]
+		switch {
+		case !ok:
+			fieldErrors = append(fieldErrors, &validation.FieldError{Field: SortParam, Reason: "oneof", Value: name})
+			continue
+		case !field.Sortable:
+			fieldErrors = append(fieldErrors, &validation.FieldError{Field: SortParam, Reason: "sortable", Value: name})
+			continue
+		case seen[name]:
+			fieldErrors = append(fieldErrors, &validation.FieldError{Field: SortParam, Reason: "unique", Value: name})
+			continue
+		}
+		seen[name] = true
+
+		order.Column = field.Column
+		orders = append(orders, order)
+	}
+
+	return orders, fieldErrors
+}
+
+func (s Schema) withTiebreaker(orders []Order) []Order {
+	if s.Tiebreaker == "" {
+		return orders
+	}
+	for _, order := range orders {
+		if order.Column == s.Tiebreaker {
+			return orders
+		}
+	}
+	return append(orders, Order{Column: s.Tiebreaker})
+}
+
+// Parse reads the sort param and the filter params like createdAt[gte] or isError of the request,
+// the params which are not the schema fields are skipped, the invalid ones are reported by the param name.
+func (s Schema) Parse(values url.Values) (Params, []*validation.FieldError) {
+	var fieldErrors []*validation.FieldError
+
+	params := Params{Conditions: make([]Condition, 0)}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if key == SortParam {
+			continue
+		}
+
+		matches := filterParamRegexp.FindStringSubmatch(key)
+		if matches == nil {
+			continue
+		}
+		name, rawOperator := matches[1], matches[2]
+
+		field, ok := s.Fields[name]
+		if !ok {
+			if rawOperator != "" {
+				fieldErrors = append(fieldErrors, &validation.FieldError{Field: key, Reason: "unknown", Value: values.Get(key)})
+			}
+			continue
+		}
+
+		operator := OperatorEq
+		if field.DefaultOperator != "" {
+			operator = field.DefaultOperator
+		}
+		if rawOperator != "" {
+			operator = Operator(rawOperator)
+		}
+		if !field.allows(operator) {
+			fieldErrors = append(fieldErrors, &validation.FieldError{Field: key, Reason: "operator", Value: values.Get(key)})
+			continue
+		}
+
+		for _, raw := range values[key] {
+			value, reason := field.Type.parse(raw)
+			if reason != "" {
+				fieldErrors = append(fieldErrors, &validation.FieldError{Field: key, Reason: reason, Value: raw})
+				continue
+			}
+			switch operator {
+			case OperatorLike:
+				value = "%" + likeReplacer.Replace(raw) + "%"
+			case OperatorMatch:
+				if _, err := regexp.Compile(raw); err != nil {
+					fieldErrors = append(fieldErrors, &validation.FieldError{Field: key, Reason: "regexp", Value: raw})
+					continue
+				}
+			}
+			params.Conditions = append(params.Conditions, Condition{Column: field.Column, Operator: operator, Value: value})
+		}
+	}
+
+	orders := s.DefaultSort
+	if raw, ok := values[SortParam]; ok {
+		var sortErrors []*validation.FieldError
+		orders, sortErrors = s.parseSort(strings.Join(raw, ","))
+		fieldErrors = append(fieldErrors, sortErrors...)
+	}
+	params.Orders = s.withTiebreaker(append([]Order{}, orders...))
+
+	return params, fieldErrors
+}
+
+// Where returns the conditions joined with AND, their values are the arguments numbered from argNumber,
+// TRUE is returned when there are no conditions.
+func (p Params) Where(argNumber int) (string, []any) {
+	if len(p.Conditions) == 0 {
+		return "TRUE", nil
+	}
+
+	clauses := make([]string, 0, len(p.Conditions))
+	args := make([]any, 0, len(p.Conditions))
+
+	for i, condition := range p.Conditions {
+		clause := fmt.Sprintf("%s %s $%d", condition.Column, sqlOperators[condition.Operator], argNumber+i)
+		if condition.Operator == OperatorLike {
+			clause += ` ESCAPE '\'`
+		}
+		clauses = append(clauses, clause)
+		args = append(args, condition.Value)
+	}
+
+	return strings.Join(clauses, " AND "), args
+}
+
+// OrderBy returns the ORDER BY clause of the orders or an empty string.
+func (p Params) OrderBy() string {
+	if len(p.Orders) == 0 {
+		return ""
+	}
+
+	columns := make([]string, 0, len(p.Orders))
+	for _, order := range p.Orders {
+		direction := "ASC"
+		if order.Desc {
+			direction = "DESC"
+		}
+		columns = append(columns, order.Column+" "+direction)
+	}
+
+	return "ORDER BY " + strings.Join(columns, ", ")
+}
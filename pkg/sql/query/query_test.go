@@ -0,0 +1,185 @@
+package query
+
+import (
+	"net/url"
+	"pg-sh-scripts/pkg/validation"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = Schema{
+	Fields: map[string]Field{
+		"id":        {Column: "id", Type: TypeUUID, Operators: []Operator{OperatorEq}},
+		"title":     {Column: "title", Type: TypeString, Operators: []Operator{OperatorEq, OperatorNe, OperatorLike}, Sortable: true},
+		"isError":   {Column: "is_error", Type: TypeBool, Operators: []Operator{OperatorEq}, Sortable: true},
+		"exitCode":  {Column: "exit_code", Type: TypeInt, Operators: []Operator{OperatorEq, OperatorGt}},
+		"createdAt": {Column: "created_at", Type: TypeTime, Operators: []Operator{OperatorGte, OperatorLt}, Sortable: true},
+		"from":      {Column: "created_at", Type: TypeTime, Operators: []Operator{OperatorGte}, DefaultOperator: OperatorGte},
+		"output":    {Column: "output", Type: TypeString, Operators: []Operator{OperatorMatch}, DefaultOperator: OperatorMatch},
+	},
+	DefaultSort: []Order{{Column: "created_at"}},
+	Tiebreaker:  "id",
+}
+
+func TestSchema_Parse(t *testing.T) {
+	type expectedStruct struct {
+		params      Params
+		fieldErrors []*validation.FieldError
+	}
+
+	createdAt := time.Date(2024, 4, 14, 15, 50, 21, 907561000, time.UTC)
+	id := uuid.NewV4()
+
+	testCases := []struct {
+		name     string
+		values   url.Values
+		expected expectedStruct
+	}{
+		{
+			name:   "Default sort",
+			values: url.Values{"limit": {"20"}, "offset": {"0"}},
+			expected: expectedStruct{
+				params: Params{
+					Conditions: []Condition{},
+					Orders:     []Order{{Column: "created_at"}, {Column: "id"}},
+				},
+			},
+		},
+		{
+			name: "Sort and filters",
+			values: url.Values{
+				"sort":           {"-createdAt,title"},
+				"createdAt[gte]": {createdAt.Format(time.RFC3339Nano)},
+				"isError":        {"true"},
+				"title[like]":    {`50%_off\`},
+				"exitCode[gt]":   {"1"},
+				"id":             {id.String()},
+			},
+			expected: expectedStruct{
+				params: Params{
+					Conditions: []Condition{
+						{Column: "created_at", Operator: OperatorGte, Value: createdAt},
+						{Column: "exit_code", Operator: OperatorGt, Value: int64(1)},
+						{Column: "id", Operator: OperatorEq, Value: id},
+						{Column: "is_error", Operator: OperatorEq, Value: true},
+						{Column: "title", Operator: OperatorLike, Value: `%50\%\_off\\%`},
+					},
+					Orders: []Order{{Column: "created_at", Desc: true}, {Column: "title"}, {Column: "id"}},
+				},
+			},
+		},
+		{
+			name:   "Repeated filter",
+			values: url.Values{"title[ne]": {"a", "b"}},
+			expected: expectedStruct{
+				params: Params{
+					Conditions: []Condition{
+						{Column: "title", Operator: OperatorNe, Value: "a"},
+						{Column: "title", Operator: OperatorNe, Value: "b"},
+					},
+					Orders: []Order{{Column: "created_at"}, {Column: "id"}},
+				},
+			},
+		},
+		{
+			name:   "Default operator",
+			values: url.Values{"from": {createdAt.Format(time.RFC3339Nano)}, "output[match]": {`^error: \d+`}},
+			expected: expectedStruct{
+				params: Params{
+					Conditions: []Condition{
+						{Column: "created_at", Operator: OperatorGte, Value: createdAt},
+						{Column: "output", Operator: OperatorMatch, Value: `^error: \d+`},
+					},
+					Orders: []Order{{Column: "created_at"}, {Column: "id"}},
+				},
+			},
+		},
+		{
+			name:   "Time with offset",
+			values: url.Values{"createdAt[gte]": {createdAt.In(time.FixedZone("MSK", 3*60*60)).Format(time.RFC3339Nano)}},
+			expected: expectedStruct{
+				params: Params{
+					Conditions: []Condition{
+						{Column: "created_at", Operator: OperatorGte, Value: createdAt},
+					},
+					Orders: []Order{{Column: "created_at"}, {Column: "id"}},
+				},
+			},
+		},
+		{
+			name: "Invalid params",
+			values: url.Values{
+				"sort":            {"-body,id,title,title"},
+				"body[like]":      {"echo"},
+				"title[gt]":       {"a"},
+				"isError":         {"yes"},
+				"exitCode":        {"one"},
+				"id":              {"uuid"},
+				"createdAt[gte]":  {"yesterday"},
+				"runId":           {"not a filter"},
+				"createdAt[like]": {"2024"},
+				"from[lt]":        {"2024-04-14T15:50:21Z"},
+				"output":          {"(error"},
+			},
+			expected: expectedStruct{
+				params: Params{
+					Conditions: []Condition{},
+					Orders:     []Order{{Column: "title"}, {Column: "id"}},
+				},
+				fieldErrors: []*validation.FieldError{
+					{Field: "body[like]", Reason: "unknown", Value: "echo"},
+					{Field: "createdAt[gte]", Reason: "datetime=RFC3339", Value: "yesterday"},
+					{Field: "createdAt[like]", Reason: "operator", Value: "2024"},
+					{Field: "exitCode", Reason: "int", Value: "one"},
+					{Field: "from[lt]", Reason: "operator", Value: "2024-04-14T15:50:21Z"},
+					{Field: "id", Reason: "uuid", Value: "uuid"},
+					{Field: "isError", Reason: "boolean", Value: "yes"},
+					{Field: "output", Reason: "regexp", Value: "(error"},
+					{Field: "title[gt]", Reason: "operator", Value: "a"},
+					{Field: "sort", Reason: "oneof", Value: "body"},
+					{Field: "sort", Reason: "sortable", Value: "id"},
+					{Field: "sort", Reason: "unique", Value: "title"},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			params, fieldErrors := testSchema.Parse(testCase.values)
+
+			assert.Equal(t, testCase.expected.params, params)
+			assert.Equal(t, testCase.expected.fieldErrors, fieldErrors)
+		})
+	}
+}
+
+func TestParams_Where(t *testing.T) {
+	where, args := Params{}.Where(3)
+	assert.Equal(t, "TRUE", where)
+	assert.Empty(t, args)
+
+	params := Params{
+		Conditions: []Condition{
+			{Column: "created_at", Operator: OperatorGte, Value: "2024-04-14"},
+			{Column: "is_error", Operator: OperatorEq, Value: true},
+			{Column: "title", Operator: OperatorLike, Value: "%deploy%"},
+			{Column: "output", Operator: OperatorMatch, Value: "^error"},
+		},
+	}
+	where, args = params.Where(3)
+	assert.Equal(t, `created_at >= $3 AND is_error = $4 AND title ILIKE $5 ESCAPE '\' AND output ~ $6`, where)
+	assert.Equal(t, []any{"2024-04-14", true, "%deploy%", "^error"}, args)
+}
+
+func TestParams_OrderBy(t *testing.T) {
+	assert.Equal(t, "", Params{}.OrderBy())
+	assert.Equal(
+		t,
+		"ORDER BY created_at DESC, title ASC, id ASC",
+		Params{Orders: []Order{{Column: "created_at", Desc: true}, {Column: "title"}, {Column: "id"}}}.OrderBy(),
+	)
+}
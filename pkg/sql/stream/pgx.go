@@ -0,0 +1,36 @@
+package stream
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// Rows scans the query rows one by one and passes them to fn without loading the whole result set into memory,
+// the error of fn stops the iteration and is returned as is.
+func Rows[T any](
+	ctx context.Context,
+	db pgxscan.Querier,
+	fn func(item *T) error,
+	query string,
+	args ...any,
+) error {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	rowScanner := pgxscan.NewRowScanner(rows)
+	for rows.Next() {
+		item := new(T)
+		if err := rowScanner.Scan(item); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
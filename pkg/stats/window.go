@@ -0,0 +1,56 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidWindow = errors.New("window must be a positive number of minutes, hours, days or weeks like 30m, 24h, 7d or 4w")
+
+var windowUnits = map[byte]time.Duration{
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// Window is the period before now which the stats are aggregated over, Name keeps the parsed form like 7d.
+type Window struct {
+	Name     string
+	Duration time.Duration
+}
+
+// ParseWindow parses the window like 30m, 24h, 7d or 4w.
+func ParseWindow(s string) (Window, error) {
+	if len(s) < 2 {
+		return Window{}, ErrInvalidWindow
+	}
+
+	unit, ok := windowUnits[s[len(s)-1]]
+	if !ok {
+		return Window{}, ErrInvalidWindow
+	}
+	count, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil || count <= 0 || count > int64(1<<63-1)/int64(unit) {
+		return Window{}, ErrInvalidWindow
+	}
+
+	return Window{Name: s, Duration: time.Duration(count) * unit}, nil
+}
+
+// Seconds returns the window duration in whole seconds as it's passed to the sql queries.
+func (w Window) Seconds() int64 {
+	return int64(w.Duration / time.Second)
+}
+
+// UnmarshalText parses the window of the config.
+func (w *Window) UnmarshalText(text []byte) error {
+	window, err := ParseWindow(string(text))
+	if err != nil {
+		return fmt.Errorf("%q: %w", text, err)
+	}
+	*w = window
+	return nil
+}
@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWindow(t *testing.T) {
+	type expectedStruct struct {
+		window Window
+		err    error
+	}
+
+	testCases := []struct {
+		name     string
+		in       string
+		expected expectedStruct
+	}{
+		{
+			name:     "Minutes",
+			in:       "30m",
+			expected: expectedStruct{window: Window{Name: "30m", Duration: 30 * time.Minute}},
+		},
+		{
+			name:     "Hours",
+			in:       "24h",
+			expected: expectedStruct{window: Window{Name: "24h", Duration: 24 * time.Hour}},
+		},
+		{
+			name:     "Days",
+			in:       "7d",
+			expected: expectedStruct{window: Window{Name: "7d", Duration: 7 * 24 * time.Hour}},
+		},
+		{
+			name:     "Weeks",
+			in:       "4w",
+			expected: expectedStruct{window: Window{Name: "4w", Duration: 28 * 24 * time.Hour}},
+		},
+		{
+			name:     "Unknown unit",
+			in:       "1y",
+			expected: expectedStruct{err: ErrInvalidWindow},
+		},
+		{
+			name:     "Zero",
+			in:       "0d",
+			expected: expectedStruct{err: ErrInvalidWindow},
+		},
+		{
+			name:     "Negative",
+			in:       "-1d",
+			expected: expectedStruct{err: ErrInvalidWindow},
+		},
+		{
+			name:     "Overflow",
+			in:       "9999999999w",
+			expected: expectedStruct{err: ErrInvalidWindow},
+		},
+		{
+			name:     "Without count",
+			in:       "d",
+			expected: expectedStruct{err: ErrInvalidWindow},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			window, err := ParseWindow(testCase.in)
+
+			assert.ErrorIs(t, err, testCase.expected.err)
+			assert.Equal(t, testCase.expected.window, window)
+		})
+	}
+}
+
+func TestWindow_UnmarshalText(t *testing.T) {
+	var window Window
+
+	err := window.UnmarshalText([]byte("24h"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, Window{Name: "24h", Duration: 24 * time.Hour}, window)
+	assert.Equal(t, int64(86400), window.Seconds())
+
+	err = window.UnmarshalText([]byte("1y"))
+
+	assert.ErrorIs(t, err, ErrInvalidWindow)
+}
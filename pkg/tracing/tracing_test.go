@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGetEnv(t *testing.T) {
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	traceState, _ := trace.ParseTraceState("vendor=value")
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected []string
+	}{
+		{
+			name: "With span",
+			ctx: trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    traceId,
+				SpanID:     spanId,
+				TraceFlags: trace.FlagsSampled,
+				TraceState: traceState,
+			})),
+			expected: []string{
+				"TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"TRACESTATE=vendor=value",
+			},
+		},
+		{
+			name:     "Without span",
+			ctx:      context.Background(),
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.ElementsMatch(t, testCase.expected, GetEnv(testCase.ctx))
+		})
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	testCases := []struct {
+		name     string
+		exporter string
+		isErr    bool
+	}{
+		{
+			name:     "Stdout exporter",
+			exporter: ExporterStdout,
+		},
+		{
+			name:     "Otlp exporter",
+			exporter: ExporterOtlp,
+		},
+		{
+			name:     "Unknown exporter error",
+			exporter: "zipkin",
+			isErr:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tracerProvider, err := NewTracerProvider(context.Background(), Config{
+				ServiceName: "pg-sh-scripts",
+				Exporter:    testCase.exporter,
+				Endpoint:    "localhost:4318",
+				Insecure:    true,
+				SampleRatio: 1,
+			})
+
+			assert.Equal(t, testCase.isErr, err != nil)
+			if tracerProvider != nil {
+				assert.NoError(t, tracerProvider.Shutdown(context.Background()))
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	tagName  = "validate"
+	rootPath = "$"
+	// uniqueTag is reported for every duplicated element instead of the whole list.
+	uniqueTag = "unique"
+)
+
+type FieldError struct {
+	Field  string `json:"field"  example:"$[1].timeoutSeconds"`
+	Reason string `json:"reason" example:"max=86400"`
+	Value  any    `json:"value"  swaggertype:"object"`
+}
+
+var validate = newValidate()
+
+func getJsonName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// newValidate returns the validator which names the fields by their json names,
+// so the paths of the errors match the request body.
+func newValidate() *validator.Validate {
+	v := validator.New()
+	v.SetTagName(tagName)
+	v.RegisterTagNameFunc(getJsonName)
+	return v
+}
+
+func getReason(fieldErr validator.FieldError) string {
+	if fieldErr.Param() == "" {
+		return fieldErr.Tag()
+	}
+	return fieldErr.Tag() + "=" + fieldErr.Param()
+}
+
+// getPath replaces the struct name at the beginning of the namespace with the path prefix.
+func getPath(prefix string, fieldErr validator.FieldError) string {
+	namespace := fieldErr.Namespace()
+	if i := strings.IndexAny(namespace, ".["); i >= 0 {
+		namespace = namespace[i:]
+		if namespace[0] == '.' {
+			namespace = namespace[1:]
+		}
+	}
+	if namespace == "" || strings.HasPrefix(namespace, "[") {
+		return prefix + namespace
+	}
+	return prefix + "." + namespace
+}
+
+func getFieldErrors(prefix string, err error) []*FieldError {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return []*FieldError{{Field: prefix, Reason: err.Error()}}
+	}
+
+	fieldErrors := make([]*FieldError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fieldErrors = append(fieldErrors, &FieldError{
+			Field:  getPath(prefix, fieldErr),
+			Reason: getReason(fieldErr),
+			Value:  fieldErr.Value(),
+		})
+	}
+	return fieldErrors
+}
+
+// getDuplicateErrors returns an error for every element of the list
+// whose field value has already been seen in the previous elements.
+func getDuplicateErrors(list reflect.Value, fieldName string) []*FieldError {
+	fieldErrors := make([]*FieldError, 0)
+	seen := make(map[any]struct{}, list.Len())
+
+	for i := 0; i < list.Len(); i++ {
+		field, ok := list.Index(i).Type().FieldByName(fieldName)
+		if !ok || !field.Type.Comparable() {
+			continue
+		}
+		value := list.Index(i).FieldByIndex(field.Index).Interface()
+		if _, ok := seen[value]; ok {
+			fieldErrors = append(fieldErrors, &FieldError{
+				Field:  fmt.Sprintf("%s[%d].%s", rootPath, i, getJsonName(field)),
+				Reason: uniqueTag,
+				Value:  value,
+			})
+		}
+		seen[value] = struct{}{}
+	}
+	return fieldErrors
+}
+
+// ValidateStruct validates the struct by the validate tags of its fields,
+// it returns nil when the struct is valid.
+func ValidateStruct(s any) []*FieldError {
+	if err := validate.Struct(s); err != nil {
+		return getFieldErrors(rootPath, err)
+	}
+	return nil
+}
+
+// ValidateList validates the list itself by the tag, for example "min=1,max=100,unique=Id",
+// and then each of its struct elements by their own validate tags, so all invalid elements are reported.
+// It returns nil when the list and all of its elements are valid.
+func ValidateList(list any, tag string) []*FieldError {
+	var fieldErrors []*FieldError
+
+	if err := validate.Var(list, tag); err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) && validationErrs[0].Tag() == uniqueTag {
+			fieldErrors = append(fieldErrors, getDuplicateErrors(reflect.ValueOf(list), validationErrs[0].Param())...)
+		} else {
+			fieldErrors = append(fieldErrors, getFieldErrors(rootPath, err)...)
+		}
+	}
+
+	value := reflect.ValueOf(list)
+	for i := 0; i < value.Len(); i++ {
+		if value.Index(i).Kind() != reflect.Struct {
+			continue
+		}
+		if err := validate.Struct(value.Index(i).Interface()); err != nil {
+			fieldErrors = append(fieldErrors, getFieldErrors(fmt.Sprintf("%s[%d]", rootPath, i), err)...)
+		}
+	}
+	return fieldErrors
+}
@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testItem struct {
+		Name  string `json:"name"  validate:"required"`
+		Count int    `json:"count" validate:"min=1,max=10"`
+	}
+
+	testStruct struct {
+		Title string     `json:"title" validate:"required,max=5"`
+		Items []testItem `json:"items" validate:"dive"`
+	}
+)
+
+func TestValidateStruct(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       testStruct
+		expected []*FieldError
+	}{
+		{
+			name: "Valid",
+			in: testStruct{
+				Title: "title",
+				Items: []testItem{{Name: "first", Count: 1}},
+			},
+			expected: nil,
+		},
+		{
+			name: "Nested fields error",
+			in: testStruct{
+				Title: "long title",
+				Items: []testItem{{Name: "first", Count: 1}, {Count: 11}},
+			},
+			expected: []*FieldError{
+				{Field: "$.title", Reason: "max=5", Value: "long title"},
+				{Field: "$.items[1].name", Reason: "required", Value: ""},
+				{Field: "$.items[1].count", Reason: "max=10", Value: 11},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ValidateStruct(testCase.in))
+		})
+	}
+}
+
+func TestValidateList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       []testItem
+		expected []*FieldError
+	}{
+		{
+			name:     "Valid",
+			in:       []testItem{{Name: "first", Count: 1}, {Name: "second", Count: 2}},
+			expected: nil,
+		},
+		{
+			name: "Length error",
+			in:   []testItem{{Name: "first", Count: 1}, {Name: "second", Count: 2}, {Name: "third", Count: 3}},
+			expected: []*FieldError{
+				{
+					Field:  "$",
+					Reason: "max=2",
+					Value:  []testItem{{Name: "first", Count: 1}, {Name: "second", Count: 2}, {Name: "third", Count: 3}},
+				},
+			},
+		},
+		{
+			name: "Duplicate and fields error",
+			in:   []testItem{{Name: "first", Count: 1}, {Name: "first", Count: 0}},
+			expected: []*FieldError{
+				{Field: "$[1].name", Reason: "unique", Value: "first"},
+				{Field: "$[1].count", Reason: "min=1", Value: 0},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ValidateList(testCase.in, "min=1,max=2,unique=Name"))
+		})
+	}
+}
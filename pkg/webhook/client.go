@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+
+	signaturePrefix = "sha256="
+	contentType     = "application/json"
+)
+
+type (
+	IClient interface {
+		Send(ctx context.Context, req *Request, onAttempt AttemptFunc) error
+	}
+
+	Client struct {
+		httpClient        *http.Client
+		retryCount        int
+		retrySleepSeconds time.Duration
+	}
+
+	ClientConfig struct {
+		RetryCount        int
+		RetrySleepSeconds time.Duration
+		TimeoutSeconds    time.Duration
+	}
+
+	Request struct {
+		Url     string
+		Secret  string
+		Event   string
+		Payload []byte
+	}
+
+	Attempt struct {
+		Number     int
+		StatusCode int
+		Err        error
+	}
+
+	// AttemptFunc is called after every delivery attempt, successful or not.
+	AttemptFunc func(Attempt)
+)
+
+// Sign returns the HMAC-SHA256 signature of the payload in the form
+// sha256=<hex>, which is sent in the SignatureHeader.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func isRetryable(statusCode int) bool {
+	switch {
+	case statusCode == 0:
+		return true
+	case statusCode == http.StatusRequestTimeout, statusCode == http.StatusTooManyRequests:
+		return true
+	case statusCode >= http.StatusInternalServerError:
+		return true
+	}
+	return false
+}
+
+func (c *Client) post(ctx context.Context, req *Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		req.Url,
+		bytes.NewReader(req.Payload),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, req.Payload))
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Send delivers the request, retrying failed attempts with exponential backoff
+// starting from RetrySleepSeconds. Client errors other than 408 and 429 are not retried.
+func (c *Client) Send(ctx context.Context, req *Request, onAttempt AttemptFunc) error {
+	var err error
+
+	attemptsCount := max(c.retryCount, 1)
+	retrySleep := c.retrySleepSeconds
+
+	for attempt := 1; attempt <= attemptsCount; attempt++ {
+		var statusCode int
+
+		statusCode, err = c.post(ctx, req)
+		if onAttempt != nil {
+			onAttempt(Attempt{Number: attempt, StatusCode: statusCode, Err: err})
+		}
+
+		if err == nil {
+			return nil
+		}
+		if !isRetryable(statusCode) || attempt == attemptsCount {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retrySleep):
+		}
+		retrySleep *= 2
+	}
+
+	return err
+}
+
+func GetClient(clientConfig *ClientConfig) IClient {
+	return &Client{
+		httpClient:        &http.Client{Timeout: clientConfig.TimeoutSeconds},
+		retryCount:        clientConfig.RetryCount,
+		retrySleepSeconds: clientConfig.RetrySleepSeconds,
+	}
+}
@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	signature := Sign("secret", []byte(`{"event":"run.success"}`))
+
+	assert.Equal(
+		t,
+		"sha256=73d1106263c48342039bbc9ad501bc3606dcf31bf013e5c95e32ccc9026b57b1",
+		signature,
+	)
+	assert.NotEqual(t, signature, Sign("other", []byte(`{"event":"run.success"}`)))
+}
+
+func TestClient_Send(t *testing.T) {
+	type (
+		inStruct struct {
+			statusCodes []int
+			retryCount  int
+		}
+
+		expectedStruct struct {
+			attempts int
+			isErr    bool
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		in       inStruct
+		expected expectedStruct
+	}{
+		{
+			name: "Success",
+			in: inStruct{
+				statusCodes: []int{http.StatusOK},
+				retryCount:  3,
+			},
+			expected: expectedStruct{
+				attempts: 1,
+				isErr:    false,
+			},
+		},
+		{
+			name: "Success after retries",
+			in: inStruct{
+				statusCodes: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusNoContent},
+				retryCount:  3,
+			},
+			expected: expectedStruct{
+				attempts: 3,
+				isErr:    false,
+			},
+		},
+		{
+			name: "Retries exhausted error",
+			in: inStruct{
+				statusCodes: []int{http.StatusInternalServerError},
+				retryCount:  3,
+			},
+			expected: expectedStruct{
+				attempts: 3,
+				isErr:    true,
+			},
+		},
+		{
+			name: "Not retryable status error",
+			in: inStruct{
+				statusCodes: []int{http.StatusBadRequest},
+				retryCount:  3,
+			},
+			expected: expectedStruct{
+				attempts: 1,
+				isErr:    true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var requestCount atomic.Int32
+
+			payload := []byte(`{"event":"run.failure"}`)
+
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+
+				assert.Equal(t, payload, body)
+				assert.Equal(t, "run.failure", r.Header.Get(EventHeader))
+				assert.Equal(t, Sign("secret", body), r.Header.Get(SignatureHeader))
+
+				i := int(requestCount.Add(1)) - 1
+				w.WriteHeader(testCase.in.statusCodes[min(i, len(testCase.in.statusCodes)-1)])
+			}))
+			defer receiver.Close()
+
+			client := GetClient(&ClientConfig{
+				RetryCount:        testCase.in.retryCount,
+				RetrySleepSeconds: time.Millisecond,
+				TimeoutSeconds:    time.Second,
+			})
+
+			attempts := make([]Attempt, 0)
+			err := client.Send(
+				context.Background(),
+				&Request{Url: receiver.URL, Secret: "secret", Event: "run.failure", Payload: payload},
+				func(attempt Attempt) {
+					attempts = append(attempts, attempt)
+				},
+			)
+
+			assert.Equal(t, testCase.expected.isErr, err != nil)
+			assert.Len(t, attempts, testCase.expected.attempts)
+			assert.Equal(t, testCase.expected.attempts, int(requestCount.Load()))
+			for i, attempt := range attempts {
+				assert.Equal(t, i+1, attempt.Number)
+			}
+		})
+	}
+}